@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lineDiff returns a simple line-based diff between a and b. Lines that are
+// only in a are prefixed with "-" and lines only in b are prefixed with "+".
+// Lines that are the same in both are omitted.
+func lineDiff(a, b string) string {
+	as := strings.Split(a, "\n")
+	bs := strings.Split(b, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of as[i:] and
+	// bs[j:].
+	lcs := make([][]int, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bs)+1)
+	}
+
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			if as[i] == bs[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(as) || j < len(bs) {
+		switch {
+		case i < len(as) && j < len(bs) && as[i] == bs[j]:
+			i++
+			j++
+
+		case j == len(bs) || (i < len(as) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&diff, "%d: -%s\n", i+1, as[i])
+			i++
+
+		default:
+			fmt.Fprintf(&diff, "%d: +%s\n", i+1, bs[j])
+			j++
+		}
+	}
+
+	return diff.String()
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// findDirectives returns the "//pie:" directives in the comments of the type
+// declaration with name. The key is the name of the directive (such as
+// "filters") and the value contains the space-separated arguments that
+// followed it.
+//
+// A directive may appear more than once, in which case the arguments are
+// combined.
+func findDirectives(pkgs map[string]*ast.Package, name string) map[string][]string {
+	directives := map[string][]string{}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range genDecl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok || typeSpec.Name.String() != name {
+						continue
+					}
+
+					// A single type declaration has its comments on the
+					// GenDecl, whereas types in a group have their own.
+					for _, doc := range []*ast.CommentGroup{genDecl.Doc, typeSpec.Doc} {
+						addDirectives(directives, doc)
+					}
+
+					return directives
+				}
+			}
+		}
+	}
+
+	return directives
+}
+
+func addDirectives(directives map[string][]string, doc *ast.CommentGroup) {
+	if doc == nil {
+		return
+	}
+
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//pie:") {
+			continue
+		}
+
+		parts := strings.Fields(comment.Text[len("//pie:"):])
+		if len(parts) == 0 {
+			continue
+		}
+
+		directives[parts[0]] = append(directives[parts[0]], parts[1:]...)
+	}
+}
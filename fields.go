@@ -0,0 +1,454 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/elliotchance/pie/functions"
+	"github.com/elliotchance/pie/pie"
+)
+
+// pieSliceTypes are the built-in pie types that are returned from field
+// projections. Any other field type will return a plain slice.
+var pieSliceTypes = map[string]string{
+	"bool":          "pie.Bools",
+	"float32":       "pie.Float32s",
+	"float64":       "pie.Float64s",
+	"int":           "pie.Ints",
+	"int32":         "pie.Int32s",
+	"int64":         "pie.Int64s",
+	"string":        "pie.Strings",
+	"time.Duration": "pie.Durations",
+	"time.Time":     "pie.Times",
+	"uint64":        "pie.Uint64s",
+}
+
+type structField struct {
+	Name, Type string
+}
+
+// findStruct returns the exported fields of the struct type with name, and the
+// file it was declared in. Embedded fields are ignored. nil is returned if name
+// is not a struct in pkgs.
+func findStruct(pkgs map[string]*ast.Package, name string) (fields []structField, file *ast.File) {
+	name = strings.TrimLeft(name, "*")
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range genDecl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok || typeSpec.Name.String() != name {
+						continue
+					}
+
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						return nil, nil
+					}
+
+					for _, field := range structType.Fields.List {
+						fieldType, ok := tryGetIdentName(field.Type)
+						if !ok {
+							continue
+						}
+
+						for _, fieldName := range field.Names {
+							if fieldName.IsExported() {
+								fields = append(fields, structField{fieldName.Name, fieldType})
+							}
+						}
+					}
+
+					return fields, f
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// tryGetIdentName works like getIdentName but returns false for types that it
+// cannot decode, rather than panicking.
+func tryGetIdentName(e ast.Expr) (name string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+
+	return getIdentName(e), true
+}
+
+// pluralize returns the plural form of an English noun, such as "Names" or
+// "Categories". It is used to name field projections.
+func pluralize(name string) string {
+	lower := strings.ToLower(name)
+
+	switch {
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"),
+		strings.HasSuffix(lower, "z"), strings.HasSuffix(lower, "ch"),
+		strings.HasSuffix(lower, "sh"):
+		return name + "es"
+
+	case len(lower) > 1 && strings.HasSuffix(lower, "y") &&
+		!strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return name[:len(name)-1] + "ies"
+	}
+
+	return name + "s"
+}
+
+// isFunctionName returns true if name is already used by one of the template
+// functions.
+func isFunctionName(name string) bool {
+	for _, function := range functions.Functions {
+		if function.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldProjections generates a method for each exported field of the struct
+// element type that returns the value of that field for every element, such
+// as Names() for a Name field.
+//
+// Projections are only generated if includeFunction allows the method name.
+func fieldProjections(pkgs map[string]*ast.Package, packageName, sliceType, elementType string, fns []string) (code string, imports []string) {
+	fields, file := findStruct(pkgs, elementType)
+
+	for _, field := range fields {
+		methodName := pluralize(field.Name)
+		if isFunctionName(methodName) {
+			continue
+		}
+
+		if !includeFunction(fns, methodName) {
+			continue
+		}
+
+		returnType, ok := pieSliceTypes[field.Type]
+		if ok {
+			if !isSelfPackage(packageName) {
+				imports = append(imports, `"github.com/elliotchance/pie/pie"`)
+			}
+		} else {
+			returnType = "[]" + field.Type
+			imports = append(imports, getTypeImports(file, field.Type)...)
+		}
+
+		variable := parameterName(methodName)
+		assign := fmt.Sprintf("\t\t%s[i] = s.%s\n", variable, field.Name)
+		if elementType[0] == '*' {
+			assign = fmt.Sprintf("\t\tif s != nil {\n\t%s\t\t}\n", assign)
+		}
+
+		code += fmt.Sprintf(`// %s returns the %s field of each element.`, methodName, field.Name)
+		if elementType[0] == '*' {
+			code += "\n//\n// The zero value is used for nil elements."
+		}
+		code += fmt.Sprintf(`
+func (ss %s) %s() %s {
+	if ss == nil {
+		return nil
+	}
+
+	%s := make(%s, len(ss))
+	for i, s := range ss {
+%s	}
+
+	return %s
+}
+
+`, sliceType, methodName, returnType, variable, returnType, assign, variable)
+	}
+
+	return
+}
+
+// parameterName returns a variable name that is derived from the field name,
+// such as "color" for Color.
+func parameterName(fieldName string) string {
+	name := string(unicode.ToLower(rune(fieldName[0]))) + fieldName[1:]
+
+	// Avoid keywords and the names used in the generated code.
+	if token.Lookup(name).IsKeyword() || name == "s" || name == "ss" || name == "ss2" {
+		return "value"
+	}
+
+	return name
+}
+
+// fieldFilters generates a method for each exported field of the struct
+// element type that returns the elements that have a specific value for that
+// field, such as WithColor(color string) for a Color field.
+//
+// Filters are only generated for the fields listed in onlyFields. If
+// onlyFields is empty then all exported fields that can be compared with ==
+// are used, and fields such as slices and maps are skipped. An error is
+// returned if a field that cannot be compared is listed explicitly. Like
+// fieldProjections, methods are only generated when all functions (*) are
+// requested, or when the method name is requested explicitly.
+func fieldFilters(fset *token.FileSet, pkgs map[string]*ast.Package, packageName, sliceType, elementType string, fns, onlyFields []string) (code string, imports []string, err error) {
+	fields, file := findStruct(pkgs, elementType)
+	checked := checkPackage(fset, pkgs, packageName)
+
+	for _, field := range fields {
+		if len(onlyFields) > 0 && !pie.Strings(onlyFields).Contains(field.Name) {
+			continue
+		}
+
+		if !isComparableField(checked, elementType, field) {
+			if len(onlyFields) > 0 {
+				return "", nil, notComparableError(sliceType, elementType, "filter", field)
+			}
+
+			continue
+		}
+
+		methodName := "With" + field.Name
+		if isFunctionName(methodName) {
+			continue
+		}
+
+		if !includeFunction(fns, methodName) {
+			continue
+		}
+
+		imports = append(imports, getTypeImports(file, field.Type)...)
+
+		param := parameterName(field.Name)
+		condition := fmt.Sprintf("s.%s == %s", field.Name, param)
+		if elementType[0] == '*' {
+			condition = "s != nil && " + condition
+		}
+
+		code += fmt.Sprintf(`// %s returns a new slice containing only the elements where %s is
+// equal to %s. The returned slice may contain zero elements (nil).
+func (ss %s) %s(%s %s) (ss2 %s) {
+	for _, s := range ss {
+		if %s {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+`, methodName, field.Name, param, sliceType, methodName, param, field.Type, sliceType, condition)
+	}
+
+	return
+}
+
+// isComparableField returns true if the field of the struct element type can
+// be compared with ==. The resolved type is used when the package can be type
+// checked, otherwise slice, map and func types are assumed to not be
+// comparable.
+func isComparableField(checked *types.Package, elementType string, field structField) bool {
+	if t := structFieldType(checked, strings.TrimLeft(elementType, "*"), field.Name); t != nil {
+		return types.Comparable(t)
+	}
+
+	for _, prefix := range []string{"[]", "map[", "func("} {
+		if strings.HasPrefix(field.Type, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// notComparableError is returned when a field that cannot be compared with ==
+// is listed explicitly for a method that needs to compare it, such as a filter.
+func notComparableError(sliceType, elementType, method string, field structField) error {
+	return fmt.Errorf("%s: cannot generate %s for %s.%s because %s cannot be compared with ==",
+		sliceType, method, strings.TrimLeft(elementType, "*"), field.Name, field.Type)
+}
+
+// orderedTypes are the field types that support the < operator.
+var orderedTypes = map[string]bool{
+	"float32": true, "float64": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"byte": true, "rune": true, "uintptr": true, "string": true,
+	"time.Duration": true,
+}
+
+// fieldSorts generates SortBy and SortByDesc methods for each exported field
+// of the struct element type that can be ordered, such as SortByName() and
+// SortByNameDesc() for a Name field.
+//
+// Like fieldProjections, methods are only generated if includeFunction allows
+// them.
+func fieldSorts(pkgs map[string]*ast.Package, sliceType, elementType string, fns []string) (code string, imports []string) {
+	fields, _ := findStruct(pkgs, elementType)
+
+	for _, field := range fields {
+		if !orderedTypes[field.Type] {
+			continue
+		}
+
+		for _, desc := range []bool{false, true} {
+			methodName, order, operator := "SortBy"+field.Name, "ascending", "<"
+			nilLess := "a == nil && b != nil"
+			if desc {
+				methodName, order, operator = methodName+"Desc", "descending", ">"
+				nilLess = "a != nil && b == nil"
+			}
+
+			if isFunctionName(methodName) {
+				continue
+			}
+
+			if !includeFunction(fns, methodName) {
+				continue
+			}
+
+			imports = append(imports, `"sort"`)
+
+			doc := fmt.Sprintf(`// %s returns a new slice sorted by %s in %s order.
+// Elements with the same %s will keep their original order.`,
+				methodName, field.Name, order, field.Name)
+			less := fmt.Sprintf("return sorted[i].%s %s sorted[j].%s",
+				field.Name, operator, field.Name)
+
+			if elementType[0] == '*' {
+				position := "first"
+				if desc {
+					position = "last"
+				}
+
+				doc += fmt.Sprintf("\n//\n// nil elements will be sorted %s.", position)
+				less = fmt.Sprintf(`a, b := sorted[i], sorted[j]
+		if a == nil || b == nil {
+			return %s
+		}
+
+		return a.%s %s b.%s`, nilLess, field.Name, operator, field.Name)
+			}
+
+			code += fmt.Sprintf(`%s
+func (ss %s) %s() %s {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(%s, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		%s
+	})
+
+	return sorted
+}
+
+`, doc, sliceType, methodName, sliceType, sliceType, less)
+		}
+	}
+
+	return
+}
+
+// fieldGroupings generates GroupBy and KeyBy methods for the fields listed in
+// the "//pie:groupby" and "//pie:keyby" directives, such as GroupByColor() and
+// KeyByName().
+//
+// The fields are used as map keys, so an error is returned if one of them
+// cannot be compared with ==. Like fieldProjections, methods are only generated
+// if includeFunction allows them.
+func fieldGroupings(fset *token.FileSet, pkgs map[string]*ast.Package, packageName, sliceType, elementType string, fns, groupByFields, keyByFields []string) (code string, imports []string, err error) {
+	fields, file := findStruct(pkgs, elementType)
+	checked := checkPackage(fset, pkgs, packageName)
+
+	skipNil := ""
+	if elementType[0] == '*' {
+		skipNil = `		if s == nil {
+			continue
+		}
+
+`
+	}
+
+	for _, field := range fields {
+		groupBy := pie.Strings(groupByFields).Contains(field.Name)
+		keyBy := pie.Strings(keyByFields).Contains(field.Name)
+
+		if groupBy && !isComparableField(checked, elementType, field) {
+			return "", nil, notComparableError(sliceType, elementType, "GroupBy"+field.Name, field)
+		}
+
+		if keyBy && !isComparableField(checked, elementType, field) {
+			return "", nil, notComparableError(sliceType, elementType, "KeyBy"+field.Name, field)
+		}
+
+		if groupBy {
+			methodName := "GroupBy" + field.Name
+			if !isFunctionName(methodName) && includeFunction(fns, methodName) {
+				imports = append(imports, getTypeImports(file, field.Type)...)
+
+				doc := fmt.Sprintf(`// %s returns the elements grouped by their %s field. The
+// elements in each group will retain their original order.`, methodName, field.Name)
+				if skipNil != "" {
+					doc += "\n//\n// nil elements are not included."
+				}
+
+				code += fmt.Sprintf(`%s
+func (ss %s) %s() map[%s]%s {
+	group := map[%s]%s{}
+
+	for _, s := range ss {
+%s		group[s.%s] = append(group[s.%s], s)
+	}
+
+	return group
+}
+
+`, doc, sliceType, methodName, field.Type, sliceType, field.Type, sliceType, skipNil, field.Name, field.Name)
+			}
+		}
+
+		if keyBy {
+			methodName := "KeyBy" + field.Name
+			if !isFunctionName(methodName) && includeFunction(fns, methodName) {
+				imports = append(imports, getTypeImports(file, field.Type)...)
+
+				doc := fmt.Sprintf(`// %s returns a map of the elements using their %s field as the
+// key. If more than one element has the same %s the last one is used.`,
+					methodName, field.Name, field.Name)
+				if skipNil != "" {
+					doc += "\n//\n// nil elements are not included."
+				}
+
+				code += fmt.Sprintf(`%s
+func (ss %s) %s() map[%s]%s {
+	keyed := make(map[%s]%s, len(ss))
+
+	for _, s := range ss {
+%s		keyed[s.%s] = s
+	}
+
+	return keyed
+}
+
+`, doc, sliceType, methodName, field.Type, elementType, field.Type, elementType, skipNil, field.Name)
+			}
+		}
+	}
+
+	return
+}
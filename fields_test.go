@@ -0,0 +1,93 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// parseTestPackage parses src as the only file of a package so that it can be
+// passed to the generator functions.
+func parseTestPackage(t *testing.T, src string) (*token.FileSet, map[string]*ast.Package, string) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "test.go", src, parser.ParseComments)
+	assert.NoError(t, err)
+
+	packageName := f.Name.Name
+	pkgs := map[string]*ast.Package{
+		packageName: {Name: packageName, Files: map[string]*ast.File{"test.go": f}},
+	}
+
+	return fset, pkgs, packageName
+}
+
+const filtersTestSource = `package filterstest
+
+type Item struct {
+	Name   string
+	Count  int
+	Tags   []string
+	Labels map[string]string
+}
+
+type Items []Item
+`
+
+func TestFieldFilters_SkipsFieldsThatAreNotComparable(t *testing.T) {
+	fset, pkgs, packageName := parseTestPackage(t, filtersTestSource)
+
+	code, _, err := fieldFilters(fset, pkgs, packageName, "Items", "Item", []string{"*"}, nil)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(code, "func (ss Items) WithName(name string)"))
+	assert.True(t, strings.Contains(code, "func (ss Items) WithCount(count int)"))
+	assert.False(t, strings.Contains(code, "WithTags"))
+	assert.False(t, strings.Contains(code, "WithLabels"))
+}
+
+func TestFieldFilters_ErrorsForNamedFieldThatIsNotComparable(t *testing.T) {
+	fset, pkgs, packageName := parseTestPackage(t, filtersTestSource)
+
+	_, _, err := fieldFilters(fset, pkgs, packageName, "Items", "Item", []string{"*"}, []string{"Name", "Tags"})
+	assert.Error(t, err)
+	assert.Equal(t, "Items: cannot generate filter for Item.Tags because []string cannot be compared with ==", err.Error())
+
+	_, _, err = fieldFilters(fset, pkgs, packageName, "Items", "Item", []string{"*"}, []string{"Labels"})
+	assert.Error(t, err)
+}
+
+func TestFieldFilters_Pointers(t *testing.T) {
+	fset, pkgs, packageName := parseTestPackage(t, strings.Replace(filtersTestSource, "[]Item", "[]*Item", 1))
+
+	code, _, err := fieldFilters(fset, pkgs, packageName, "Items", "*Item", []string{"*"}, nil)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(code, "s != nil && s.Name == name"))
+	assert.False(t, strings.Contains(code, "WithTags"))
+}
+
+func TestFieldGroupings(t *testing.T) {
+	fset, pkgs, packageName := parseTestPackage(t, filtersTestSource)
+
+	code, _, err := fieldGroupings(fset, pkgs, packageName, "Items", "Item", []string{"*"},
+		[]string{"Name"}, []string{"Count"})
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(code, "func (ss Items) GroupByName() map[string]Items"))
+	assert.True(t, strings.Contains(code, "func (ss Items) KeyByCount() map[int]Item"))
+}
+
+func TestFieldGroupings_ErrorsForFieldThatIsNotComparable(t *testing.T) {
+	fset, pkgs, packageName := parseTestPackage(t, filtersTestSource)
+
+	_, _, err := fieldGroupings(fset, pkgs, packageName, "Items", "Item", []string{"*"},
+		[]string{"Name", "Tags"}, nil)
+	assert.Error(t, err)
+	assert.Equal(t, "Items: cannot generate GroupByTags for Item.Tags because []string cannot be compared with ==", err.Error())
+
+	_, _, err = fieldGroupings(fset, pkgs, packageName, "Items", "Item", []string{"*"},
+		nil, []string{"Labels"})
+	assert.Error(t, err)
+	assert.Equal(t, "Items: cannot generate KeyByLabels for Item.Labels because map[string]string cannot be compared with ==", err.Error())
+}
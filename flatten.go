@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// flattenMethod generates a Flatten method for slices where the elements are
+// also slices, such as:
+//
+//	type Batches []pie.Float64s
+//
+// The flattened slice is the same type as the elements.
+func flattenMethod(fset *token.FileSet, pkgs map[string]*ast.Package, packageName, sliceType, elementType string, fns []string) string {
+	if !includeFunction(fns, "Flatten") {
+		return ""
+	}
+
+	elem := elementTypeOf(checkPackage(fset, pkgs, packageName), sliceType)
+	if elem == nil {
+		return ""
+	}
+
+	if _, ok := elem.Underlying().(*types.Slice); !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+// Flatten returns a single slice containing all of the elements of each slice,
+// in order. The result may contain zero elements (nil).
+func (ss %s) Flatten() (flattened %s) {
+	for _, s := range ss {
+		flattened = append(flattened, s...)
+	}
+
+	return
+}
+`, sliceType, elementType)
+}
@@ -1,14 +1,24 @@
 package functions
 
-import (
-	"math"
-)
-
-// Abs is a function which returns the absolute value of all the
-// elements in the slice.
+// Abs returns a new slice with the absolute value of each element. The input
+// slice is not modified.
+//
+// For signed integers the absolute value of the smallest value cannot be
+// represented, so it is returned unchanged. For example, math.MinInt64 for
+// Int64s.
 func (ss SliceType) Abs() SliceType {
-	for i, val := range ss {
-		ss[i] = ElementType(math.Abs(float64(val)))
+	if ss == nil {
+		return nil
 	}
-	return ss
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		if s < 0 {
+			s = -s
+		}
+
+		result[i] = s
+	}
+
+	return result
 }
@@ -0,0 +1,31 @@
+package functions
+
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss SliceType) Accumulate(initial ElementType, fn func(acc, value ElementType) ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(SliceType, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
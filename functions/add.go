@@ -0,0 +1,24 @@
+package functions
+
+// Add returns a new slice where each element is the sum of the elements at the
+// same position in ss and ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss SliceType) Add(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] + ss2[i]
+	}
+
+	return result
+}
@@ -0,0 +1,15 @@
+package functions
+
+// AddScalar returns a new slice with value added to each element.
+func (ss SliceType) AddScalar(value ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = s + value
+	}
+
+	return result
+}
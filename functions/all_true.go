@@ -0,0 +1,14 @@
+package functions
+
+// AllTrue returns true if all of the elements are true.
+//
+// If the list is empty then true is always returned.
+func (ss BoolSliceType) AllTrue() bool {
+	for _, s := range ss {
+		if !s {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,14 @@
+package functions
+
+// AnyTrue returns true if at least one of the elements is true.
+//
+// If the list is empty then false is always returned.
+func (ss BoolSliceType) AnyTrue() bool {
+	for _, s := range ss {
+		if s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,10 +1,19 @@
 package functions
 
-// Append will return a new slice with the elements appended to the end. It is a
-// wrapper for the internal append(). It is offered as a function so that it can
-// more easily chained.
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
 //
 // It is acceptable to provide zero arguments.
 func (ss SliceType) Append(elements ...ElementType) SliceType {
-	return append(ss, elements...)
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(SliceType, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
 }
@@ -0,0 +1,21 @@
+package functions
+
+// ArgMax returns the index of the largest element. If there are several
+// largest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Max to get the value instead.
+func (ss SliceType) ArgMax() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s > ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
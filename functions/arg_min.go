@@ -0,0 +1,21 @@
+package functions
+
+// ArgMin returns the index of the smallest element. If there are several
+// smallest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Min to get the value instead.
+func (ss SliceType) ArgMin() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s < ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"sort"
+)
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
+//
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order. The input slice is not modified
+// and nil is returned if there are no elements.
+func (ss SliceType) ArgSort() []int {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ss[indexes[i]] < ss[indexes[j]]
+	})
+
+	return indexes
+}
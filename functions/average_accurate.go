@@ -0,0 +1,12 @@
+package functions
+
+// AverageAccurate is the same as Average except that the elements are added
+// with SumAccurate, which is more accurate for large slices. Zero is returned
+// if there are no elements.
+func (ss SliceType) AverageAccurate() float64 {
+	if l := len(ss); l > 0 {
+		return float64(ss.SumAccurate()) / float64(l)
+	}
+
+	return 0
+}
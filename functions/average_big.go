@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"math/big"
+)
+
+// AverageBig is the exact average of all of the elements. The sum is
+// calculated with a big.Int so it cannot overflow, unlike Average which sums
+// the elements with the element type.
+//
+// Zero is returned if there are no elements.
+func (ss SliceType) AverageBig() *big.Rat {
+	if len(ss) == 0 {
+		return new(big.Rat)
+	}
+
+	sum, value := new(big.Int), new(big.Int)
+	for _, s := range ss {
+		if s < 0 {
+			value.SetInt64(int64(s))
+		} else {
+			value.SetUint64(uint64(s))
+		}
+
+		sum.Add(sum, value)
+	}
+
+	return new(big.Rat).SetFrac(sum, big.NewInt(int64(len(ss))))
+}
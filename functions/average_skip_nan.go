@@ -0,0 +1,26 @@
+package functions
+
+import (
+	"math"
+)
+
+// AverageSkipNaN is the average of all of the elements that are not NaN (not a
+// number). Unlike Average, a NaN element does not cause the result to be NaN.
+//
+// Zero is returned if there are no elements that are not NaN.
+func (ss SliceType) AverageSkipNaN() float64 {
+	var sum float64
+	var count int
+	for _, s := range ss {
+		if v := float64(s); !math.IsNaN(v) {
+			sum += v
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return sum / float64(count)
+}
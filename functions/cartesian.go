@@ -0,0 +1,23 @@
+package functions
+
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
+//
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesianSliceType for more
+// than two slices.
+func (ss SliceType) Cartesian(ss2 SliceType) SliceTypePairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
+	}
+
+	pairs := make(SliceTypePairs, 0, len(ss)*len(ss2))
+	for _, s := range ss {
+		for _, s2 := range ss2 {
+			pairs = append(pairs, SliceTypePair{s, s2})
+		}
+	}
+
+	return pairs
+}
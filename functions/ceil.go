@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"math"
+)
+
+// Ceil returns a new slice with the least integer value greater than or equal
+// to each element.
+func (ss SliceType) Ceil() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Ceil(float64(s)))
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package functions
+
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
+//
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
+//
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss SliceType) Chunk(size int) (chunks []SliceType) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		chunks = append(chunks, ss[i:end:end])
+	}
+
+	return
+}
@@ -0,0 +1,26 @@
+package functions
+
+// Clamp returns a new slice where each element less than min is replaced with
+// min, and each element greater than max is replaced with max.
+//
+// If min is greater than max every element will be max.
+func (ss SliceType) Clamp(min, max ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		if s < min {
+			s = min
+		}
+
+		if s > max {
+			s = max
+		}
+
+		result[i] = s
+	}
+
+	return result
+}
@@ -0,0 +1,15 @@
+package functions
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss SliceType) CoalesceOr(defaultValue ElementType) ElementType {
+	var zero ElementType
+
+	for _, s := range ss {
+		if s != zero {
+			return s
+		}
+	}
+
+	return defaultValue
+}
@@ -0,0 +1,20 @@
+//go:build go1.23
+// +build go1.23
+
+package functions
+
+import (
+	"iter"
+)
+
+// CollectSliceType creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// CollectSliceType is only available when compiling with Go 1.23 or newer.
+func CollectSliceType(seq iter.Seq[ElementType]) (ss SliceType) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
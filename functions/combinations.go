@@ -0,0 +1,19 @@
+package functions
+
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss SliceType) Combinations(k int) (combinations []SliceType) {
+	ss.EachCombination(k, func(combination SliceType) bool {
+		c := make(SliceType, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
+
+	return
+}
@@ -0,0 +1,17 @@
+package functions
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Compact() (ss2 SliceType) {
+	var zero ElementType
+
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"strings"
+)
+
+// Containing returns a new slice containing only the elements that contain
+// substr. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) Containing(substr string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.Contains(string(s), substr) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+package functions
+
+import (
+	"math"
+)
+
+// ContainsApprox returns true if any element is within epsilon of value. This
+// is more useful than Contains after doing arithmetic, which can introduce
+// small rounding errors:
+//
+//   ss.ContainsApprox(0.3, 1e-9)
+//
+// NaN is never approximately equal to any value.
+func (ss SliceType) ContainsApprox(value ElementType, epsilon float64) bool {
+	for _, s := range ss {
+		if math.Abs(float64(s)-float64(value)) <= epsilon {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"strings"
+)
+
+// ContainsFold works the same as Contains, except that the comparison ignores
+// case (see strings.EqualFold).
+func (ss StringSliceType) ContainsFold(lookingFor string) bool {
+	for _, s := range ss {
+		if strings.EqualFold(string(s), lookingFor) {
+			return true
+		}
+	}
+
+	return false
+}
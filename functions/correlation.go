@@ -0,0 +1,42 @@
+package functions
+
+import (
+	"math"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Correlation is the Pearson correlation coefficient between the elements at
+// the same position in each slice. The result is between -1 and 1, where 1 is
+// a perfect positive linear relationship, -1 is a perfect negative linear
+// relationship and 0 means there is no linear relationship.
+//
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and pie.ErrEmptySlice if there are no elements. The correlation
+// is undefined when either slice has all equal elements, in which case
+// pie.ErrZeroVariance is returned.
+func (ss SliceType) Correlation(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
+	}
+
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var covariance, variance, variance2 float64
+	for i, s := range ss {
+		diff, diff2 := float64(s)-mean, float64(ss2[i])-mean2
+		covariance += diff * diff2
+		variance += diff * diff
+		variance2 += diff2 * diff2
+	}
+
+	if variance == 0 || variance2 == 0 {
+		return 0, pie.ErrZeroVariance
+	}
+
+	return covariance / math.Sqrt(variance*variance2), nil
+}
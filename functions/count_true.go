@@ -0,0 +1,12 @@
+package functions
+
+// CountTrue returns the number of elements that are true.
+func (ss BoolSliceType) CountTrue() (count int) {
+	for _, s := range ss {
+		if s {
+			count++
+		}
+	}
+
+	return
+}
@@ -0,0 +1,13 @@
+package functions
+
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss SliceType) CountUsing(condition func(ElementType) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
+	}
+
+	return
+}
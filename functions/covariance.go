@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Covariance is the population covariance between the elements at the same
+// position in each slice. A positive result means the values tend to increase
+// together, and a negative result means one tends to decrease as the other
+// increases.
+//
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and pie.ErrEmptySlice if there are no elements.
+func (ss SliceType) Covariance(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
+	}
+
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var sum float64
+	for i, s := range ss {
+		sum += (float64(s) - mean) * (float64(ss2[i]) - mean2)
+	}
+
+	return sum / float64(len(ss)), nil
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss SliceType) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
+}
@@ -0,0 +1,21 @@
+package functions
+
+// CumulativeSum returns a new slice where each element is the running total of
+// all of the elements up to and including that position:
+//
+//   Ints{1, 2, 3, 4}.CumulativeSum() // Ints{1, 3, 6, 10}
+//
+func (ss SliceType) CumulativeSum() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	sums := make(SliceType, len(ss))
+	var sum ElementType
+	for i, s := range ss {
+		sum += s
+		sums[i] = sum
+	}
+
+	return sums
+}
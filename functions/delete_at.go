@@ -0,0 +1,23 @@
+package functions
+
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) DeleteAt(indexes ...int) (ss2 SliceType) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
+	}
+
+	for i, s := range ss {
+		if !deleted[i] {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,24 @@
+package functions
+
+// Deltas returns a new slice containing the difference between each element and
+// the element before it. It is the inverse of CumulativeSum (without the first
+// element):
+//
+//   Ints{1, 3, 6, 10}.Deltas() // Ints{2, 3, 4}
+//
+// The result has one less element than the input. If there are less than two
+// elements nil is returned.
+//
+// For unsigned types a decreasing value will wrap around.
+func (ss SliceType) Deltas() SliceType {
+	if len(ss) < 2 {
+		return nil
+	}
+
+	deltas := make(SliceType, len(ss)-1)
+	for i := 1; i < len(ss); i++ {
+		deltas[i-1] = ss[i] - ss[i-1]
+	}
+
+	return deltas
+}
@@ -0,0 +1,35 @@
+package functions
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+func (ss SliceType) Diff(against SliceType) (added, removed SliceType) {
+	counts := map[ElementType]int{}
+	for _, value := range ss {
+		counts[value]++
+	}
+
+	for _, value := range against {
+		if counts[value] > 0 {
+			counts[value]--
+		} else {
+			added = append(added, value)
+		}
+	}
+
+	// Anything remaining in counts has been removed. We iterate ss again to
+	// keep the original order.
+	for _, value := range ss {
+		if counts[value] > 0 {
+			counts[value]--
+			removed = append(removed, value)
+		}
+	}
+
+	return
+}
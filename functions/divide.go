@@ -0,0 +1,26 @@
+package functions
+
+// Divide returns a new slice where each element of ss has been divided by the
+// element at the same position in ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+//
+// Dividing integers by zero will panic, in the same way as the / operator.
+func (ss SliceType) Divide(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] / ss2[i]
+	}
+
+	return result
+}
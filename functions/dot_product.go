@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// DotProduct returns the sum of the products of the elements at the same
+// position in each slice.
+//
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements. The dot product of two empty slices is zero.
+func (ss SliceType) DotProduct(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	var product float64
+	for i, s := range ss {
+		product += float64(s) * float64(ss2[i])
+	}
+
+	return product, nil
+}
@@ -0,0 +1,21 @@
+package functions
+
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss SliceType) Drop(n int) (ss2 SliceType) {
+	if n < 0 {
+		n = 0
+	}
+
+	if n >= len(ss) {
+		return nil
+	}
+
+	ss2 = make(SliceType, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
+}
@@ -0,0 +1,13 @@
+package functions
+
+import (
+	"math"
+)
+
+// DropInf returns a new slice without any of the positive or negative infinity
+// elements. The remaining elements keep their original order.
+func (ss SliceType) DropInf() SliceType {
+	return ss.Select(func(s ElementType) bool {
+		return !math.IsInf(float64(s), 0)
+	})
+}
@@ -0,0 +1,13 @@
+package functions
+
+import (
+	"math"
+)
+
+// DropNaN returns a new slice without any of the NaN (not a number) elements.
+// The remaining elements keep their original order.
+func (ss SliceType) DropNaN() SliceType {
+	return ss.Select(func(s ElementType) bool {
+		return !math.IsNaN(float64(s))
+	})
+}
@@ -0,0 +1,16 @@
+package functions
+
+// DropNil returns a new slice with all of the nil elements removed. The order
+// of the remaining elements is retained. The returned slice may contain zero
+// elements (nil).
+//
+// DropNil is only available for slices of pointers.
+func (ss PointerSliceType) DropNil() (ss2 PointerSliceType) {
+	for _, s := range ss {
+		if s != nil {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
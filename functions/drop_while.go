@@ -0,0 +1,18 @@
+package functions
+
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See TakeWhile().
+func (ss SliceType) DropWhile(condition func(ElementType) bool) (ss2 SliceType) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
+	}
+
+	return
+}
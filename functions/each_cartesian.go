@@ -0,0 +1,55 @@
+package functions
+
+// EachCartesianSliceType calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
+//
+//   EachCartesianSliceType(func(values SliceType) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesianSliceType(fn func(SliceType) bool, slices ...SliceType) {
+	if len(slices) == 0 {
+		return
+	}
+
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	values := make(SliceType, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
+
+	for {
+		if !fn(values) {
+			return
+		}
+
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
+
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package functions
+
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
+//
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss SliceType) EachCombination(k int, fn func(SliceType) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	combination := make(SliceType, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
+
+	for {
+		if !fn(combination) {
+			return
+		}
+
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
+}
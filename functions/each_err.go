@@ -0,0 +1,13 @@
+package functions
+
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss SliceType) EachErr(fn func(ElementType) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
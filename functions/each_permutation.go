@@ -0,0 +1,46 @@
+package functions
+
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss SliceType) EachPermutation(fn func(SliceType) bool) {
+	n := len(ss)
+	if n == 0 {
+		return
+	}
+
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(SliceType, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
+	}
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+}
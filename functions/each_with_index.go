@@ -0,0 +1,16 @@
+package functions
+
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
+//
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
+//
+func (ss SliceType) EachWithIndex(fn func(int, ElementType)) SliceType {
+	for i, s := range ss {
+		fn(i, s)
+	}
+
+	return ss
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"math"
+)
+
+// Entropy is the Shannon entropy, in nats, of the elements treated as a
+// probability distribution. That is, the negative sum of each element
+// multiplied by its natural logarithm. Elements that are zero are ignored.
+//
+// The elements should be between 0 and 1 and add up to 1, such as the result
+// of Proportions or Softmax. Divide the result by math.Ln2 to get the entropy
+// in bits. Zero is returned if there are no elements.
+func (ss SliceType) Entropy() float64 {
+	var entropy float64
+	for _, s := range ss {
+		if p := float64(s); p != 0 {
+			entropy -= p * math.Log(p)
+		}
+	}
+
+	return entropy
+}
@@ -0,0 +1,17 @@
+package equality
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+//
+// Elements are compared to the zero value with ElementEquals.
+func (ss SliceType) CoalesceOr(defaultValue ElementType) ElementType {
+	var zero ElementType
+
+	for _, s := range ss {
+		if !ElementEquals(s, zero) {
+			return s
+		}
+	}
+
+	return defaultValue
+}
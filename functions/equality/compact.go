@@ -0,0 +1,19 @@
+package equality
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// Elements are compared to the zero value with ElementEquals.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Compact() (ss2 SliceType) {
+	var zero ElementType
+
+	for _, s := range ss {
+		if !ElementEquals(s, zero) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
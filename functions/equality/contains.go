@@ -0,0 +1,14 @@
+package equality
+
+// Contains returns true if the element exists in the slice.
+//
+// Elements are compared with ElementEquals.
+func (ss SliceType) Contains(lookingFor ElementType) bool {
+	for _, s := range ss {
+		if ElementEquals(s, lookingFor) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,36 @@
+package equality
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n*m).
+func (ss SliceType) Diff(against SliceType) (added, removed SliceType) {
+	// Each element in ss can only be matched once.
+	matched := make([]bool, len(ss))
+
+values:
+	for _, value := range against {
+		for i, s := range ss {
+			if !matched[i] && ElementEquals(s, value) {
+				matched[i] = true
+				continue values
+			}
+		}
+
+		added = append(added, value)
+	}
+
+	for i, s := range ss {
+		if !matched[i] {
+			removed = append(removed, s)
+		}
+	}
+
+	return
+}
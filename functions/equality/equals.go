@@ -0,0 +1,19 @@
+package equality
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// Elements are compared with ElementEquals.
+func (ss SliceType) Equals(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if !ElementEquals(s, ss2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
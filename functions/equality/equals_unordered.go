@@ -0,0 +1,30 @@
+package equality
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) EqualsUnordered(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	// Each element in ss2 can only be matched once.
+	matched := make([]bool, len(ss2))
+
+values:
+	for _, s := range ss {
+		for i, s2 := range ss2 {
+			if !matched[i] && ElementEquals(s, s2) {
+				matched[i] = true
+				continue values
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,17 @@
+package equality
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// Elements are compared with ElementEquals.
+//
+// See LastIndexOf() and Contains().
+func (ss SliceType) IndexOf(lookingFor ElementType) int {
+	for i, s := range ss {
+		if ElementEquals(s, lookingFor) {
+			return i
+		}
+	}
+
+	return -1
+}
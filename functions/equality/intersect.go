@@ -0,0 +1,26 @@
+package equality
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n*m).
+func (ss SliceType) Intersect(ss2 SliceType) (intersect SliceType) {
+values:
+	for _, value := range ss {
+		for _, existing := range intersect {
+			if ElementEquals(existing, value) {
+				continue values
+			}
+		}
+
+		for _, value2 := range ss2 {
+			if ElementEquals(value, value2) {
+				intersect = append(intersect, value)
+				continue values
+			}
+		}
+	}
+
+	return
+}
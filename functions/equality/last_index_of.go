@@ -0,0 +1,17 @@
+package equality
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// Elements are compared with ElementEquals.
+//
+// See IndexOf() and Contains().
+func (ss SliceType) LastIndexOf(lookingFor ElementType) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ElementEquals(ss[i], lookingFor) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,13 @@
+// Package equality contains the templates that are used instead of the
+// templates in the functions package when the elements are compared with an
+// Equals method or the -compare flag. These versions only compare elements
+// with ElementEquals, so they also work for elements that are not comparable
+// with ==.
+package equality
+
+type ElementType float64
+type SliceType []ElementType
+
+func ElementEquals(a, b ElementType) bool {
+	return a == b
+}
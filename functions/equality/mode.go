@@ -0,0 +1,42 @@
+package equality
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) Mode() (mode SliceType) {
+	// counts[i] is the number of times that ss[i] appears in ss, or zero if it
+	// is not the first occurrence.
+	counts := make([]int, len(ss))
+	highest := 0
+
+values:
+	for i, s := range ss {
+		for j := 0; j < i; j++ {
+			if ElementEquals(ss[j], s) {
+				continue values
+			}
+		}
+
+		for _, s2 := range ss[i:] {
+			if ElementEquals(s, s2) {
+				counts[i]++
+			}
+		}
+
+		if counts[i] > highest {
+			highest = counts[i]
+		}
+	}
+
+	for i, s := range ss {
+		if counts[i] == highest && highest > 0 {
+			mode = append(mode, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,24 @@
+package equality
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) Union(ss2 SliceType) (union SliceType) {
+	for _, slice := range []SliceType{ss, ss2} {
+	values:
+		for _, value := range slice {
+			for _, existing := range union {
+				if ElementEquals(existing, value) {
+					continue values
+				}
+			}
+
+			union = append(union, value)
+		}
+	}
+
+	return
+}
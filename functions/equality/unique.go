@@ -0,0 +1,37 @@
+package equality
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+//
+// See AreUnique().
+func (ss SliceType) Unique() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	uniqueValues := SliceType{}
+
+values:
+	for _, value := range ss {
+		for _, uniqueValue := range uniqueValues {
+			if ElementEquals(uniqueValue, value) {
+				continue values
+			}
+		}
+
+		uniqueValues = append(uniqueValues, value)
+	}
+
+	return uniqueValues
+}
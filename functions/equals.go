@@ -0,0 +1,19 @@
+package functions
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) Equals(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,24 @@
+package functions
+
+import (
+	"math"
+)
+
+// EqualsApprox returns true if both slices have the same number of elements
+// and each element is within epsilon of the element at the same position in
+// the other slice. A nil slice and an empty slice are considered equal.
+//
+// NaN is never approximately equal to any value.
+func (ss SliceType) EqualsApprox(ss2 SliceType, epsilon float64) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if !(math.Abs(float64(s)-float64(ss2[i])) <= epsilon) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,28 @@
+package functions
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) EqualsUnordered(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[ElementType]int{}
+	for _, s := range ss {
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
+		}
+
+		counts[s]--
+	}
+
+	return true
+}
@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"math"
+)
+
+// Exp returns a new slice with e raised to the power of each element.
+func (ss SliceType) Exp() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Exp(float64(s)))
+	}
+
+	return result
+}
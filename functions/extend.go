@@ -3,13 +3,26 @@ package functions
 // Extend will return a new slice with the slices of elements appended to the
 // end.
 //
+// Like Append, the result never shares the underlying array of ss.
+//
 // It is acceptable to provide zero arguments.
-func (ss SliceType) Extend(slices ...SliceType) (ss2 SliceType) {
-	ss2 = ss
+func (ss SliceType) Extend(slices ...SliceType) SliceType {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
+
+	// Avoid the allocation.
+	if n == 0 {
+		return ss
+	}
+
+	result := make(SliceType, len(ss), len(ss)+n)
+	copy(result, ss)
 
 	for _, slice := range slices {
-		ss2 = ss2.Append(slice...)
+		result = append(result, slice...)
 	}
 
-	return ss2
+	return result
 }
@@ -0,0 +1,16 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// FirstE returns the first element. Unlike First, pie.ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss SliceType) FirstE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
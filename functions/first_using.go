@@ -0,0 +1,17 @@
+package functions
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss SliceType) FirstUsing(condition func(ElementType) bool) (ElementType, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return ElementZeroValue, false
+}
@@ -0,0 +1,14 @@
+package functions
+
+// FlattenSliceType returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func FlattenSliceType(slices []SliceType) (ss SliceType) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
@@ -0,0 +1,20 @@
+package floats
+
+import (
+	"math"
+)
+
+// Abs returns a new slice with the absolute value of each element. The input
+// slice is not modified.
+func (ss SliceType) Abs() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Abs(float64(s)))
+	}
+
+	return result
+}
@@ -0,0 +1,14 @@
+package floats
+
+import (
+	"sort"
+)
+
+// AreSorted will return true if the slice is already sorted. It is a wrapper
+// for sort.SliceTypeAreSorted. NaN values are expected to be first, which is
+// the order produced by Sort.
+func (ss SliceType) AreSorted() bool {
+	return sort.SliceIsSorted(ss, func(i, j int) bool {
+		return ss[i] < ss[j] || (ss[i] != ss[i] && ss[j] == ss[j])
+	})
+}
@@ -0,0 +1,34 @@
+package floats
+
+import (
+	"sort"
+)
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
+//
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order, and NaN values are placed first
+// like Sort. The input slice is not modified and nil is returned if there are
+// no elements.
+func (ss SliceType) ArgSort() []int {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := ss[indexes[i]], ss[indexes[j]]
+
+		return a < b || (a != a && b == b)
+	})
+
+	return indexes
+}
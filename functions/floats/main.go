@@ -0,0 +1,7 @@
+// Package floats contains the templates that are used instead of the templates
+// in the functions package when the elements are floating point numbers. These
+// versions place NaN values first so that the order is deterministic.
+package floats
+
+type ElementType float64
+type SliceType []ElementType
@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see pie.RankMethod.
+//
+// NaN values are sorted first, so they have the lowest ranks, and are ranked
+// as equal to each other.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss SliceType) Rank(method pie.RankMethod) pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(pie.Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) {
+			// NaN is the only value that is not equal to itself.
+			a, b := ss[indexes[end]], ss[indexes[start]]
+			if a != b && (a == a || b == b) {
+				break
+			}
+
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case pie.RankMin:
+			rank = float64(start + 1)
+
+		case pie.RankMax:
+			rank = float64(end)
+
+		case pie.RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
@@ -0,0 +1,29 @@
+package floats
+
+import (
+	"sort"
+)
+
+// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// NaN values are placed before all other values, in the same way as
+// sort.Float64s, so that the order is deterministic.
+//
+// See Reverse() and AreSorted().
+func (ss SliceType) Sort() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]ElementType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		// NaN is the only value that is not equal to itself.
+		return sorted[i] < sorted[j] || (sorted[i] != sorted[i] && sorted[j] == sorted[j])
+	})
+
+	return sorted
+}
@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"math"
+)
+
+// Floor returns a new slice with the greatest integer value less than or equal
+// to each element.
+func (ss SliceType) Floor() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Floor(float64(s)))
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Format returns a new slice with each element formatted with fmt.Sprintf
+// using verb, which may include flags, width and precision:
+//
+//   prices.Format("%.2f")  // ["1.50", "20.00"]
+//   ids.Format("%05d")     // ["00042", "01337"]
+//
+// See Strings for the default format.
+func (ss SliceType) Format(verb string) pie.Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(pie.Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprintf(verb, s)
+	}
+
+	return result
+}
@@ -0,0 +1,15 @@
+package functions
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss SliceType) Frequencies() map[ElementType]int {
+	frequencies := make(map[ElementType]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
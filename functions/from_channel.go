@@ -0,0 +1,13 @@
+package functions
+
+// SliceTypeFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func SliceTypeFromChannel(ch <-chan ElementType) (ss SliceType) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
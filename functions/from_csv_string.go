@@ -0,0 +1,21 @@
+package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// SliceTypeFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func SliceTypeFromCSVString(s string) (ss SliceType, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
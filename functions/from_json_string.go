@@ -0,0 +1,9 @@
+package functions
+
+// SliceTypeFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func SliceTypeFromJSONString(s string) (ss SliceType, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
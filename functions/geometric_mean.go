@@ -0,0 +1,34 @@
+package functions
+
+import (
+	"math"
+)
+
+// GeometricMean is the nth root of the product of the n elements. It is the
+// appropriate average for rates of change and ratios, such as growth rates.
+//
+// The logarithms of the elements are summed rather than multiplying them so
+// that large slices do not overflow. If any element is zero the result is zero,
+// and if any element is negative the result is NaN. Zero is returned if there
+// are no elements.
+func (ss SliceType) GeometricMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		switch v := float64(s); {
+		case v < 0:
+			return math.NaN()
+
+		case v == 0:
+			return 0
+
+		default:
+			sum += math.Log(v)
+		}
+	}
+
+	return math.Exp(sum / float64(len(ss)))
+}
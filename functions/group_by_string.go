@@ -0,0 +1,20 @@
+package functions
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss SliceType) GroupByString(fn func(ElementType) string) map[string]SliceType {
+	group := map[string]SliceType{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
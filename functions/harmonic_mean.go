@@ -0,0 +1,24 @@
+package functions
+
+// HarmonicMean is the number of elements divided by the sum of their
+// reciprocals. It is the appropriate average for rates, such as speeds over the
+// same distance.
+//
+// If any element is zero the result is zero. Zero is returned if there are no
+// elements.
+func (ss SliceType) HarmonicMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		if s == 0 {
+			return 0
+		}
+
+		sum += 1 / float64(s)
+	}
+
+	return float64(len(ss)) / sum
+}
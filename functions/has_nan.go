@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"math"
+)
+
+// HasNaN returns true if any of the elements are NaN (not a number). A single
+// NaN causes functions like Sum and Average to also return NaN.
+func (ss SliceType) HasNaN() bool {
+	for _, s := range ss {
+		if math.IsNaN(float64(s)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+)
+
+// Histogram divides the range between the smallest and largest element into
+// bucketCount buckets of equal width and counts the elements in each.
+//
+// Each key describes the bucket, for example "[0, 2.5)". Every bucket is
+// half-open except for the last one, which also includes the largest element.
+// Buckets with no elements are included with a count of zero.
+//
+// NaN and infinite elements are excluded because they do not fall into any
+// bucket.
+//
+// If all of the elements are equal there is a single bucket, such as "[3, 3]".
+// An empty map is returned if there are no elements or bucketCount is less
+// than one.
+func (ss SliceType) Histogram(bucketCount int) map[string]int {
+	histogram := map[string]int{}
+	if bucketCount < 1 {
+		return histogram
+	}
+
+	var values []float64
+	for _, s := range ss {
+		if v := float64(s); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return histogram
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+
+	if min == max {
+		histogram[fmt.Sprintf("[%v, %v]", min, max)] = len(values)
+		return histogram
+	}
+
+	width := (max - min) / float64(bucketCount)
+	counts := make([]int, bucketCount)
+	for _, v := range values {
+		i := int((v - min) / width)
+		if i < 0 {
+			i = 0
+		} else if i >= bucketCount {
+			i = bucketCount - 1
+		}
+
+		counts[i]++
+	}
+
+	for i, count := range counts {
+		lower := min + float64(i)*width
+		if i == bucketCount-1 {
+			histogram[fmt.Sprintf("[%v, %v]", lower, max)] = count
+		} else {
+			histogram[fmt.Sprintf("[%v, %v)", lower, min+float64(i+1)*width)] = count
+		}
+	}
+
+	return histogram
+}
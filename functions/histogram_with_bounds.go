@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"sort"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// HistogramWithBounds counts the elements that fall between each of the bounds,
+// which must be sorted in ascending order. There is one more count than there
+// are bounds:
+//
+//   counts[0] is the number of elements less than bounds[0]
+//   counts[i] is the number of elements >= bounds[i-1] and < bounds[i]
+//   counts[len(bounds)] is the number of elements >= the last bound
+//
+// The result is undefined if the bounds are not sorted.
+func (ss SliceType) HistogramWithBounds(bounds pie.Float64s) []int {
+	counts := make([]int, len(bounds)+1)
+	for _, s := range ss {
+		v := float64(s)
+		i := sort.Search(len(bounds), func(i int) bool {
+			return bounds[i] > v
+		})
+		counts[i]++
+	}
+
+	return counts
+}
@@ -0,0 +1,17 @@
+package functions
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss SliceType) IndexOf(lookingFor ElementType) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
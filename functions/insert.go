@@ -0,0 +1,29 @@
+package functions
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss SliceType) Insert(index int, values ...ElementType) SliceType {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(SliceType, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"sort"
+)
+
+// InsertSorted returns a new slice with value inserted into a slice that is
+// already sorted in ascending order, so that the result is also sorted. If
+// value already exists it is inserted after the existing elements.
+//
+// The result is undefined if the slice is not sorted.
+func (ss SliceType) InsertSorted(value ElementType) SliceType {
+	index := sort.Search(len(ss), func(i int) bool {
+		return ss[i] > value
+	})
+
+	result := make(SliceType, len(ss)+1)
+	copy(result, ss[:index])
+	result[index] = value
+	copy(result[index+1:], ss[index:])
+
+	return result
+}
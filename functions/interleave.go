@@ -0,0 +1,20 @@
+package functions
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Interleave(ss2 SliceType) (ss3 SliceType) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+package functions
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+func (ss SliceType) Intersect(ss2 SliceType) (intersect SliceType) {
+	lookup := map[ElementType]struct{}{}
+	for _, value := range ss2 {
+		lookup[value] = struct{}{}
+	}
+
+	for _, value := range ss {
+		if _, ok := lookup[value]; ok {
+			intersect = append(intersect, value)
+
+			// Make sure the same element is not added again.
+			delete(lookup, value)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,22 @@
+//go:build go1.23
+// +build go1.23
+
+package functions
+
+import (
+	"iter"
+)
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss SliceType) Iter() iter.Seq[ElementType] {
+	return func(yield func(ElementType) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
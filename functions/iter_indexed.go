@@ -0,0 +1,22 @@
+//go:build go1.23
+// +build go1.23
+
+package functions
+
+import (
+	"iter"
+)
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss SliceType) IterIndexed() iter.Seq2[int, ElementType] {
+	return func(yield func(int, ElementType) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
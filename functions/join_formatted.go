@@ -0,0 +1,24 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JoinFormatted returns a string from joining each of the elements after they
+// have been formatted with the fmt verb provided:
+//
+//   Float64s{1.5, 2.25}.JoinFormatted(", ", "%.1f") // "1.5, 2.2"
+//
+func (ss SliceType) JoinFormatted(glue, verb string) string {
+	var sb strings.Builder
+	for i, element := range ss {
+		if i > 0 {
+			sb.WriteString(glue)
+		}
+
+		fmt.Fprintf(&sb, verb, element)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,21 @@
+package functions
+
+import (
+	"encoding/json"
+)
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
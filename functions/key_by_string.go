@@ -0,0 +1,19 @@
+package functions
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss SliceType) KeyByString(fn func(ElementType) string) map[string]ElementType {
+	m := make(map[string]ElementType, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
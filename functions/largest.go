@@ -0,0 +1,39 @@
+package functions
+
+import (
+	"sort"
+)
+
+// Largest returns the n largest elements in descending order. If the slice has
+// less than n elements then all elements are returned. If n < 1 it will return
+// nil.
+//
+// Unlike Sort().Reverse().Top(n) it does not sort the whole slice. Only the n
+// largest elements seen so far are kept, which is much faster when n is small
+// compared to the number of elements.
+//
+// This is not called Top because Top() already returns the first n elements
+// by position, regardless of their value.
+func (ss SliceType) Largest(n int) (largest SliceType) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(largest) < n {
+			largest = append(largest, s)
+		} else if !(largest[n-1] < s) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the smallest that will be dropped.
+		i := sort.Search(len(largest)-1, func(i int) bool {
+			return largest[i] < s
+		})
+		copy(largest[i+1:], largest[i:len(largest)-1])
+		largest[i] = s
+	}
+
+	return
+}
@@ -0,0 +1,17 @@
+package functions
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss SliceType) LastIndexOf(lookingFor ElementType) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
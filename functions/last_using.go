@@ -0,0 +1,18 @@
+package functions
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss SliceType) LastUsing(condition func(ElementType) bool) (ElementType, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return ElementZeroValue, false
+}
@@ -0,0 +1,92 @@
+package functions
+
+// SliceTypeLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type SliceTypeLazy struct {
+	iterate func(fn func(ElementType) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss SliceType) Lazy() SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l SliceTypeLazy) Select(condition func(ElementType) bool) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l SliceTypeLazy) Unselect(condition func(ElementType) bool) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l SliceTypeLazy) Transform(transform func(ElementType) ElementType) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l SliceTypeLazy) Top(n int) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s ElementType) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l SliceTypeLazy) Collect() (ss SliceType) {
+	l.iterate(func(s ElementType) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
@@ -0,0 +1,28 @@
+package functions
+
+// SliceTypeLinspace creates a slice of n evenly spaced values from start to
+// stop. Unlike SliceTypeRange, both start and stop are included:
+//
+//   SliceTypeLinspace(0, 1, 5)  // [0 0.25 0.5 0.75 1]
+//
+// If n is one the only value is start. nil is returned if n is less than one.
+func SliceTypeLinspace(start, stop ElementType, n int) SliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if n == 1 {
+		return SliceType{start}
+	}
+
+	step := (stop - start) / ElementType(n-1)
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = start + ElementType(i)*step
+	}
+
+	// Avoid a rounding error in the last value.
+	ss[n-1] = stop
+
+	return ss
+}
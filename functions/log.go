@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"math"
+)
+
+// Log returns a new slice with the natural logarithm of each element. Zero
+// becomes negative infinity and negative elements become NaN.
+func (ss SliceType) Log() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Log(float64(s)))
+	}
+
+	return result
+}
@@ -5,8 +5,18 @@ const (
 	ForStrings
 	ForStructs
 	ForMaps
+	ForBools
+	ForPointers
 
-	ForAll               = ForNumbers | ForStrings | ForStructs
+	// ForFloats is set in addition to ForNumbers for float32 and float64
+	// elements.
+	ForFloats
+
+	// ForIntegers is set in addition to ForNumbers for signed and unsigned
+	// integer elements.
+	ForIntegers
+
+	ForAll               = ForNumbers | ForStrings | ForStructs | ForBools
 	ForNumbersAndStrings = ForNumbers | ForStrings
 )
 
@@ -19,45 +29,270 @@ var Functions = []struct {
 	For  int
 }{
 	{"Abs", "abs.go", ForNumbers},
+	{"Accumulate", "accumulate.go", ForAll},
+	{"Add", "add.go", ForNumbers},
+	{"AddScalar", "add_scalar.go", ForNumbers},
 	{"All", "all.go", ForAll},
+	{"AllTrue", "all_true.go", ForBools},
 	{"Any", "any.go", ForAll},
+	{"AnyTrue", "any_true.go", ForBools},
 	{"Append", "append.go", ForAll},
+	{"ArgMax", "arg_max.go", ForNumbersAndStrings},
+	{"ArgMin", "arg_min.go", ForNumbersAndStrings},
+	{"ArgSort", "arg_sort.go", ForNumbersAndStrings},
 	{"AreSorted", "are_sorted.go", ForNumbersAndStrings},
 	{"AreUnique", "are_unique.go", ForNumbersAndStrings},
 	{"Average", "average.go", ForNumbers},
+	{"AverageAccurate", "average_accurate.go", ForFloats},
+	{"AverageBig", "average_big.go", ForIntegers},
+	{"AverageSkipNaN", "average_skip_nan.go", ForFloats},
 	{"Bottom", "bottom.go", ForAll},
+	{"Cartesian", "cartesian.go", ForAll},
+	{"Ceil", "ceil.go", ForFloats},
+	{"Chunk", "chunk.go", ForAll},
+	{"Clamp", "clamp.go", ForNumbers},
+	{"CoalesceOr", "coalesce_or.go", ForAll},
+	{"Collect", "collect.go", ForAll},
+	{"Combinations", "combinations.go", ForAll},
+	{"Compact", "compact.go", ForAll},
 	{"Contains", "contains.go", ForAll},
+	{"ContainsApprox", "contains_approx.go", ForFloats},
+	{"ContainsFold", "contains_fold.go", ForStrings},
+	{"Containing", "containing.go", ForStrings},
+	{"Correlation", "correlation.go", ForNumbers},
+	{"CountTrue", "count_true.go", ForBools},
+	{"CountUsing", "count_using.go", ForAll},
+	{"Covariance", "covariance.go", ForNumbers},
+	{"CSVString", "csv_string.go", ForAll},
+	{"CumulativeSum", "cumulative_sum.go", ForNumbers},
+	{"DeleteAt", "delete_at.go", ForAll},
+	{"Deltas", "deltas.go", ForNumbers},
+	{"Diff", "diff.go", ForNumbersAndStrings},
+	{"Divide", "divide.go", ForNumbers},
+	{"DotProduct", "dot_product.go", ForNumbers},
+	{"Drop", "drop.go", ForAll},
+	{"DropInf", "drop_inf.go", ForFloats},
+	{"DropNaN", "drop_nan.go", ForFloats},
+	{"DropNil", "drop_nil.go", ForPointers},
+	{"DropWhile", "drop_while.go", ForAll},
 	{"Each", "each.go", ForAll},
+	{"EachCartesian", "each_cartesian.go", ForAll},
+	{"EachCombination", "each_combination.go", ForAll},
+	{"EachErr", "each_err.go", ForAll},
+	{"EachPermutation", "each_permutation.go", ForAll},
+	{"EachWithIndex", "each_with_index.go", ForAll},
+	{"Entropy", "entropy.go", ForFloats},
+	{"Equals", "equals.go", ForAll},
+	{"EqualsApprox", "equals_approx.go", ForFloats},
+	{"EqualsUnordered", "equals_unordered.go", ForAll},
+	{"Exp", "exp.go", ForFloats},
 	{"Extend", "extend.go", ForAll},
 	{"First", "first.go", ForAll},
+	{"FirstE", "first_e.go", ForAll},
 	{"FirstOr", "first_or.go", ForAll},
 	{"Join", "join.go", ForStrings},
+	{"FirstUsing", "first_using.go", ForAll},
+	{"Flatten", "flatten.go", ForAll},
+	{"Floor", "floor.go", ForFloats},
+	{"Format", "format.go", ForNumbers},
+	{"Frequencies", "frequencies.go", ForAll},
+	{"FromCSVString", "from_csv_string.go", ForAll},
+	{"FromChannel", "from_channel.go", ForAll},
+	{"FromJSONString", "from_json_string.go", ForAll},
+	{"GeometricMean", "geometric_mean.go", ForNumbers},
+	{"GroupByString", "group_by_string.go", ForAll},
+	{"HarmonicMean", "harmonic_mean.go", ForNumbers},
+	{"HasNaN", "has_nan.go", ForFloats},
+	{"Histogram", "histogram.go", ForNumbers},
+	{"HistogramWithBounds", "histogram_with_bounds.go", ForNumbers},
+	{"IndexOf", "index_of.go", ForAll},
+	{"Insert", "insert.go", ForAll},
+	{"InsertSorted", "insert_sorted.go", ForNumbersAndStrings},
+	{"Interleave", "interleave.go", ForAll},
+	{"Intersect", "intersect.go", ForNumbersAndStrings},
+	{"Iter", "iter.go", ForAll},
+	{"IterIndexed", "iter_indexed.go", ForAll},
+	{"JoinFormatted", "join_formatted.go", ForNumbers},
+	{"JSONBytes", "json_bytes.go", ForAll},
+	{"JSONBytesIndent", "json_bytes_indent.go", ForAll},
 	{"JSONString", "json_string.go", ForAll},
+	{"JSONStringIndent", "json_string_indent.go", ForAll},
+	{"KeyByString", "key_by_string.go", ForAll},
 	{"Keys", "keys.go", ForMaps},
+	{"Largest", "largest.go", ForNumbersAndStrings},
 	{"Last", "last.go", ForAll},
+	{"LastIndexOf", "last_index_of.go", ForAll},
+	{"LastE", "last_e.go", ForAll},
 	{"LastOr", "last_or.go", ForAll},
+	{"LastUsing", "last_using.go", ForAll},
 	{"Len", "len.go", ForAll},
+	{"Lazy", "lazy.go", ForAll},
+	{"Linspace", "linspace.go", ForFloats},
+	{"Log", "log.go", ForFloats},
+	{"Map", "map.go", ForStrings},
+	{"MarshalBinary", "marshal_binary.go", ForNumbers},
+	{"MarshalJSON", "marshal_json.go", ForAll},
+	{"MarshalYAML", "marshal_yaml.go", ForAll},
+	{"MatchingRegexp", "matching_regexp.go", ForStrings},
 	{"Max", "max.go", ForNumbersAndStrings},
+	{"MaxE", "max_e.go", ForNumbersAndStrings},
 	{"Median", "median.go", ForNumbers},
+	{"MergeSorted", "merge_sorted.go", ForNumbersAndStrings},
+	{"MergeSortedUnique", "merge_sorted_unique.go", ForNumbersAndStrings},
 	{"Min", "min.go", ForNumbersAndStrings},
+	{"MinE", "min_e.go", ForNumbersAndStrings},
+	{"Mode", "mode.go", ForAll},
+	{"MovingAverage", "moving_average.go", ForNumbers},
+	{"Multiply", "multiply.go", ForNumbers},
+	{"MultiplyScalar", "multiply_scalar.go", ForNumbers},
+	{"Norm", "norm.go", ForNumbers},
+	{"Normalize", "normalize.go", ForNumbers},
+	{"NotMatchingRegexp", "not_matching_regexp.go", ForStrings},
+	{"OrderBy", "order_by.go", ForAll},
+	{"Outliers", "outliers.go", ForNumbers},
+	{"PadLeft", "pad_left.go", ForStrings},
+	{"PadRight", "pad_right.go", ForStrings},
+	{"Page", "page.go", ForAll},
+	{"Pairwise", "pairwise.go", ForAll},
+	{"ParseFloat64s", "parse_float64s.go", ForStrings},
+	{"ParseFloat64sSkipInvalid", "parse_float64s_skip_invalid.go", ForStrings},
+	{"ParseInts", "parse_ints.go", ForStrings},
+	{"ParseTimes", "parse_times.go", ForStrings},
+	{"Partition", "partition.go", ForAll},
+	{"Percentile", "percentile.go", ForNumbers},
+	{"Permutations", "permutations.go", ForAll},
+	{"Pop", "pop.go", ForAll},
+	{"Proportions", "proportions.go", ForFloats},
+	{"Quantiles", "quantiles.go", ForNumbers},
 	{"Random", "random.go", ForAll},
+	{"RandomIntegers", "random_integers.go", ForIntegers},
+	{"RandomNumbers", "random_numbers.go", ForFloats},
+	{"RandomStrings", "random_strings.go", ForStrings},
+	{"Range", "range.go", ForFloats},
+	{"RangeIntegers", "range_integers.go", ForIntegers},
+	{"Rank", "rank.go", ForNumbersAndStrings},
+	{"Reduce", "reduce.go", ForAll},
+	{"RemoveOutliers", "remove_outliers.go", ForNumbers},
 	{"Reverse", "reverse.go", ForAll},
+	{"Rolling", "rolling.go", ForNumbers},
+	{"Rotate", "rotate.go", ForAll},
+	{"RotateInPlace", "rotate_in_place.go", ForAll},
+	{"Round", "round.go", ForFloats},
+	{"Sample", "sample.go", ForAll},
+	{"ReverseInPlace", "reverse_in_place.go", ForAll},
+	{"Scan", "sql_scan.go", ForNumbersAndStrings | ForBools},
+	{"SearchSorted", "search_sorted.go", ForNumbersAndStrings},
 	{"Select", "select.go", ForAll},
+	{"Smallest", "smallest.go", ForNumbersAndStrings},
+	{"Softmax", "softmax.go", ForFloats},
 	{"Sort", "sort.go", ForNumbersAndStrings},
+	{"SortFold", "sort_fold.go", ForStrings},
+	{"SortInPlace", "sort_in_place.go", ForNumbersAndStrings},
+	{"SortNatural", "sort_natural.go", ForStrings},
+	{"SortStableUsing", "sort_stable_using.go", ForAll},
+	{"SortUsing", "sort_using.go", ForAll},
+	{"Sorted", "sorted.go", ForNumbersAndStrings},
+	{"Splice", "splice.go", ForAll},
+	{"SplitAt", "split_at.go", ForAll},
+	{"Sqrt", "sqrt.go", ForFloats},
+	{"StandardDeviation", "standard_deviation.go", ForNumbers},
+	{"Strings", "strings.go", ForNumbers},
+	{"Subtract", "subtract.go", ForNumbers},
 	{"Sum", "sum.go", ForNumbers},
+	{"SumAccurate", "sum_accurate.go", ForFloats},
+	{"SumChecked", "sum_checked.go", ForIntegers},
+	{"SumSkipNaN", "sum_skip_nan.go", ForFloats},
+	{"Shift", "shift.go", ForAll},
+	{"Swap", "swap.go", ForAll},
+	{"SwapInPlace", "swap_in_place.go", ForAll},
+	{"Set", "set.go", ForNumbersAndStrings},
 	{"Shuffle", "shuffle.go", ForAll},
+	{"ShuffleInPlace", "shuffle_in_place.go", ForAll},
+	{"Sync", "sync.go", ForAll},
+	{"Take", "take.go", ForAll},
+	{"TakeWhile", "take_while.go", ForAll},
 	{"Top", "top.go", ForAll},
+	{"ToChannel", "to_channel.go", ForAll},
+	{"ToChannelCtx", "to_channel_ctx.go", ForAll},
+	{"ToFloat64s", "to_float64s.go", ForAll},
+	{"ToFloat64sErr", "to_float64s_err.go", ForAll},
+	{"ToInts", "to_ints.go", ForAll},
+	{"ToIntsErr", "to_ints_err.go", ForAll},
+	{"ToLower", "to_lower.go", ForStrings},
+	{"ToSet", "to_set.go", ForAll},
 	{"ToStrings", "to_strings.go", ForAll},
+	{"ToUpper", "to_upper.go", ForStrings},
+	{"TotalPages", "total_pages.go", ForAll},
 	{"Transform", "transform.go", ForAll},
-	{"Unique", "unique.go", ForNumbersAndStrings},
+	{"TrimSpace", "trim_space.go", ForStrings},
+	{"TrimmedMean", "trimmed_mean.go", ForNumbers},
+	{"Truncate", "truncate.go", ForStrings},
+	{"Union", "union.go", ForNumbersAndStrings},
+	{"TransformErr", "transform_err.go", ForAll},
+	{"TransformInPlace", "transform_in_place.go", ForAll},
+	{"TransformParallel", "transform_parallel.go", ForAll},
+	{"Unique", "unique.go", ForAll},
+	{"UniqueApprox", "unique_approx.go", ForFloats},
+	{"UniqueFold", "unique_fold.go", ForStrings},
+	{"UnmarshalBinary", "unmarshal_binary.go", ForNumbers},
+	{"UnmarshalJSON", "unmarshal_json.go", ForAll},
+	{"UnmarshalYAML", "unmarshal_yaml.go", ForAll},
 	{"Unselect", "unselect.go", ForAll},
+	{"Value", "sql_value.go", ForNumbersAndStrings | ForBools},
 	{"Values", "values.go", ForMaps},
+	{"Variance", "variance.go", ForNumbers},
+	{"WeightedAverage", "weighted_average.go", ForNumbers},
+	{"Windows", "windows.go", ForAll},
+	{"WithPrefix", "with_prefix.go", ForStrings},
+	{"WithSuffix", "with_suffix.go", ForStrings},
+	{"Zip", "zip.go", ForAll},
+	{"ZipWith", "zip_with.go", ForAll},
+	{"ZScore", "z_score.go", ForNumbers},
+}
+
+// EqualityFunctions are the alternative templates in the equality directory.
+// They are used instead of the templates above for elements that have an
+// Equals method, or when the -compare flag is used. Functions without a file
+// rely on using elements as map keys so they are not generated in that case.
+var EqualityFunctions = map[string]string{
+	"CoalesceOr":      "coalesce_or.go",
+	"Compact":         "compact.go",
+	"Contains":        "contains.go",
+	"Diff":            "diff.go",
+	"Equals":          "equals.go",
+	"EqualsUnordered": "equals_unordered.go",
+	"Frequencies":     "",
+	"IndexOf":         "index_of.go",
+	"Intersect":       "intersect.go",
+	"LastIndexOf":     "last_index_of.go",
+	"Mode":            "mode.go",
+	"Set":             "",
+	"ToSet":           "",
+	"Union":           "union.go",
+	"Unique":          "unique.go",
+}
+
+// FloatFunctions are the alternative templates in the floats directory. They
+// are used instead of the templates above for float32 and float64 elements.
+var FloatFunctions = map[string]string{
+	"Abs":         "abs.go",
+	"AreSorted":   "are_sorted.go",
+	"ArgSort":     "arg_sort.go",
+	"Rank":        "rank.go",
+	"Sort":        "sort.go",
+	"SortInPlace": "sort_in_place.go",
 }
 
 type ElementType float64
 type SliceType []ElementType
 type StringElementType string
 type StringSliceType []StringElementType
+type BoolElementType bool
+type BoolSliceType []BoolElementType
+type PointerElementType *ElementType
+type PointerSliceType []PointerElementType
+type IntegerElementType int64
+type IntegerSliceType []IntegerElementType
 type KeyType string
 type KeySliceType []KeyType
 type MapType map[KeyType]ElementType
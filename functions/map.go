@@ -0,0 +1,17 @@
+package functions
+
+// Map works the same as Transform, except that fn always receives and returns a
+// string. This allows functions from the strings package (such as
+// strings.Title) to be used directly with slices of custom string types.
+func (ss StringSliceType) Map(fn func(string) string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	mapped := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		mapped[i] = StringElementType(fn(string(s)))
+	}
+
+	return mapped
+}
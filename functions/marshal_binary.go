@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. Each element is encoded
+// with a fixed number of bytes, which is much more compact than JSON. This is
+// also used by encoding/gob.
+//
+// Unlike JSONString, a nil slice and an empty slice are encoded differently so
+// that UnmarshalBinary can restore either one.
+func (ss SliceType) MarshalBinary() ([]byte, error) {
+	return util.MarshalBinary(reflect.ValueOf(ss))
+}
@@ -0,0 +1,16 @@
+package functions
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss SliceType) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]ElementType(ss))
+}
@@ -0,0 +1,12 @@
+package functions
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss SliceType) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []ElementType{}, nil
+	}
+
+	return []ElementType(ss), nil
+}
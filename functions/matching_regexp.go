@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"regexp"
+)
+
+// MatchingRegexp returns a new slice containing only the elements that match
+// the regular expression. The returned slice may contain zero elements (nil).
+//
+// NotMatchingRegexp works in the opposite way as MatchingRegexp.
+func (ss StringSliceType) MatchingRegexp(re *regexp.Regexp) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
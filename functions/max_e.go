@@ -0,0 +1,16 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MaxE is the maximum value. Unlike Max, pie.ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss SliceType) MaxE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss.Max(), nil
+}
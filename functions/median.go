@@ -1,7 +1,11 @@
 package functions
 
 // Median returns the value separating the higher half from the lower half of a
-// data sample.
+// data sample. When there is an even number of elements the mean of the two
+// middle values is used. The input slice is not modified.
+//
+// For integer types the mean of the two middle values is rounded toward zero,
+// so Ints{1, 2}.Median() is 1. It is calculated in a way that cannot overflow.
 //
 // Zero is returned if there are no elements in the slice.
 func (ss SliceType) Median() ElementType {
@@ -21,5 +25,16 @@ func (ss SliceType) Median() ElementType {
 		return sorted[l/2]
 	}
 
-	return (sorted[l/2-1] + sorted[l/2]) / 2
+	// Each case avoids an overflow and rounds integers toward zero, the same
+	// as integer division.
+	a, b := sorted[l/2-1], sorted[l/2]
+	switch {
+	case a >= 0:
+		return a + (b-a)/2
+
+	case b < 0:
+		return b - (b-a)/2
+	}
+
+	return (a + b) / 2
 }
@@ -0,0 +1,29 @@
+package functions
+
+// MergeSorted merges two slices that are already sorted in ascending order into
+// a new sorted slice in O(n+m), without needing to sort again. Duplicate
+// elements are retained. See MergeSortedUnique.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss SliceType) MergeSorted(ss2 SliceType) (merged SliceType) {
+	if len(ss)+len(ss2) == 0 {
+		return nil
+	}
+
+	merged = make(SliceType, 0, len(ss)+len(ss2))
+	i, j := 0, 0
+	for i < len(ss) && j < len(ss2) {
+		if ss2[j] < ss[i] {
+			merged = append(merged, ss2[j])
+			j++
+		} else {
+			merged = append(merged, ss[i])
+			i++
+		}
+	}
+
+	merged = append(merged, ss[i:]...)
+
+	return append(merged, ss2[j:]...)
+}
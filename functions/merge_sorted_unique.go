@@ -0,0 +1,28 @@
+package functions
+
+// MergeSortedUnique works the same as MergeSorted, except that each value will
+// only appear once in the result, even if it appears more than once in either
+// slice.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss SliceType) MergeSortedUnique(ss2 SliceType) (merged SliceType) {
+	add := func(value ElementType) {
+		if len(merged) == 0 || merged[len(merged)-1] != value {
+			merged = append(merged, value)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ss) || j < len(ss2) {
+		if i == len(ss) || (j < len(ss2) && ss2[j] < ss[i]) {
+			add(ss2[j])
+			j++
+		} else {
+			add(ss[i])
+			i++
+		}
+	}
+
+	return
+}
@@ -0,0 +1,16 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MinE is the minimum value. Unlike Min, pie.ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss SliceType) MinE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss.Min(), nil
+}
@@ -0,0 +1,30 @@
+package functions
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss SliceType) Mode() (mode SliceType) {
+	counts := map[ElementType]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
@@ -0,0 +1,34 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MovingAverage returns the simple moving average of each window of size
+// consecutive elements. The result will have len(ss)-window+1 elements.
+//
+// It keeps a running total so each element is only visited twice, regardless
+// of the window size.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss SliceType) MovingAverage(window int) pie.Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	var sum float64
+	for _, s := range ss[:window] {
+		sum += float64(s)
+	}
+
+	averages := make(pie.Float64s, len(ss)-window+1)
+	averages[0] = sum / float64(window)
+
+	for i := window; i < len(ss); i++ {
+		sum += float64(ss[i]) - float64(ss[i-window])
+		averages[i-window+1] = sum / float64(window)
+	}
+
+	return averages
+}
@@ -0,0 +1,16 @@
+package functions
+
+// MultiplyScalar returns a new slice with each element multiplied by value. It
+// can be used to scale all of the elements.
+func (ss SliceType) MultiplyScalar(value ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = s * value
+	}
+
+	return result
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"math"
+)
+
+// Norm returns the Euclidean norm (also known as the magnitude or L2 norm) of
+// the elements, treating the slice as a vector. Zero is returned if there are
+// no elements.
+func (ss SliceType) Norm() float64 {
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s) * float64(s)
+	}
+
+	return math.Sqrt(sum)
+}
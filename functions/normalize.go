@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Normalize returns a new slice where each element has been rescaled to be
+// between 0 and 1 (min-max scaling). The smallest element becomes 0 and the
+// largest element becomes 1.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss SliceType) Normalize() pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	min, max := float64(ss.Min()), float64(ss.Max())
+	spread := max - min
+
+	normalized := make(pie.Float64s, len(ss))
+	if spread == 0 {
+		return normalized
+	}
+
+	for i, s := range ss {
+		normalized[i] = (float64(s) - min) / spread
+	}
+
+	return normalized
+}
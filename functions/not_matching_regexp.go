@@ -0,0 +1,18 @@
+package functions
+
+import (
+	"regexp"
+)
+
+// NotMatchingRegexp returns a new slice containing only the elements that do
+// not match the regular expression. The returned slice may contain zero
+// elements (nil).
+func (ss StringSliceType) NotMatchingRegexp(re *regexp.Regexp) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if !re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
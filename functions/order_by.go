@@ -0,0 +1,42 @@
+package functions
+
+import (
+	"sort"
+)
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss SliceType) OrderBy(less ...func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
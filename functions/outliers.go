@@ -0,0 +1,16 @@
+package functions
+
+// Outliers returns the elements that are more than multiplier times the
+// interquartile range (IQR) below the first quartile or above the third
+// quartile. A multiplier of 1.5 is the conventional choice, and 3 only finds
+// extreme outliers.
+//
+// The elements are returned in their original order. See RemoveOutliers.
+func (ss SliceType) Outliers(multiplier float64) SliceType {
+	q1, q3 := float64(ss.Percentile(25)), float64(ss.Percentile(75))
+	lower, upper := q1-multiplier*(q3-q1), q3+multiplier*(q3-q1)
+
+	return ss.Select(func(s ElementType) bool {
+		return float64(s) < lower || float64(s) > upper
+	})
+}
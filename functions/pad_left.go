@@ -0,0 +1,35 @@
+package functions
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// PadLeft returns a new slice where each element shorter than width characters
+// has pad repeated on the left until it is width characters. This is useful for
+// right aligning values:
+//
+//   Strings{"7", "42"}.PadLeft(3, " ") // ["  7", " 42"]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss StringSliceType) PadLeft(width int, pad string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = StringElementType(string(padding) + string(s))
+	}
+
+	return padded
+}
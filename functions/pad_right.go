@@ -0,0 +1,35 @@
+package functions
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// PadRight returns a new slice where each element shorter than width
+// characters has pad repeated on the right until it is width characters. This
+// is useful for left aligning values in columns:
+//
+//   Strings{"a", "abc"}.PadRight(4, ".") // ["a...", "abc."]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss StringSliceType) PadRight(width int, pad string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = StringElementType(string(s) + string(padding))
+	}
+
+	return padded
+}
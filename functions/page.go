@@ -0,0 +1,34 @@
+package functions
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss SliceType) Page(pageNumber, pageSize int) SliceType {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
@@ -0,0 +1,13 @@
+package functions
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss SliceType) Pairwise() (pairs [][2]ElementType) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]ElementType{ss[i-1], ss[i]})
+	}
+
+	return
+}
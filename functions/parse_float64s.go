@@ -0,0 +1,30 @@
+package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseFloat64s converts each element to a float64 with strconv.ParseFloat. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first. See
+// ParseFloat64sSkipInvalid to keep the elements that can be parsed.
+func (ss StringSliceType) ParseFloat64s() (pie.Float64s, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Float64s, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
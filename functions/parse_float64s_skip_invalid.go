@@ -0,0 +1,27 @@
+package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseFloat64sSkipInvalid converts each element to a float64 with
+// strconv.ParseFloat. Unlike ParseFloat64s, elements that cannot be parsed do
+// not stop the conversion. Instead they are returned separately as invalid, so
+// both slices keep the original order of the elements.
+//
+// Either slice may contain zero elements (nil).
+func (ss StringSliceType) ParseFloat64sSkipInvalid() (valid pie.Float64s, invalid StringSliceType) {
+	for _, s := range ss {
+		f, err := strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			invalid = append(invalid, s)
+			continue
+		}
+
+		valid = append(valid, f)
+	}
+
+	return
+}
@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseInts converts each element to an int with strconv.Atoi. It will stop at
+// the first element that cannot be parsed and return a nil slice with the
+// error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first.
+func (ss StringSliceType) ParseInts() (pie.Ints, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Ints, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.Atoi(string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"time"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseTimes converts each element to a time with time.Parse using layout. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error.
+//
+// See pie.Times.Format for the reverse.
+func (ss StringSliceType) ParseTimes(layout string) (pie.Times, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Times, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = time.Parse(layout, string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,19 @@
+package functions
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss SliceType) Partition(condition func(ElementType) bool) (matching, rest SliceType) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"math"
+	"sort"
+)
+
+// Percentile returns the value below which p percent of the elements fall. p
+// must be between 0 and 100, values outside of this range will be clamped.
+//
+// When the percentile lies between two elements the result is linearly
+// interpolated between them. This is the same method used by Excel's
+// PERCENTILE.INC and NumPy's default percentile.
+//
+//   latencies.Percentile(95)
+//
+// Zero is returned if there are no elements and NaN is returned if p is NaN.
+// The input slice is not modified.
+func (ss SliceType) Percentile(p float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(l-1)
+	lower := int(rank)
+	if lower == l-1 {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
@@ -0,0 +1,20 @@
+package functions
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss SliceType) Permutations() (permutations []SliceType) {
+	ss.EachPermutation(func(permutation SliceType) bool {
+		p := make(SliceType, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
@@ -0,0 +1,21 @@
+package functions
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss SliceType) Pop() (last ElementType, rest SliceType) {
+	n := len(ss)
+	if n == 0 {
+		return ElementZeroValue, nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
@@ -0,0 +1,25 @@
+package functions
+
+// Proportions returns a new slice where each element is divided by the sum of
+// all of the elements. If the elements are all positive the result is a
+// probability distribution that adds up to 1.
+//
+// If the elements add up to zero every element in the result will be NaN or
+// infinity. nil is returned if there are no elements.
+func (ss SliceType) Proportions() SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s)
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(float64(s) / sum)
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+	"sort"
+)
+
+// Quantiles returns the n-1 cut points that divide the elements into n groups
+// of equal size. For example, Quantiles(4) returns the quartiles and
+// Quantiles(100) returns the percentiles.
+//
+// Each cut point is linearly interpolated in the same way as Percentile(), but
+// the elements are only sorted once. If there are no elements, or n is less
+// than 2, nil is returned.
+func (ss SliceType) Quantiles(n int) pie.Float64s {
+	l := len(ss)
+	if l == 0 || n < 2 {
+		return nil
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	quantiles := make(pie.Float64s, n-1)
+	for i := range quantiles {
+		rank := float64(i+1) / float64(n) * float64(l-1)
+		lower := int(rank)
+
+		if lower == l-1 {
+			quantiles[i] = sorted[lower]
+		} else {
+			quantiles[i] = sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+		}
+	}
+
+	return quantiles
+}
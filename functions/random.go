@@ -2,9 +2,12 @@ package functions
 
 import (
 	"math/rand"
+	"time"
 )
 
-// Random returns a random element by your rand.Source, or zero
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
 func (ss SliceType) Random(source rand.Source) ElementType {
 	n := len(ss)
 
@@ -15,6 +18,11 @@ func (ss SliceType) Random(source rand.Source) ElementType {
 	if n < 2 {
 		return ss[0]
 	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
 	rnd := rand.New(source)
 	i := rnd.Intn(n)
 	return ss[i]
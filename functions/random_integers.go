@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RandomIntegerSliceType creates a slice of n random values that are greater
+// than or equal to min and less than max. The values are evenly distributed
+// across the whole numbers in that range, which may be as wide as the element
+// type allows.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one, and every value will be min if
+// max is not greater than min.
+func RandomIntegerSliceType(n int, min, max IntegerElementType, source rand.Source) IntegerSliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	ss := make(IntegerSliceType, n)
+	for i := range ss {
+		ss[i] = min
+	}
+
+	if max <= min {
+		return ss
+	}
+
+	// The width is calculated with uint64 so that it cannot overflow, even for
+	// the full range of an int64. Values at or above limit are rejected so
+	// that the modulo does not favour the smaller values.
+	width := uint64(max) - uint64(min)
+	limit := math.MaxUint64 - math.MaxUint64%width
+
+	rnd := rand.New(source)
+	for i := range ss {
+		v := rnd.Uint64()
+		for v >= limit {
+			v = rnd.Uint64()
+		}
+
+		ss[i] += IntegerElementType(v % width)
+	}
+
+	return ss
+}
@@ -0,0 +1,36 @@
+package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RandomSliceType creates a slice of n random values that are greater than or
+// equal to min and less than max.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one, and every value will be min if
+// max is not greater than min.
+func RandomSliceType(n int, min, max ElementType, source rand.Source) SliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	width := float64(max) - float64(min)
+	if width < 0 {
+		width = 0
+	}
+
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = min + ElementType(rnd.Float64()*width)
+	}
+
+	return ss
+}
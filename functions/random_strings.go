@@ -0,0 +1,37 @@
+package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RandomStringSliceType creates a slice of n random strings that each contain
+// length letters and digits.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one.
+func RandomStringSliceType(n, length int, source rand.Source) StringSliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	rnd := rand.New(source)
+	ss := make(StringSliceType, n)
+	for i := range ss {
+		b := make([]byte, length)
+		for j := range b {
+			b[j] = chars[rnd.Intn(len(chars))]
+		}
+
+		ss[i] = StringElementType(b)
+	}
+
+	return ss
+}
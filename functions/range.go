@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"math"
+)
+
+// SliceTypeRange creates a slice of the values from start up to, but not
+// including, stop, increasing by step each time. A negative step counts down
+// from start to stop instead:
+//
+//   SliceTypeRange(0, 10, 3)  // [0 3 6 9]
+//   SliceTypeRange(5, 0, -2)  // [5 3 1]
+//
+// Each value is calculated from start rather than adding step repeatedly, so
+// rounding errors do not accumulate for floating point types. nil is returned
+// if step is zero or moves away from stop.
+func SliceTypeRange(start, stop, step ElementType) SliceType {
+	if step == 0 {
+		return nil
+	}
+
+	n := int(math.Ceil((float64(stop) - float64(start)) / float64(step)))
+	if n <= 0 {
+		return nil
+	}
+
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = start + ElementType(i)*step
+	}
+
+	return ss
+}
@@ -0,0 +1,37 @@
+package functions
+
+// IntegerSliceTypeRange creates a slice of the values from start up to, but not
+// including, stop, increasing by step each time. A negative step counts down
+// from start to stop instead:
+//
+//   IntegerSliceTypeRange(0, 10, 3)  // [0 3 6 9]
+//   IntegerSliceTypeRange(5, 0, -2)  // [5 3 1]
+//
+// The number of values is calculated with uint64 so that it is exact, even
+// for the full range of an int64. nil is returned if step is zero or moves away
+// from stop.
+func IntegerSliceTypeRange(start, stop, step IntegerElementType) IntegerSliceType {
+	var width, size uint64
+	switch {
+	case step > 0 && stop > start:
+		width, size = uint64(stop)-uint64(start), uint64(step)
+
+	case step < 0 && stop < start:
+		width, size = uint64(start)-uint64(stop), -uint64(step)
+
+	default:
+		return nil
+	}
+
+	n := width / size
+	if width%size != 0 {
+		n++
+	}
+
+	ss := make(IntegerSliceType, n)
+	for i := range ss {
+		ss[i] = start + IntegerElementType(i)*step
+	}
+
+	return ss
+}
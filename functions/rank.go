@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see pie.RankMethod.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss SliceType) Rank(method pie.RankMethod) pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(pie.Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) && ss[indexes[end]] == ss[indexes[start]] {
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case pie.RankMin:
+			rank = float64(start + 1)
+
+		case pie.RankMax:
+			rank = float64(end)
+
+		case pie.RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
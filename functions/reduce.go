@@ -0,0 +1,19 @@
+package functions
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss SliceType) Reduce(initial ElementType, fn func(acc, value ElementType) ElementType) ElementType {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
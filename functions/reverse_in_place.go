@@ -0,0 +1,14 @@
+package functions
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss SliceType) ReverseInPlace() SliceType {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rolling calls fn for every window of size consecutive elements and returns
+// the results. The window slides one element at a time, so the result will
+// have len(ss)-window+1 elements:
+//
+//   prices.Rolling(3, func (window Float64s) float64 {
+//       return window.Max()
+//   })
+//
+// The window passed to fn shares memory with the input slice. It must not be
+// modified or retained after fn returns.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss SliceType) Rolling(window int, fn func(SliceType) float64) pie.Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	results := make(pie.Float64s, len(ss)-window+1)
+	for i := range results {
+		results[i] = fn(ss[i : i+window : i+window])
+	}
+
+	return results
+}
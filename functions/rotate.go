@@ -0,0 +1,23 @@
+package functions
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss SliceType) Rotate(n int) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(SliceType, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
@@ -0,0 +1,31 @@
+package functions
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss SliceType) RotateInPlace(n int) SliceType {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s SliceType) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"math"
+)
+
+// Round returns a new slice with each element rounded to the number of
+// decimal places. Halfway values are rounded away from zero, like math.Round.
+// A negative number of decimals rounds to the left of the decimal point, so -2
+// rounds to the nearest hundred.
+func (ss SliceType) Round(decimals int) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	scale := math.Pow10(decimals)
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Round(float64(s)*scale) / scale)
+	}
+
+	return result
+}
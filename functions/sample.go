@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss SliceType) Sample(n int, source rand.Source) SliceType {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SearchSorted uses a binary search to find value in a slice that is already
+// sorted in ascending order (see Sort). If value exists, index is the position
+// of the first occurrence and found is true. Otherwise, index is the position
+// where value would need to be inserted to keep the slice sorted.
+//
+// The result is undefined if the slice is not sorted.
+func (ss SliceType) SearchSorted(value ElementType) (index int, found bool) {
+	index = sort.Search(len(ss), func(i int) bool {
+		return ss[i] >= value
+	})
+
+	return index, index < len(ss) && ss[index] == value
+}
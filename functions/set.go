@@ -0,0 +1,123 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SliceTypeSet is an unordered collection of unique elements backed by a map.
+// Unlike the slice based Contains, checking membership is O(1), which makes it
+// a better fit for membership-heavy workloads.
+//
+// A nil set can be read from but not added to. Use NewSliceTypeSet or convert
+// the result of ToSet to create one.
+type SliceTypeSet map[ElementType]struct{}
+
+// NewSliceTypeSet creates a set containing each of the values. Duplicate values
+// are only stored once.
+func NewSliceTypeSet(values ...ElementType) SliceTypeSet {
+	s := make(SliceTypeSet, len(values))
+
+	return s.Add(values...)
+}
+
+// Set returns a set of the unique elements in the slice.
+func (ss SliceType) Set() SliceTypeSet {
+	return NewSliceTypeSet(ss...)
+}
+
+// Add will insert each of the values into the set. The set is modified in place
+// and returned for chaining.
+func (s SliceTypeSet) Add(values ...ElementType) SliceTypeSet {
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+
+	return s
+}
+
+// Remove will delete each of the values from the set. Values that are not in
+// the set are ignored. The set is modified in place and returned for chaining.
+func (s SliceTypeSet) Remove(values ...ElementType) SliceTypeSet {
+	for _, value := range values {
+		delete(s, value)
+	}
+
+	return s
+}
+
+// Contains returns true if the value is in the set.
+func (s SliceTypeSet) Contains(value ElementType) bool {
+	_, ok := s[value]
+
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s SliceTypeSet) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing the elements that are in either set.
+func (s SliceTypeSet) Union(s2 SliceTypeSet) SliceTypeSet {
+	union := make(SliceTypeSet, len(s)+len(s2))
+	for value := range s {
+		union[value] = struct{}{}
+	}
+
+	for value := range s2 {
+		union[value] = struct{}{}
+	}
+
+	return union
+}
+
+// Intersect returns a new set containing the elements that are in both sets.
+func (s SliceTypeSet) Intersect(s2 SliceTypeSet) SliceTypeSet {
+	// Iterate over the smaller set.
+	if len(s2) < len(s) {
+		s, s2 = s2, s
+	}
+
+	intersect := make(SliceTypeSet)
+	for value := range s {
+		if _, ok := s2[value]; ok {
+			intersect[value] = struct{}{}
+		}
+	}
+
+	return intersect
+}
+
+// Diff returns a new set containing the elements that are in s but not in s2.
+//
+// This is different from the slice Diff, which returns both the added and
+// removed elements.
+func (s SliceTypeSet) Diff(s2 SliceTypeSet) SliceTypeSet {
+	diff := make(SliceTypeSet)
+	for value := range s {
+		if _, ok := s2[value]; !ok {
+			diff[value] = struct{}{}
+		}
+	}
+
+	return diff
+}
+
+// Slice returns the elements of the set as a slice in ascending order. An empty
+// set returns nil.
+func (s SliceTypeSet) Slice() SliceType {
+	if len(s) == 0 {
+		return nil
+	}
+
+	ss := make(SliceType, 0, len(s))
+	for value := range s {
+		ss = append(ss, value)
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
@@ -0,0 +1,19 @@
+package functions
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss SliceType) Shift() (first ElementType, rest SliceType) {
+	if len(ss) == 0 {
+		return ElementZeroValue, nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
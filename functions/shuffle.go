@@ -3,9 +3,14 @@ package functions
 import (
 	"github.com/elliotchance/pie/pie/util"
 	"math/rand"
+	"time"
 )
 
-// Shuffle returns shuffled slice by your rand.Source
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
 func (ss SliceType) Shuffle(source rand.Source) SliceType {
 	n := len(ss)
 
@@ -20,6 +25,10 @@ func (ss SliceType) Shuffle(source rand.Source) SliceType {
 	shuffled := make([]ElementType, n)
 	copy(shuffled, ss)
 
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
 	rnd := rand.New(source)
 
 	util.Shuffle(rnd, n, func(i, j int) {
@@ -0,0 +1,28 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie/util"
+	"math/rand"
+	"time"
+)
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss SliceType) ShuffleInPlace(source rand.Source) SliceType {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
@@ -0,0 +1,39 @@
+package functions
+
+import (
+	"sort"
+)
+
+// Smallest returns the n smallest elements in ascending order. If the slice
+// has less than n elements then all elements are returned. If n < 1 it will
+// return nil.
+//
+// Unlike Sort().Top(n) it does not sort the whole slice. Only the n smallest
+// elements seen so far are kept, which is much faster when n is small compared
+// to the number of elements.
+//
+// This is not called Bottom because Bottom() already returns the last n
+// elements by position, regardless of their value.
+func (ss SliceType) Smallest(n int) (smallest SliceType) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(smallest) < n {
+			smallest = append(smallest, s)
+		} else if !(s < smallest[n-1]) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the largest that will be dropped.
+		i := sort.Search(len(smallest)-1, func(i int) bool {
+			return s < smallest[i]
+		})
+		copy(smallest[i+1:], smallest[i:len(smallest)-1])
+		smallest[i] = s
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+package functions
+
+import (
+	"math"
+)
+
+// Softmax returns a new slice where each element is e raised to the power of
+// the element, divided by the sum of those values. The result is a probability
+// distribution where each element is between 0 and 1 and the elements add up
+// to 1. Larger elements receive exponentially larger probabilities.
+//
+// The largest element is subtracted before exponentiating so that large
+// elements do not overflow. nil is returned if there are no elements.
+func (ss SliceType) Softmax() SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	max := float64(ss[0])
+	for _, s := range ss[1:] {
+		if float64(s) > max {
+			max = float64(s)
+		}
+	}
+
+	exps := make([]float64, len(ss))
+	var sum float64
+	for i, s := range ss {
+		exps[i] = math.Exp(float64(s) - max)
+		sum += exps[i]
+	}
+
+	result := make(SliceType, len(ss))
+	for i, e := range exps {
+		result[i] = ElementType(e / sum)
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortFold works the same as Sort, except that the comparison ignores case.
+// Elements that only differ by case will retain their original order.
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss StringSliceType) SortFold() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(StringSliceType, len(ss))
+	copy(sorted, ss)
+
+	keys := make(map[StringElementType]string, len(ss))
+	for _, s := range ss {
+		keys[s] = strings.ToLower(strings.ToUpper(string(s)))
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keys[sorted[i]] < keys[sorted[j]]
+	})
+
+	return sorted
+}
@@ -0,0 +1,18 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SortInPlace works the same as Sort, except that the elements are sorted in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Sort if you need to keep the original.
+func (ss SliceType) SortInPlace() SliceType {
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
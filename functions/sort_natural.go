@@ -0,0 +1,27 @@
+package functions
+
+import (
+	"sort"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// SortNatural works the same as Sort, except that runs of digits are compared
+// by their numeric value. That is, "file2" will be sorted before "file10".
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss StringSliceType) SortNatural() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(StringSliceType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return util.NaturalLess(string(sorted[i]), string(sorted[j]))
+	})
+
+	return sorted
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss SliceType) SortStableUsing(less func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
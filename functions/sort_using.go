@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss SliceType) SortUsing(less func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
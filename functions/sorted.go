@@ -0,0 +1,112 @@
+package functions
+
+import (
+	"sort"
+)
+
+// SliceTypeSorted keeps its elements sorted in ascending order as they are
+// inserted. This allows Contains, Index and RangeBetween to use a binary
+// search rather than scanning every element.
+//
+// The zero value is an empty sorted slice that is ready to use.
+type SliceTypeSorted struct {
+	ss SliceType
+}
+
+// NewSliceTypeSorted creates a sorted slice containing each of the values.
+// The values are copied so they are not modified.
+func NewSliceTypeSorted(values ...ElementType) *SliceTypeSorted {
+	ss := make(SliceType, len(values))
+	copy(ss, values)
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return &SliceTypeSorted{ss: ss}
+}
+
+// search returns the index of the first element that is not less than value.
+func (s *SliceTypeSorted) search(value ElementType) int {
+	return sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] >= value
+	})
+}
+
+// Insert adds each of the values in their sorted position. Each insert is
+// O(log n) to find the position and O(n) to make room for the value.
+func (s *SliceTypeSorted) Insert(values ...ElementType) *SliceTypeSorted {
+	for _, value := range values {
+		i := s.search(value)
+		s.ss = append(s.ss, ElementZeroValue)
+		copy(s.ss[i+1:], s.ss[i:])
+		s.ss[i] = value
+	}
+
+	return s
+}
+
+// Remove deletes the first occurrence of value and returns true. If value does
+// not exist false is returned.
+func (s *SliceTypeSorted) Remove(value ElementType) bool {
+	i := s.Index(value)
+	if i == -1 {
+		return false
+	}
+
+	s.ss = append(s.ss[:i], s.ss[i+1:]...)
+
+	return true
+}
+
+// Contains returns true if the value exists.
+func (s *SliceTypeSorted) Contains(value ElementType) bool {
+	return s.Index(value) != -1
+}
+
+// Index returns the position of the first occurrence of value, or -1 if it
+// does not exist.
+func (s *SliceTypeSorted) Index(value ElementType) int {
+	i := s.search(value)
+	if i < len(s.ss) && s.ss[i] == value {
+		return i
+	}
+
+	return -1
+}
+
+// RangeBetween returns a new slice of the elements that are greater than or
+// equal to min and less than or equal to max, in ascending order. If min is
+// greater than max nil is returned.
+func (s *SliceTypeSorted) RangeBetween(min, max ElementType) SliceType {
+	start := s.search(min)
+	end := sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] > max
+	})
+
+	if start >= end {
+		return nil
+	}
+
+	result := make(SliceType, end-start)
+	copy(result, s.ss[start:end])
+
+	return result
+}
+
+// Len returns the number of elements.
+func (s *SliceTypeSorted) Len() int {
+	return len(s.ss)
+}
+
+// Slice returns a copy of the elements in ascending order. An empty sorted
+// slice returns nil.
+func (s *SliceTypeSorted) Slice() SliceType {
+	if len(s.ss) == 0 {
+		return nil
+	}
+
+	result := make(SliceType, len(s.ss))
+	copy(result, s.ss)
+
+	return result
+}
@@ -0,0 +1,38 @@
+package functions
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Splice(start, deleteCount int, values ...ElementType) SliceType {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(SliceType, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
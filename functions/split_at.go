@@ -0,0 +1,27 @@
+package functions
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss SliceType) SplitAt(index int) (left, right SliceType) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// Scan implements sql.Scanner so that an array column can be scanned directly
+// into the slice. It accepts a one-dimensional PostgreSQL array literal, such
+// as {1,2,3}.
+//
+// NULL is scanned as an empty slice. An error is returned if any of the
+// elements are NULL.
+func (ss *SliceType) Scan(src interface{}) error {
+	return util.ScanArrayLiteral(src, reflect.ValueOf(ss))
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// Value implements driver.Valuer so that the slice can be used as a query
+// argument for an array column. It is encoded as a PostgreSQL array literal,
+// such as {1,2,3}.
+//
+// A nil slice is encoded as an empty array rather than NULL.
+func (ss SliceType) Value() (driver.Value, error) {
+	return util.ArrayLiteral(reflect.ValueOf(ss)), nil
+}
@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"math"
+)
+
+// Sqrt returns a new slice with the square root of each element. Negative
+// elements become NaN.
+func (ss SliceType) Sqrt() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Sqrt(float64(s)))
+	}
+
+	return result
+}
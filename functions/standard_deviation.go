@@ -0,0 +1,11 @@
+package functions
+
+import (
+	"math"
+)
+
+// StandardDeviation is the population standard deviation of the elements. It
+// is the square root of Variance(). Zero is returned if there are no elements.
+func (ss SliceType) StandardDeviation() float64 {
+	return math.Sqrt(ss.Variance())
+}
@@ -0,0 +1,23 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Strings returns a new slice with each element formatted in the default
+// format, the same as fmt.Sprint. Element types that have a String method,
+// such as time.Duration, use that method. See Format to control the format.
+func (ss SliceType) Strings() pie.Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(pie.Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprint(s)
+	}
+
+	return result
+}
@@ -0,0 +1,24 @@
+package functions
+
+// Subtract returns a new slice where each element of ss2 has been subtracted
+// from the element at the same position in ss.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss SliceType) Subtract(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] - ss2[i]
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package functions
+
+import (
+	"math"
+)
+
+// SumAccurate is the sum of all of the elements using compensated
+// (Kahan-Babuska) summation. It tracks the rounding error lost from each
+// addition and adds it back at the end, so it is much more accurate than Sum
+// when adding many values or values with very different magnitudes. It is a
+// little slower.
+func (ss SliceType) SumAccurate() ElementType {
+	var sum, compensation float64
+	for _, s := range ss {
+		v := float64(s)
+		t := sum + v
+		if math.Abs(sum) >= math.Abs(v) {
+			compensation += (sum - t) + v
+		} else {
+			compensation += (v - t) + sum
+		}
+		sum = t
+	}
+
+	return ElementType(sum + compensation)
+}
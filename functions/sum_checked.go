@@ -0,0 +1,25 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// SumChecked is the sum of all of the elements. Unlike Sum, which silently
+// wraps around, pie.ErrOverflow is returned if the sum cannot be represented by
+// the element type.
+//
+// Overflow is checked after each addition, so an error may be returned even if
+// later elements would have brought the sum back into range.
+func (ss SliceType) SumChecked() (ElementType, error) {
+	var sum ElementType
+	for _, s := range ss {
+		next := sum + s
+		if (s > 0 && next < sum) || (s < 0 && next > sum) {
+			return 0, pie.ErrOverflow
+		}
+
+		sum = next
+	}
+
+	return sum, nil
+}
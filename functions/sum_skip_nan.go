@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"math"
+)
+
+// SumSkipNaN is the sum of all of the elements that are not NaN (not a
+// number). Unlike Sum, a NaN element does not cause the result to be NaN.
+func (ss SliceType) SumSkipNaN() (sum ElementType) {
+	for _, s := range ss {
+		if !math.IsNaN(float64(s)) {
+			sum += s
+		}
+	}
+
+	return
+}
@@ -0,0 +1,11 @@
+package functions
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss SliceType) Swap(i, j int) SliceType {
+	swapped := make(SliceType, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
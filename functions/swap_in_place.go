@@ -0,0 +1,12 @@
+package functions
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss SliceType) SwapInPlace(i, j int) SliceType {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"sync"
+)
+
+// SliceTypeSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A SliceTypeSync must
+// not be copied after first use.
+type SliceTypeSync struct {
+	mu sync.RWMutex
+	ss SliceType
+}
+
+// Append adds the values to the end of the slice.
+func (s *SliceTypeSync) Append(values ...ElementType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *SliceTypeSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *SliceTypeSync) Snapshot() SliceType {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(SliceType, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss SliceType) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *SliceTypeSync) Read(fn func(ss SliceType)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
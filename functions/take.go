@@ -0,0 +1,21 @@
+package functions
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss SliceType) Take(n int) (ss2 SliceType) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(SliceType, n)
+	copy(ss2, ss)
+
+	return
+}
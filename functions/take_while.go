@@ -0,0 +1,20 @@
+package functions
+
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss SliceType) TakeWhile(condition func(ElementType) bool) (ss2 SliceType) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package functions
+
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss SliceType) ToChannel() <-chan ElementType {
+	ch := make(chan ElementType)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
@@ -0,0 +1,25 @@
+package functions
+
+import (
+	"context"
+)
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss SliceType) ToChannelCtx(ctx context.Context) <-chan ElementType {
+	ch := make(chan ElementType)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
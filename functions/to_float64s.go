@@ -0,0 +1,22 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToFloat64s transforms each element to a float64.
+func (ss SliceType) ToFloat64s(transform func(ElementType) float64) pie.Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
@@ -0,0 +1,28 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss SliceType) ToFloat64sErr(transform func(ElementType) (float64, error)) (pie.Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(pie.Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
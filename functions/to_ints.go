@@ -0,0 +1,22 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToInts transforms each element to an int.
+func (ss SliceType) ToInts(transform func(ElementType) int) pie.Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
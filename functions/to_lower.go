@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"strings"
+)
+
+// ToLower returns a new slice with each element converted to lower case.
+func (ss StringSliceType) ToLower() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	lower := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		lower[i] = StringElementType(strings.ToLower(string(s)))
+	}
+
+	return lower
+}
@@ -0,0 +1,17 @@
+package functions
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss SliceType) ToSet() map[ElementType]struct{} {
+	set := make(map[ElementType]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,19 @@
+package functions
+
+import (
+	"strings"
+)
+
+// ToUpper returns a new slice with each element converted to upper case.
+func (ss StringSliceType) ToUpper() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	upper := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		upper[i] = StringElementType(strings.ToUpper(string(s)))
+	}
+
+	return upper
+}
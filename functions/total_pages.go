@@ -0,0 +1,17 @@
+package functions
+
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss SliceType) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
@@ -0,0 +1,21 @@
+package functions
+
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss SliceType) TransformErr(fn func(ElementType) (ElementType, error)) (SliceType, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]ElementType, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
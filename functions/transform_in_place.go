@@ -0,0 +1,15 @@
+package functions
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss SliceType) TransformInPlace(fn func(ElementType) ElementType) SliceType {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
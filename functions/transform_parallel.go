@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"runtime"
+	"sync"
+)
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss SliceType) TransformParallel(fn func(ElementType) ElementType, workers int) (ss2 SliceType) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]ElementType, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
@@ -0,0 +1,20 @@
+package functions
+
+import (
+	"strings"
+)
+
+// TrimSpace returns a new slice with the leading and trailing white space
+// removed from each element.
+func (ss StringSliceType) TrimSpace() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	trimmed := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		trimmed[i] = StringElementType(strings.TrimSpace(string(s)))
+	}
+
+	return trimmed
+}
@@ -0,0 +1,43 @@
+package functions
+
+import (
+	"sort"
+)
+
+// TrimmedMean is the average of the elements after discarding the smallest and
+// largest fraction of them. For example, a fraction of 0.1 ignores the lowest
+// 10% and highest 10% of the elements. This is more robust against outliers
+// than Average.
+//
+// The number of elements discarded from each end is rounded down. If the
+// fraction would discard every element only the middle one or two elements are
+// used, which is the same as the Median. A fraction of zero or less is the same
+// as Average. Zero is returned if there are no elements.
+func (ss SliceType) TrimmedMean(fraction float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	trim := 0
+	if fraction > 0 {
+		trim = int(fraction * float64(l))
+	}
+
+	if 2*trim >= l {
+		trim = (l - 1) / 2
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, s := range sorted[trim : l-trim] {
+		sum += s
+	}
+
+	return sum / float64(l-2*trim)
+}
@@ -0,0 +1,41 @@
+package functions
+
+import (
+	"unicode/utf8"
+)
+
+// Truncate returns a new slice where each element longer than maxLen
+// characters is shortened to maxLen characters, ending with ellipsis:
+//
+//   Strings{"hello world", "hi"}.Truncate(8, "...") // ["hello...", "hi"]
+//
+// Lengths are counted in runes rather than bytes, so multi-byte characters are
+// never split. If ellipsis is longer than maxLen the elements are cut to maxLen
+// characters without it.
+func (ss StringSliceType) Truncate(maxLen int, ellipsis string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	if maxLen < 0 {
+		maxLen = 0
+	}
+
+	ellipsisLen := utf8.RuneCountInString(ellipsis)
+	if ellipsisLen > maxLen {
+		ellipsis, ellipsisLen = "", 0
+	}
+
+	truncated := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		if utf8.RuneCountInString(string(s)) <= maxLen {
+			truncated[i] = s
+			continue
+		}
+
+		runes := []rune(string(s))
+		truncated[i] = StringElementType(string(runes[:maxLen-ellipsisLen]) + ellipsis)
+	}
+
+	return truncated
+}
@@ -0,0 +1,20 @@
+package functions
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+func (ss SliceType) Union(ss2 SliceType) (union SliceType) {
+	seen := map[ElementType]struct{}{}
+
+	for _, slice := range []SliceType{ss, ss2} {
+		for _, value := range slice {
+			if _, ok := seen[value]; !ok {
+				seen[value] = struct{}{}
+				union = append(union, value)
+			}
+		}
+	}
+
+	return
+}
@@ -2,13 +2,16 @@ package functions
 
 // Unique returns a new slice with all of the unique values.
 //
-// The items will be returned in a randomized order, even with the same input.
+// The items will be returned in the same order that they first appear in the
+// input slice.
 //
 // The number of items returned may be the same as the input or less. It will
 // never return zero items unless then input slice has zero items.
 //
 // A slice with zero elements is considered to be unique.
 //
+// When using slices of pointers it will only compare by address, not value.
+//
 // See AreUnique().
 func (ss SliceType) Unique() SliceType {
 	// Avoid the allocation. If there is one element or less it is already
@@ -17,15 +20,14 @@ func (ss SliceType) Unique() SliceType {
 		return ss
 	}
 
-	values := map[ElementType]struct{}{}
+	seen := map[ElementType]struct{}{}
+	uniqueValues := SliceType{}
 
 	for _, value := range ss {
-		values[value] = struct{}{}
-	}
-
-	var uniqueValues SliceType
-	for value := range values {
-		uniqueValues = append(uniqueValues, value)
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
 	}
 
 	return uniqueValues
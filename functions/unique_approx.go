@@ -0,0 +1,24 @@
+package functions
+
+// UniqueApprox returns a new slice with the elements that are not within
+// epsilon of an element before them. The first of each group of approximately
+// equal elements is kept and the order is maintained.
+//
+// Each element is compared to all of the elements kept so far, so the
+// complexity is O(n*k) where k is the number of elements returned. NaN
+// elements are always kept since they are not approximately equal to
+// anything.
+func (ss SliceType) UniqueApprox(epsilon float64) SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	unique := make(SliceType, 0, len(ss))
+	for _, s := range ss {
+		if !unique.ContainsApprox(s, epsilon) {
+			unique = append(unique, s)
+		}
+	}
+
+	return unique
+}
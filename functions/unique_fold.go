@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"strings"
+)
+
+// UniqueFold works the same as Unique, except that elements that only differ
+// by case are considered to be the same. The first appearance of each element
+// is kept, in its original case.
+func (ss StringSliceType) UniqueFold() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[string]struct{}{}
+	uniqueValues := StringSliceType{}
+
+	for _, value := range ss {
+		key := strings.ToLower(strings.ToUpper(string(value)))
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
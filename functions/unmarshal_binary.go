@@ -0,0 +1,13 @@
+package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes data that
+// was created with MarshalBinary.
+func (ss *SliceType) UnmarshalBinary(data []byte) error {
+	return util.UnmarshalBinary(data, reflect.ValueOf(ss))
+}
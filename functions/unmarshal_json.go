@@ -0,0 +1,22 @@
+package functions
+
+import (
+	"encoding/json"
+)
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *SliceType) UnmarshalJSON(data []byte) error {
+	var elements []ElementType
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []ElementType{}
+	}
+
+	*ss = elements
+
+	return nil
+}
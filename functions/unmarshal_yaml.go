@@ -0,0 +1,19 @@
+package functions
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *SliceType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []ElementType
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []ElementType{}
+	}
+
+	*ss = elements
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package functions
+
+// Variance is the population variance of the elements, that is, the average of
+// the squared differences from the mean. Zero is returned if there are no
+// elements.
+//
+// If the slice is only a sample of a larger population you may want to
+// multiply the result by n/(n-1) to get the unbiased sample variance.
+func (ss SliceType) Variance() float64 {
+	l := float64(len(ss))
+	if l == 0 {
+		return 0
+	}
+
+	mean := float64(ss.Average())
+
+	var sum float64
+	for _, s := range ss {
+		diff := float64(s) - mean
+		sum += diff * diff
+	}
+
+	return sum / l
+}
@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// WeightedAverage is the sum of each element multiplied by the weight at the
+// same position, divided by the sum of the weights.
+//
+// pie.ErrLengthMismatch is returned if there is not exactly one weight for each
+// element, pie.ErrEmptySlice if there are no elements and pie.ErrZeroWeights if
+// the weights add up to zero.
+func (ss SliceType) WeightedAverage(weights pie.Float64s) (float64, error) {
+	if len(ss) != len(weights) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
+	}
+
+	var sum, totalWeight float64
+	for i, s := range ss {
+		sum += float64(s) * weights[i]
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return 0, pie.ErrZeroWeights
+	}
+
+	return sum / totalWeight, nil
+}
@@ -0,0 +1,22 @@
+package functions
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss SliceType) Windows(size int) (windows []SliceType) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
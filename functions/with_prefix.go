@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"strings"
+)
+
+// WithPrefix returns a new slice containing only the elements that begin with
+// prefix. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) WithPrefix(prefix string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.HasPrefix(string(s), prefix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,17 @@
+package functions
+
+import (
+	"strings"
+)
+
+// WithSuffix returns a new slice containing only the elements that end with
+// suffix. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) WithSuffix(suffix string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.HasSuffix(string(s), suffix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,31 @@
+package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ZScore returns a new slice containing the standard score of each element.
+// That is, the number of standard deviations (see StandardDeviation) that the
+// element is above or below the mean.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss SliceType) ZScore() pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	mean := float64(ss.Average())
+	stddev := ss.StandardDeviation()
+
+	scores := make(pie.Float64s, len(ss))
+	if stddev == 0 {
+		return scores
+	}
+
+	for i, s := range ss {
+		scores[i] = (float64(s) - mean) / stddev
+	}
+
+	return scores
+}
@@ -0,0 +1,33 @@
+package functions
+
+// SliceTypePair is a pair of elements from the same position of two slices.
+type SliceTypePair struct {
+	First, Second ElementType
+}
+
+// SliceTypePairs is created by Zip.
+type SliceTypePairs []SliceTypePair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss SliceType) Zip(ss2 SliceType) (pairs SliceTypePairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, SliceTypePair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs SliceTypePairs) Unzip() (ss, ss2 SliceType) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
@@ -0,0 +1,15 @@
+package functions
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss SliceType) ZipWith(ss2 SliceType, fn func(a, b ElementType) ElementType) (ss3 SliceType) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
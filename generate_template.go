@@ -32,6 +32,28 @@ func main() {
 		data[function.Name] = string(tmpl)
 	}
 
+	for name, file := range functions.EqualityFunctions {
+		if file == "" {
+			continue
+		}
+
+		tmpl, err := ioutil.ReadFile("functions/equality/" + file)
+		if err != nil {
+			panic(err)
+		}
+
+		data["equality/"+name] = string(tmpl)
+	}
+
+	for name, file := range functions.FloatFunctions {
+		tmpl, err := ioutil.ReadFile("functions/floats/" + file)
+		if err != nil {
+			panic(err)
+		}
+
+		data["floats/"+name] = string(tmpl)
+	}
+
 	f, err := os.Create("template.go")
 	if err != nil {
 		panic(err)
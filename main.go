@@ -3,14 +3,17 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/elliotchance/pie/functions"
 	"github.com/elliotchance/pie/pie"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 )
@@ -29,24 +32,62 @@ func getIdentName(e ast.Expr) string {
 	case *ast.StarExpr:
 		return "*" + getIdentName(v.X)
 
-	default:
-		panic(fmt.Sprintf("cannot decode %T", e))
+	case *ast.SelectorExpr:
+		return getIdentName(v.X) + "." + v.Sel.Name
+
+	case *ast.ArrayType:
+		if v.Len == nil {
+			return "[]" + getIdentName(v.Elt)
+		}
+
+	case *ast.MapType:
+		return "map[" + getIdentName(v.Key) + "]" + getIdentName(v.Value)
+
 	}
+
+	panic(fmt.Sprintf("cannot decode %T", e))
 }
 
-func getKeyAndElementType(pkgName, name string, typeSpec *ast.TypeSpec) (string, string, string) {
+func getKeyAndElementType(typeSpec *ast.TypeSpec) (keyType, elementType string, ok bool) {
 	if t, ok := typeSpec.Type.(*ast.ArrayType); ok {
-		return pkgName, "", getIdentName(t.Elt)
+		return "", getIdentName(t.Elt), true
 	}
 
 	if t, ok := typeSpec.Type.(*ast.MapType); ok {
-		return pkgName, getIdentName(t.Key), getIdentName(t.Value)
+		return getIdentName(t.Key), getIdentName(t.Value), true
+	}
+
+	return
+}
+
+// getTypeImports returns the import paths of any packages that are used to
+// qualify the key or element type, such as "time" for time.Time.
+func getTypeImports(file *ast.File, types ...string) (imports []string) {
+	for _, t := range types {
+		i := strings.Index(t, ".")
+		if i < 0 {
+			continue
+		}
+
+		qualifier := strings.TrimLeft(t[:i], "*")
+
+		for _, imp := range file.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+
+			if name == qualifier {
+				imports = append(imports, imp.Path.Value)
+			}
+		}
 	}
 
-	panic(fmt.Sprintf("type %s must be a slice or map", name))
+	return
 }
 
-func findType(pkgs map[string]*ast.Package, name string) (packageName, keyType, elementType string) {
+func findType(fset *token.FileSet, pkgs map[string]*ast.Package, name string) (packageName, keyType, elementType string, imports []string) {
 	for pkgName, pkg := range pkgs {
 		for _, file := range pkg.Files {
 			for _, decl := range file.Decls {
@@ -54,7 +95,25 @@ func findType(pkgs map[string]*ast.Package, name string) (packageName, keyType,
 					for _, spec := range genDecl.Specs {
 						if typeSpec, ok := spec.(*ast.TypeSpec); ok {
 							if typeSpec.Name.String() == name {
-								return getKeyAndElementType(pkgName, name, typeSpec)
+								packageName = pkgName
+
+								var ok bool
+								keyType, elementType, ok = getKeyAndElementType(typeSpec)
+								if ok {
+									imports = getTypeImports(file, keyType, elementType)
+
+									return
+								}
+
+								// The type is declared in terms of another
+								// type so we need to resolve it.
+								keyType, elementType, imports, ok = resolveType(
+									checkPackage(fset, pkgs, pkgName), name)
+								if !ok {
+									panic(fmt.Sprintf("type %s must be a slice or map", name))
+								}
+
+								return
 							}
 						}
 					}
@@ -66,24 +125,109 @@ func findType(pkgs map[string]*ast.Package, name string) (packageName, keyType,
 	panic(fmt.Sprintf("type %s does not exist", name))
 }
 
-func getType(keyType, elementType string) int {
+func getType(fset *token.FileSet, pkgs map[string]*ast.Package, packageName, name, keyType, elementType string) int {
+	kind := getBuiltinType(keyType, elementType)
+
+	// Elements that are not structs may be named types (or aliases) of a
+	// builtin type.
+	if kind == functions.ForStructs {
+		if _, file := findStruct(pkgs, elementType); file == nil {
+			if resolved := resolveKind(checkPackage(fset, pkgs, packageName), name); resolved != 0 {
+				return resolved
+			}
+		}
+	}
+
+	return kind
+}
+
+func getBuiltinType(keyType, elementType string) int {
 	if keyType != "" {
 		return functions.ForMaps
 	}
 
 	switch elementType {
 	case "int8", "uint8", "byte", "int16", "uint16", "int32", "rune", "uint32",
-		"int64", "uint64", "int", "uint", "uintptr", "float32", "float64",
-		"complex64", "complex128":
+		"int64", "uint64", "int", "uint", "uintptr", "time.Duration":
+		return functions.ForNumbers | functions.ForIntegers
+
+	case "complex64", "complex128":
 		return functions.ForNumbers
 
+	case "float32", "float64":
+		return functions.ForNumbers | functions.ForFloats
+
 	case "string":
 		return functions.ForStrings
+
+	case "bool":
+		return functions.ForBools
+	}
+
+	if elementType[0] == '*' {
+		return functions.ForStructs | functions.ForPointers
 	}
 
 	return functions.ForStructs
 }
 
+// getElementEquals returns the function used to compare elements, or an empty
+// string if they are compared with ==. This is the -compare flag, otherwise the
+// Equals method of the element type, if it has one.
+func getElementEquals(fset *token.FileSet, pkgs map[string]*ast.Package, packageName, name, elementType string) string {
+	if *compareFlag != "" {
+		return *compareFlag
+	}
+
+	// Builtin types cannot have methods.
+	if types.Universe.Lookup(elementType) != nil {
+		return ""
+	}
+
+	checked := checkPackage(fset, pkgs, packageName)
+	if hasEqualsMethod(checked, name) {
+		if elementType[0] == '*' {
+			return "(" + elementType + ").Equals"
+		}
+
+		return elementType + ".Equals"
+	}
+
+	// Elements like slices and maps cannot be compared with ==.
+	if elem := elementTypeOf(checked, name); elem != nil && !types.Comparable(elem) {
+		return "reflect.DeepEqual"
+	}
+
+	return ""
+}
+
+// getZeroValue returns the expression used for ElementZeroValue.
+func getZeroValue(kind int, elementType string) string {
+	switch kind &^ (functions.ForPointers | functions.ForFloats | functions.ForIntegers) {
+	case functions.ForNumbers:
+		return "0"
+
+	case functions.ForStrings:
+		return `""`
+
+	case functions.ForBools:
+		return "false"
+
+	case functions.ForStructs:
+		zeroValue := fmt.Sprintf("%s{}", elementType)
+
+		// If its a pointer we need to replace '*' -> '&' when
+		// instantiating.
+		if elementType[0] == '*' {
+			zeroValue = "&" + zeroValue[1:]
+		}
+
+		return zeroValue
+	}
+
+	return ""
+}
+
 func getImports(packageName, s string) (imports []string) {
 	fset := token.NewFileSet()
 	f, err := parser.ParseFile(fset, "", s, parser.ImportsOnly)
@@ -105,8 +249,11 @@ func getImports(packageName, s string) (imports []string) {
 	return
 }
 
-func getAllImports(packageName string, files []string) (imports []string) {
+func getAllImports(packageName string, files []string, typeImports []string) (imports []string) {
 	mapImports := map[string]struct{}{}
+	for _, imp := range typeImports {
+		mapImports[imp] = struct{}{}
+	}
 
 	for _, file := range files {
 		for _, imp := range getImports(packageName, file) {
@@ -123,88 +270,273 @@ func getAllImports(packageName string, files []string) (imports []string) {
 	return
 }
 
+// pieQualifier matches references to exported identifiers in the pie package,
+// such as "pie.Strings".
+var pieQualifier = regexp.MustCompile(`\bpie\.([A-Z])`)
+
 // We have to generate imports slightly differently when we are building code
 // that will go into its own packages vs an external package.
 func isSelfPackage(packageName string) bool {
 	return packageName == "pie"
 }
 
+var (
+	onlyFlag      = flag.String("only", "", "comma-separated `functions` to generate, all others are skipped")
+	excludeFlag   = flag.String("exclude", "", "comma-separated `functions` to skip")
+	templatesFlag = flag.String("templates", "", "`directory` containing additional templates")
+	checkFlag     = flag.Bool("check", false, "report files that are out of date instead of writing them")
+	testsFlag     = flag.Bool("tests", false, "also generate tests for the generated functions")
+	compareFlag   = flag.String("compare", "", "`function` used to compare elements instead of ==")
+)
+
+// splitFunctions splits a comma-separated list of function names.
+func splitFunctions(list string) (fns pie.Strings) {
+	for _, fn := range strings.Split(list, ",") {
+		if fn = strings.TrimSpace(fn); fn != "" {
+			fns = append(fns, fn)
+		}
+	}
+
+	return
+}
+
+// includeFunction returns true if the function (or generated method) should be
+// generated. fns are the functions requested for the type, or "*" for all
+// functions. The -only and -exclude flags are then applied.
+func includeFunction(fns []string, name string) bool {
+	if fns[0] != "*" && !pie.Strings(fns).Contains(name) {
+		return false
+	}
+
+	if only := splitFunctions(*onlyFlag); len(only) > 0 && !only.Contains(name) {
+		return false
+	}
+
+	return !splitFunctions(*excludeFlag).Contains(name)
+}
+
 func main() {
+	flag.Parse()
+
 	fset := token.NewFileSet()
-	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
+	pkgs, err := parser.ParseDir(fset, ".", nil, parser.ParseComments)
 	check(err)
 
-	for _, arg := range os.Args[1:] {
+	var customTemplates []customTemplate
+	if *templatesFlag != "" {
+		customTemplates, err = loadTemplates(*templatesFlag)
+		check(err)
+	}
+
+	outOfDate := false
+	for _, arg := range flag.Args() {
 		mapOrSliceType, fns := getFunctionsFromArg(arg)
-		packageName, keyType, elementType := findType(pkgs, mapOrSliceType)
-		kind := getType(keyType, elementType)
+		packageName, keyType, elementType, typeImports := findType(fset, pkgs, mapOrSliceType)
+		kind := getType(fset, pkgs, packageName, mapOrSliceType, keyType, elementType)
+		elementImports := append([]string(nil), typeImports...)
+
+		elementEquals := getElementEquals(fset, pkgs, packageName, mapOrSliceType, elementType)
+		if *compareFlag != "" {
+			typeImports = append(typeImports, getTypeImports(findTypeFile(pkgs, mapOrSliceType), *compareFlag)...)
+		} else if elementEquals == "reflect.DeepEqual" {
+			typeImports = append(typeImports, `"reflect"`)
+		}
 
+		// Templates with a build constraint, such as the iterators, are
+		// generated into a separate file for each constraint.
 		var templates []string
+		constrainedTemplates := map[string][]string{}
 		for _, function := range functions.Functions {
-			if fns[0] != "*" && !pie.Strings(fns).Contains(function.Name) {
+			if !includeFunction(fns, function.Name) {
 				continue
 			}
 
 			if function.For&kind != 0 {
-				templates = append(templates, pieTemplates[function.Name])
+				var tmpl string
+				file, ok := functions.EqualityFunctions[function.Name]
+				switch {
+				case !ok || elementEquals == "":
+					tmpl = pieTemplates[function.Name]
+					if _, ok := functions.FloatFunctions[function.Name]; ok && kind&functions.ForFloats != 0 {
+						tmpl = pieTemplates["floats/"+function.Name]
+					}
+
+				case file != "":
+					tmpl = pieTemplates["equality/"+function.Name]
+
+				default:
+					continue
+				}
+
+				if constraint := buildConstraint(tmpl); constraint != "" {
+					constrainedTemplates[constraint] = append(constrainedTemplates[constraint], tmpl)
+				} else {
+					templates = append(templates, tmpl)
+				}
 			}
 		}
 
-		// Aggregate imports.
-		t := fmt.Sprintf("package %s\n\n", packageName)
+		for _, template := range customTemplates {
+			if includeFunction(fns, template.Name) && template.For&kind != 0 {
+				templates = append(templates, template.Source)
+			}
+		}
 
-		imports := getAllImports(packageName, templates)
-		if len(imports) > 0 {
-			t += fmt.Sprintf("import (")
-			for _, imp := range imports {
-				t += fmt.Sprintf("\n\t%s", imp)
+		var projections string
+		if kind&functions.ForStructs != 0 {
+			var projectionImports []string
+			projections, projectionImports = fieldProjections(pkgs, packageName, mapOrSliceType, elementType, fns)
+			typeImports = append(typeImports, projectionImports...)
+
+			sorts, sortImports := fieldSorts(pkgs, mapOrSliceType, elementType, fns)
+			projections += sorts
+			typeImports = append(typeImports, sortImports...)
+
+			directives := findDirectives(pkgs, mapOrSliceType)
+			if filterFields, ok := directives["filters"]; ok {
+				filters, filterImports, err := fieldFilters(fset, pkgs, packageName, mapOrSliceType, elementType, fns, filterFields)
+				check(err)
+				projections += filters
+				typeImports = append(typeImports, filterImports...)
 			}
-			t += "\n)\n\n"
+
+			groupings, groupingImports, err := fieldGroupings(fset, pkgs, packageName, mapOrSliceType, elementType, fns,
+				directives["groupby"], directives["keyby"])
+			check(err)
+			projections += groupings
+			typeImports = append(typeImports, groupingImports...)
+
+			projections += flattenMethod(fset, pkgs, packageName, mapOrSliceType, elementType, fns)
 		}
 
-		for _, tmpl := range templates {
-			i := strings.Index(tmpl, "//")
-			t += tmpl[i:] + "\n"
+		t := renderTemplates(packageName, templates, typeImports)
+		t = replacePlaceholders(t, kind, mapOrSliceType, keyType, elementType, elementEquals)
+		t += projections
+
+		if isSelfPackage(packageName) {
+			t = pieQualifier.ReplaceAllString(t, "$1")
 		}
 
-		t = strings.Replace(t, "StringSliceType", mapOrSliceType, -1)
-		t = strings.Replace(t, "StringElementType", elementType, -1)
-		t = strings.Replace(t, "ElementType", elementType, -1)
-		t = strings.Replace(t, "MapType", mapOrSliceType, -1)
-		t = strings.Replace(t, "KeyType", elementType, -1)
-		t = strings.Replace(t, "KeySliceType", "[]"+keyType, -1)
-		t = strings.Replace(t, "SliceType", mapOrSliceType, -1)
+		// The TrimRight is important to remove an extra new line that conflicts
+		// with go fmt.
+		t = strings.TrimRight(t, "\n") + "\n"
 
-		switch kind {
-		case functions.ForNumbers:
-			t = strings.Replace(t, "ElementZeroValue", "0", -1)
+		fileName := strings.ToLower(mapOrSliceType) + "_pie"
+		if !writeFile(fileName+".go", t) {
+			outOfDate = true
+		}
 
-		case functions.ForStrings:
-			t = strings.Replace(t, "ElementZeroValue", `""`, -1)
+		for constraint, tmpls := range constrainedTemplates {
+			t := fmt.Sprintf("//go:build %s\n// +build %s\n\n", constraint, constraint)
+			t += renderTemplates(packageName, tmpls, elementImports)
+			t = replacePlaceholders(t, kind, mapOrSliceType, keyType, elementType, elementEquals)
 
-		case functions.ForStructs:
-			zeroValue := fmt.Sprintf("%s{}", elementType)
+			if isSelfPackage(packageName) {
+				t = pieQualifier.ReplaceAllString(t, "$1")
+			}
 
-			// If its a pointer we need to replace '*' -> '&' when
-			// instantiating.
-			if elementType[0] == '*' {
-				zeroValue = "&" + zeroValue[1:]
+			t = strings.TrimRight(t, "\n") + "\n"
+
+			suffix := strings.Replace(constraint, ".", "", -1)
+			if !writeFile(fileName+"_"+suffix+".go", t) {
+				outOfDate = true
 			}
+		}
 
-			t = strings.Replace(t, "ElementZeroValue", zeroValue, -1)
+		if *testsFlag && kind&functions.ForMaps == 0 {
+			tests := generateTests(pkgs, packageName, mapOrSliceType, elementType, kind, fns)
+			if !writeFile(fileName+"_test.go", tests) {
+				outOfDate = true
+			}
 		}
+	}
 
-		if isSelfPackage(packageName) {
-			t = strings.Replace(t, "pie.Strings", "Strings", -1)
+	if outOfDate {
+		os.Exit(1)
+	}
+}
+
+// buildConstraint returns the expression of the "//go:build" line at the top
+// of a template, such as "go1.23", or an empty string if there is none.
+func buildConstraint(tmpl string) string {
+	if !strings.HasPrefix(tmpl, "//go:build ") {
+		return ""
+	}
+
+	line := strings.SplitN(tmpl, "\n", 2)[0]
+
+	return strings.TrimSpace(strings.TrimPrefix(line, "//go:build "))
+}
+
+// renderTemplates returns the package clause, imports and body of each
+// template. The placeholders are not replaced.
+func renderTemplates(packageName string, templates []string, typeImports []string) string {
+	t := fmt.Sprintf("package %s\n\n", packageName)
+
+	imports := getAllImports(packageName, templates, typeImports)
+	if len(imports) > 0 {
+		t += fmt.Sprintf("import (")
+		for _, imp := range imports {
+			t += fmt.Sprintf("\n\t%s", imp)
 		}
+		t += "\n)\n\n"
+	}
 
-		// The TrimRight is important to remove an extra new line that conflicts
-		// with go fmt.
-		t = strings.TrimRight(t, "\n") + "\n"
+	for _, tmpl := range templates {
+		// Skip over the build constraint and package clause to the doc
+		// comment of the function.
+		tmpl = tmpl[strings.Index(tmpl, "package "):]
+		i := strings.Index(tmpl, "//")
+		t += tmpl[i:] + "\n"
+	}
 
-		err := ioutil.WriteFile(strings.ToLower(mapOrSliceType)+"_pie.go", []byte(t), 0755)
-		check(err)
+	return t
+}
+
+// replacePlaceholders replaces SliceType, ElementType and the other
+// placeholders used in the templates.
+func replacePlaceholders(t string, kind int, mapOrSliceType, keyType, elementType, elementEquals string) string {
+	t = strings.Replace(t, "StringSliceType", mapOrSliceType, -1)
+	t = strings.Replace(t, "StringElementType", elementType, -1)
+	t = strings.Replace(t, "BoolSliceType", mapOrSliceType, -1)
+	t = strings.Replace(t, "BoolElementType", elementType, -1)
+	t = strings.Replace(t, "PointerSliceType", mapOrSliceType, -1)
+	t = strings.Replace(t, "PointerElementType", elementType, -1)
+	t = strings.Replace(t, "IntegerSliceType", mapOrSliceType, -1)
+	t = strings.Replace(t, "IntegerElementType", elementType, -1)
+	t = strings.Replace(t, "ElementType", elementType, -1)
+	t = strings.Replace(t, "MapType", mapOrSliceType, -1)
+	t = strings.Replace(t, "KeyType", elementType, -1)
+	t = strings.Replace(t, "KeySliceType", "[]"+keyType, -1)
+	t = strings.Replace(t, "SliceType", mapOrSliceType, -1)
+
+	if zeroValue := getZeroValue(kind, elementType); zeroValue != "" {
+		t = strings.Replace(t, "ElementZeroValue", zeroValue, -1)
 	}
+
+	return strings.Replace(t, "ElementEquals", elementEquals, -1)
+}
+
+// writeFile writes the generated file. If the -check flag is used the file is
+// not written and false is returned (with a diff printed) if the file is out of
+// date.
+func writeFile(fileName, contents string) bool {
+	if *checkFlag {
+		existing, _ := ioutil.ReadFile(fileName)
+		if string(existing) != contents {
+			fmt.Printf("%s is out of date:\n%s", fileName,
+				lineDiff(string(existing), contents))
+
+			return false
+		}
+
+		return true
+	}
+
+	err := ioutil.WriteFile(fileName, []byte(contents), 0755)
+	check(err)
+
+	return true
 }
 
 func getFunctionsFromArg(arg string) (mapOrSliceType string, fns []string) {
@@ -0,0 +1,4 @@
+package pie
+
+//go:generate pie Bools.*
+type Bools []bool
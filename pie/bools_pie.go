@@ -0,0 +1,2140 @@
+package pie
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"github.com/elliotchance/pie/pie/util"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss Bools) Accumulate(initial bool, fn func(acc, value bool) bool) Bools {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(Bools, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
+
+// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
+func (ss Bools) All(fn func(value bool) bool) bool {
+	for _, value := range ss {
+		if !fn(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AllTrue returns true if all of the elements are true.
+//
+// If the list is empty then true is always returned.
+func (ss Bools) AllTrue() bool {
+	for _, s := range ss {
+		if !s {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
+func (ss Bools) Any(fn func(value bool) bool) bool {
+	for _, value := range ss {
+		if fn(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnyTrue returns true if at least one of the elements is true.
+//
+// If the list is empty then false is always returned.
+func (ss Bools) AnyTrue() bool {
+	for _, s := range ss {
+		if s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
+//
+// It is acceptable to provide zero arguments.
+func (ss Bools) Append(elements ...bool) Bools {
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(Bools, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
+}
+
+// Bottom will return n elements from bottom
+//
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss Bools) Bottom(n int) (top Bools) {
+	var lastIndex = len(ss) - 1
+	for i := lastIndex; i > -1 && n > 0; i-- {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
+//
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesianBools for more
+// than two slices.
+func (ss Bools) Cartesian(ss2 Bools) BoolsPairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
+	}
+
+	pairs := make(BoolsPairs, 0, len(ss)*len(ss2))
+	for _, s := range ss {
+		for _, s2 := range ss2 {
+			pairs = append(pairs, BoolsPair{s, s2})
+		}
+	}
+
+	return pairs
+}
+
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
+//
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
+//
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss Bools) Chunk(size int) (chunks []Bools) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		chunks = append(chunks, ss[i:end:end])
+	}
+
+	return
+}
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss Bools) CoalesceOr(defaultValue bool) bool {
+	var zero bool
+
+	for _, s := range ss {
+		if s != zero {
+			return s
+		}
+	}
+
+	return defaultValue
+}
+
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss Bools) Combinations(k int) (combinations []Bools) {
+	ss.EachCombination(k, func(combination Bools) bool {
+		c := make(Bools, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
+
+	return
+}
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Bools) Compact() (ss2 Bools) {
+	var zero bool
+
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Bools) Contains(lookingFor bool) bool {
+	for _, s := range ss {
+		if s == lookingFor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CountTrue returns the number of elements that are true.
+func (ss Bools) CountTrue() (count int) {
+	for _, s := range ss {
+		if s {
+			count++
+		}
+	}
+
+	return
+}
+
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss Bools) CountUsing(condition func(bool) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
+	}
+
+	return
+}
+
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss Bools) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
+}
+
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Bools) DeleteAt(indexes ...int) (ss2 Bools) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
+	}
+
+	for i, s := range ss {
+		if !deleted[i] {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss Bools) Drop(n int) (ss2 Bools) {
+	if n < 0 {
+		n = 0
+	}
+
+	if n >= len(ss) {
+		return nil
+	}
+
+	ss2 = make(Bools, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
+}
+
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See TakeWhile().
+func (ss Bools) DropWhile(condition func(bool) bool) (ss2 Bools) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
+	}
+
+	return
+}
+
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass the original slice on.
+//
+//   cars.Each(func (car *Car) {
+//       fmt.Printf("Car color is: %s\n", car.Color)
+//   })
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//   // Set all car colors to Red.
+//   cars.Each(func (car *Car) {
+//       car.Color = "Red"
+//   })
+//
+func (ss Bools) Each(fn func(bool)) Bools {
+	for _, s := range ss {
+		fn(s)
+	}
+
+	return ss
+}
+
+// EachCartesianBools calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
+//
+//   EachCartesianBools(func(values Bools) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesianBools(fn func(Bools) bool, slices ...Bools) {
+	if len(slices) == 0 {
+		return
+	}
+
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	values := make(Bools, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
+
+	for {
+		if !fn(values) {
+			return
+		}
+
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
+
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
+//
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss Bools) EachCombination(k int, fn func(Bools) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	combination := make(Bools, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
+
+	for {
+		if !fn(combination) {
+			return
+		}
+
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
+}
+
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss Bools) EachErr(fn func(bool) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss Bools) EachPermutation(fn func(Bools) bool) {
+	n := len(ss)
+	if n == 0 {
+		return
+	}
+
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(Bools, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
+	}
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+}
+
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
+//
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
+//
+func (ss Bools) EachWithIndex(fn func(int, bool)) Bools {
+	for i, s := range ss {
+		fn(i, s)
+	}
+
+	return ss
+}
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Bools) Equals(ss2 Bools) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Bools) EqualsUnordered(ss2 Bools) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[bool]int{}
+	for _, s := range ss {
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
+		}
+
+		counts[s]--
+	}
+
+	return true
+}
+
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// Like Append, the result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss Bools) Extend(slices ...Bools) Bools {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
+
+	// Avoid the allocation.
+	if n == 0 {
+		return ss
+	}
+
+	result := make(Bools, len(ss), len(ss)+n)
+	copy(result, ss)
+
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// First returns the first element, or zero. Also see FirstOr().
+func (ss Bools) First() bool {
+	return ss.FirstOr(false)
+}
+
+// FirstE returns the first element. Unlike First, ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss Bools) FirstE() (bool, error) {
+	if len(ss) == 0 {
+		return false, ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss Bools) FirstOr(defaultValue bool) bool {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss Bools) FirstUsing(condition func(bool) bool) (bool, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return false, false
+}
+
+// FlattenBools returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func FlattenBools(slices []Bools) (ss Bools) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Bools) Frequencies() map[bool]int {
+	frequencies := make(map[bool]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
+
+// BoolsFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func BoolsFromCSVString(s string) (ss Bools, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
+
+// BoolsFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func BoolsFromChannel(ch <-chan bool) (ss Bools) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// BoolsFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func BoolsFromJSONString(s string) (ss Bools, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Bools) GroupByString(fn func(bool) string) map[string]Bools {
+	group := map[string]Bools{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss Bools) IndexOf(lookingFor bool) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss Bools) Insert(index int, values ...bool) Bools {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(Bools, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Bools) Interleave(ss2 Bools) (ss3 Bools) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
+
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Bools) JSONBytes() []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return data
+}
+
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+// See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Bools) JSONBytesIndent(prefix, indent string) []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return data
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Bools) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return string(data)
+}
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Bools) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Bools) KeyByString(fn func(bool) string) map[string]bool {
+	m := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss Bools) Last() bool {
+	return ss.LastOr(false)
+}
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss Bools) LastIndexOf(lookingFor bool) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastE returns the last element. Unlike Last, ErrEmptySlice is returned
+// if there are no elements so that a zero value can be distinguished from an
+// empty slice.
+func (ss Bools) LastE() (bool, error) {
+	if len(ss) == 0 {
+		return false, ErrEmptySlice
+	}
+
+	return ss[len(ss)-1], nil
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss Bools) LastOr(defaultValue bool) bool {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss Bools) LastUsing(condition func(bool) bool) (bool, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return false, false
+}
+
+// Len returns the number of elements.
+func (ss Bools) Len() int {
+	return len(ss)
+}
+
+// BoolsLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type BoolsLazy struct {
+	iterate func(fn func(bool) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss Bools) Lazy() BoolsLazy {
+	return BoolsLazy{
+		iterate: func(fn func(bool) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l BoolsLazy) Select(condition func(bool) bool) BoolsLazy {
+	return BoolsLazy{
+		iterate: func(fn func(bool) bool) {
+			l.iterate(func(s bool) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l BoolsLazy) Unselect(condition func(bool) bool) BoolsLazy {
+	return BoolsLazy{
+		iterate: func(fn func(bool) bool) {
+			l.iterate(func(s bool) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l BoolsLazy) Transform(transform func(bool) bool) BoolsLazy {
+	return BoolsLazy{
+		iterate: func(fn func(bool) bool) {
+			l.iterate(func(s bool) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l BoolsLazy) Top(n int) BoolsLazy {
+	return BoolsLazy{
+		iterate: func(fn func(bool) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s bool) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l BoolsLazy) Collect() (ss Bools) {
+	l.iterate(func(s bool) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss Bools) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]bool(ss))
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss Bools) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []bool{}, nil
+	}
+
+	return []bool(ss), nil
+}
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss Bools) Mode() (mode Bools) {
+	counts := map[bool]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss Bools) OrderBy(less ...func(a, b bool) bool) Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Bools, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss Bools) Page(pageNumber, pageSize int) Bools {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss Bools) Pairwise() (pairs [][2]bool) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]bool{ss[i-1], ss[i]})
+	}
+
+	return
+}
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss Bools) Partition(condition func(bool) bool) (matching, rest Bools) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss Bools) Permutations() (permutations []Bools) {
+	ss.EachPermutation(func(permutation Bools) bool {
+		p := make(Bools, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss Bools) Pop() (last bool, rest Bools) {
+	n := len(ss)
+	if n == 0 {
+		return false, nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
+
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
+func (ss Bools) Random(source rand.Source) bool {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 1 {
+		return false
+	}
+	if n < 2 {
+		return ss[0]
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	i := rnd.Intn(n)
+	return ss[i]
+}
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss Bools) Reduce(initial bool, fn func(acc, value bool) bool) bool {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//   ss.Sort().Reverse()
+//
+func (ss Bools) Reverse() Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]bool, len(ss))
+	for i := 0; i < len(ss); i++ {
+		sorted[i] = ss[len(ss)-i-1]
+	}
+
+	return sorted
+}
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss Bools) Rotate(n int) Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(Bools, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss Bools) RotateInPlace(n int) Bools {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s Bools) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss Bools) Sample(n int, source rand.Source) Bools {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(Bools, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss Bools) ReverseInPlace() Bools {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
+
+// Scan implements sql.Scanner so that an array column can be scanned directly
+// into the slice. It accepts a one-dimensional PostgreSQL array literal, such
+// as {1,2,3}.
+//
+// NULL is scanned as an empty slice. An error is returned if any of the
+// elements are NULL.
+func (ss *Bools) Scan(src interface{}) error {
+	return util.ScanArrayLiteral(src, reflect.ValueOf(ss))
+}
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss Bools) Select(condition func(bool) bool) (ss2 Bools) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss Bools) SortStableUsing(less func(a, b bool) bool) Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Bools, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss Bools) SortUsing(less func(a, b bool) bool) Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Bools, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Bools) Splice(start, deleteCount int, values ...bool) Bools {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(Bools, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss Bools) SplitAt(index int) (left, right Bools) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss Bools) Shift() (first bool, rest Bools) {
+	if len(ss) == 0 {
+		return false, nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss Bools) Swap(i, j int) Bools {
+	swapped := make(Bools, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss Bools) SwapInPlace(i, j int) Bools {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
+
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
+func (ss Bools) Shuffle(source rand.Source) Bools {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 2 {
+		return ss
+	}
+
+	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
+	// the algorithm directly from the go source: src/math/rand/rand.go below,
+	// with some adjustments:
+	shuffled := make([]bool, n)
+	copy(shuffled, ss)
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	util.Shuffle(rnd, n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss Bools) ShuffleInPlace(source rand.Source) Bools {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
+
+// BoolsSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A BoolsSync must
+// not be copied after first use.
+type BoolsSync struct {
+	mu sync.RWMutex
+	ss Bools
+}
+
+// Append adds the values to the end of the slice.
+func (s *BoolsSync) Append(values ...bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *BoolsSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *BoolsSync) Snapshot() Bools {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(Bools, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss Bools) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *BoolsSync) Read(fn func(ss Bools)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss Bools) Take(n int) (ss2 Bools) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(Bools, n)
+	copy(ss2, ss)
+
+	return
+}
+
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss Bools) TakeWhile(condition func(bool) bool) (ss2 Bools) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
+}
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss Bools) Top(n int) (top Bools) {
+	for i := 0; i < len(ss) && n > 0; i++ {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss Bools) ToChannel() <-chan bool {
+	ch := make(chan bool)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss Bools) ToChannelCtx(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ToFloat64s transforms each element to a float64.
+func (ss Bools) ToFloat64s(transform func(bool) float64) Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss Bools) ToFloat64sErr(transform func(bool) (float64, error)) (Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToInts transforms each element to an int.
+func (ss Bools) ToInts(transform func(bool) int) Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToIntsErr works the same as ToInts, except that transform may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Bools) ToIntsErr(transform func(bool) (int, error)) (Ints, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Bools) ToSet() map[bool]struct{} {
+	set := make(map[bool]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
+
+// ToStrings transforms each element to a string.
+func (ss Bools) ToStrings(transform func(bool) string) Strings {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Strings, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss Bools) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
+
+// Transform will return a new slice where each element has been transformed.
+// The number of element returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+func (ss Bools) Transform(fn func(bool) bool) (ss2 Bools) {
+	if ss == nil {
+		return nil
+	}
+
+	ss2 = make([]bool, len(ss))
+	for i, s := range ss {
+		ss2[i] = fn(s)
+	}
+
+	return
+}
+
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Bools) TransformErr(fn func(bool) (bool, error)) (Bools, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]bool, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss Bools) TransformInPlace(fn func(bool) bool) Bools {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss Bools) TransformParallel(fn func(bool) bool, workers int) (ss2 Bools) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]bool, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See AreUnique().
+func (ss Bools) Unique() Bools {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[bool]struct{}{}
+	uniqueValues := Bools{}
+
+	for _, value := range ss {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *Bools) UnmarshalJSON(data []byte) error {
+	var elements []bool
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []bool{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *Bools) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []bool
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []bool{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// Unselect works the same as Select, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss Bools) Unselect(condition func(bool) bool) (ss2 Bools) {
+	for _, s := range ss {
+		if !condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Value implements driver.Valuer so that the slice can be used as a query
+// argument for an array column. It is encoded as a PostgreSQL array literal,
+// such as {1,2,3}.
+//
+// A nil slice is encoded as an empty array rather than NULL.
+func (ss Bools) Value() (driver.Value, error) {
+	return util.ArrayLiteral(reflect.ValueOf(ss)), nil
+}
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss Bools) Windows(size int) (windows []Bools) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
+
+// BoolsPair is a pair of elements from the same position of two slices.
+type BoolsPair struct {
+	First, Second bool
+}
+
+// BoolsPairs is created by Zip.
+type BoolsPairs []BoolsPair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss Bools) Zip(ss2 Bools) (pairs BoolsPairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, BoolsPair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs BoolsPairs) Unzip() (ss, ss2 Bools) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss Bools) ZipWith(ss2 Bools, fn func(a, b bool) bool) (ss3 Bools) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package pie
+
+import (
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+var boolsTrueTests = []struct {
+	ss               Bools
+	allTrue, anyTrue bool
+	countTrue        int
+}{
+	{nil, true, false, 0},
+	{Bools{}, true, false, 0},
+	{Bools{true}, true, true, 1},
+	{Bools{false}, false, false, 0},
+	{Bools{true, false, true}, false, true, 2},
+	{Bools{true, true}, true, true, 2},
+}
+
+func TestBools_AllTrue(t *testing.T) {
+	for _, test := range boolsTrueTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.allTrue, test.ss.AllTrue())
+		})
+	}
+}
+
+func TestBools_AnyTrue(t *testing.T) {
+	for _, test := range boolsTrueTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.anyTrue, test.ss.AnyTrue())
+		})
+	}
+}
+
+func TestBools_CountTrue(t *testing.T) {
+	for _, test := range boolsTrueTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.countTrue, test.ss.CountTrue())
+		})
+	}
+}
+
+func TestBools_First(t *testing.T) {
+	assert.False(t, Bools(nil).First())
+	assert.True(t, Bools{true, false}.First())
+	assert.False(t, Bools{true, false}.Last())
+}
+
+func TestBools_Unique(t *testing.T) {
+	assert.Equal(t, Bools{false, true}, Bools{false, true, false, true}.Unique())
+}
+
+func TestBools_Select(t *testing.T) {
+	ss := Bools{true, false, true}
+	isTrue := func(value bool) bool {
+		return value
+	}
+
+	assert.Equal(t, Bools{true, true}, ss.Select(isTrue))
+	assert.Equal(t, Bools{false}, ss.Unselect(isTrue))
+}
+
+func TestBools_ValueAndScan(t *testing.T) {
+	value, err := Bools{true, false}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{true,false}", value)
+
+	var scanned Bools
+	assert.NoError(t, scanned.Scan("{t,f,true}"))
+	assert.Equal(t, Bools{true, false, true}, scanned)
+}
@@ -1,7 +1,16 @@
 package pie
 
-//go:generate pie cars.* carPointers.*
+//go:generate pie -tests cars.* carPointers.*
+//pie:sample {{"Bob", "blue"}, {"Sally", "green"}, {"Bob", "blue"}}
+//pie:filters
+//pie:groupby Color
+//pie:keyby Name
 type cars []car
+
+//pie:sample {{"Bob", "blue"}, nil, {"Sally", "green"}}
+//pie:filters Color
+//pie:groupby Color
+//pie:keyby Name
 type carPointers []*car
 
 type car struct {
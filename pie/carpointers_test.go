@@ -1,6 +1,7 @@
 package pie
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -628,3 +629,526 @@ func TestCarPointers_Random(t *testing.T) {
 		})
 	}
 }
+
+func TestCarPointers_Reduce(t *testing.T) {
+	longestName := func(acc, value *car) *car {
+		if acc == nil || len(value.Name) > len(acc.Name) {
+			return value
+		}
+
+		return acc
+	}
+
+	assert.Nil(t, carPointers(nil).Reduce(nil, longestName))
+	assert.Equal(t, &car{"Baz", "black"},
+		carPointers{&car{"ba", "yellow"}, &car{"Baz", "black"}}.Reduce(nil, longestName))
+}
+
+var carPointersUniqueTests = []struct {
+	ss     carPointers
+	unique carPointers
+}{
+	{
+		nil,
+		nil,
+	},
+	{
+		carPointers{},
+		carPointers{},
+	},
+	{
+		carPointers{carPointerA},
+		carPointers{carPointerA},
+	},
+	{
+		carPointers{carPointerA, carPointerB, carPointerA, nil, nil},
+		carPointers{carPointerA, carPointerB, nil},
+	},
+	{
+		// Pointers are only compared by address.
+		carPointers{carPointerA, &car{"a", "green"}},
+		carPointers{carPointerA, &car{"a", "green"}},
+	},
+}
+
+func TestCarPointers_Unique(t *testing.T) {
+	for _, test := range carPointersUniqueTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.unique, test.ss.Unique())
+		})
+	}
+}
+
+func TestCarPointers_GroupByString(t *testing.T) {
+	color := func(car *car) string {
+		return car.Color
+	}
+
+	assert.Equal(t, map[string]carPointers{}, carPointers(nil).GroupByString(color))
+	assert.Equal(t, map[string]carPointers{
+		"green": {carPointerA},
+		"blue":  {carPointerB},
+	}, carPointers{carPointerA, carPointerB}.GroupByString(color))
+}
+
+var carPointersChunkTests = []struct {
+	ss       carPointers
+	size     int
+	expected []carPointers
+}{
+	{nil, 2, nil},
+	{carPointers{}, 2, nil},
+	{carPointers{carPointerA, carPointerB, carPointerC}, 0, nil},
+	{
+		carPointers{carPointerA, carPointerB, carPointerC},
+		2,
+		[]carPointers{{carPointerA, carPointerB}, {carPointerC}},
+	},
+}
+
+func TestCarPointers_Chunk(t *testing.T) {
+	for _, test := range carPointersChunkTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Chunk(test.size))
+		})
+	}
+}
+
+func TestCarPointers_ShuffleNilSource(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB, carPointerC}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	shuffled := ss.Shuffle(nil)
+	assert.Equal(t, ss.Len(), shuffled.Len())
+	assert.True(t, shuffled.All(ss.Contains))
+	assert.True(t, ss.Contains(ss.Random(nil)))
+}
+
+var carPointersSampleTests = []struct {
+	ss       carPointers
+	n        int
+	source   rand.Source
+	expected carPointers
+}{
+	{nil, 2, rand.NewSource(0), nil},
+	{carPointers{}, 2, rand.NewSource(0), nil},
+	{
+		carPointers{carPointerA, carPointerB, carPointerC},
+		2,
+		rand.NewSource(0),
+		carPointers{carPointerA, carPointerB},
+	},
+}
+
+func TestCarPointers_Sample(t *testing.T) {
+	for _, test := range carPointersSampleTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Sample(test.n, test.source))
+		})
+	}
+}
+
+func TestCarPointers_ToFloat64s(t *testing.T) {
+	nameLength := func(car *car) float64 {
+		return float64(len(car.Name))
+	}
+
+	assert.Equal(t, Float64s(nil), carPointers(nil).ToFloat64s(nameLength))
+	assert.Equal(t, Float64s{1, 0}, carPointers{carPointerA, carPointerEmpty}.ToFloat64s(nameLength))
+}
+
+func TestCarPointers_ToInts(t *testing.T) {
+	nameLength := func(car *car) int {
+		return len(car.Name)
+	}
+
+	assert.Equal(t, Ints(nil), carPointers(nil).ToInts(nameLength))
+	assert.Equal(t, Ints{1, 0}, carPointers{carPointerA, carPointerEmpty}.ToInts(nameLength))
+}
+
+func TestCarPointers_EachWithIndex(t *testing.T) {
+	var values []string
+	collect := func(i int, value *car) {
+		values = append(values, fmt.Sprintf("%d:%v", i, value))
+	}
+
+	values = []string{}
+	carPointers{}.EachWithIndex(collect)
+	assert.Equal(t, []string{}, values)
+
+	values = []string{}
+	ss := carPointers{carPointerA, carPointerB}
+	assert.Equal(t, ss, ss.EachWithIndex(collect))
+	assert.Equal(t, []string{"0:&{a green}", "1:&{b blue}"}, values)
+}
+
+func TestCarPointers_FirstUsing(t *testing.T) {
+	condition := func(value *car) bool {
+		return value.Name != "a"
+	}
+
+	value, ok := carPointers(nil).FirstUsing(condition)
+	assert.Equal(t, &car{}, value)
+	assert.False(t, ok)
+
+	value, ok = carPointers{carPointerA, carPointerB, carPointerC}.FirstUsing(condition)
+	assert.Equal(t, carPointerB, value)
+	assert.True(t, ok)
+}
+
+func TestCarPointers_LastUsing(t *testing.T) {
+	condition := func(value *car) bool {
+		return value.Name != "a"
+	}
+
+	value, ok := carPointers(nil).LastUsing(condition)
+	assert.Equal(t, &car{}, value)
+	assert.False(t, ok)
+
+	value, ok = carPointers{carPointerA, carPointerB, carPointerC}.LastUsing(condition)
+	assert.Equal(t, carPointerC, value)
+	assert.True(t, ok)
+}
+
+var carPointersIndexOfTests = []struct {
+	ss                   carPointers
+	lookingFor           *car
+	indexOf, lastIndexOf int
+}{
+	{nil, carPointerA, -1, -1},
+	{carPointers{}, carPointerA, -1, -1},
+	{carPointers{carPointerA, carPointerB, carPointerA}, carPointerA, 0, 2},
+	{carPointers{carPointerA, carPointerB, carPointerA}, carPointerB, 1, 1},
+	{carPointers{carPointerA, carPointerB, carPointerA}, &car{"a", "green"}, -1, -1},
+}
+
+func TestCarPointers_IndexOf(t *testing.T) {
+	for _, test := range carPointersIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.indexOf, test.ss.IndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestCarPointers_LastIndexOf(t *testing.T) {
+	for _, test := range carPointersIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.lastIndexOf, test.ss.LastIndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestCarPointers_SortUsing(t *testing.T) {
+	byName := func(a, b *car) bool {
+		return a.Name < b.Name
+	}
+
+	ss := carPointers{carPointerC, carPointerA, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers(nil), carPointers(nil).SortUsing(byName))
+	assert.Equal(t, carPointers{carPointerA, carPointerB, carPointerC}, ss.SortUsing(byName))
+	assert.Equal(t, carPointers{carPointerA, carPointerB, carPointerC}, ss.SortStableUsing(byName))
+}
+
+func TestCarPointers_InPlace(t *testing.T) {
+	copyCar := func(car *car) *car {
+		c := *car
+
+		return &c
+	}
+
+	assert.Equal(t, carPointers(nil), carPointers(nil).ReverseInPlace())
+	assert.Equal(t, carPointers(nil), carPointers(nil).ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, carPointers(nil), carPointers(nil).TransformInPlace(copyCar))
+
+	ss := carPointers{carPointerA, carPointerB, carPointerC}
+
+	assert.Equal(t, carPointers{carPointerC, carPointerB, carPointerA}, ss.ReverseInPlace())
+	assert.Equal(t, carPointers{carPointerC, carPointerB, carPointerA}, ss)
+
+	copied := ss.TransformInPlace(copyCar)
+	assert.Equal(t, carPointers{&car{"c", "gray"}, &car{"b", "blue"}, &car{"a", "green"}}, copied)
+	assert.False(t, copied.Contains(carPointerA))
+
+	shuffled := ss.ShuffleInPlace(nil)
+	assert.Equal(t, ss, shuffled)
+}
+
+var carPointersModeTests = []struct {
+	ss       carPointers
+	expected carPointers
+}{
+	{nil, nil},
+	{carPointers{}, nil},
+	{carPointers{carPointerA}, carPointers{carPointerA}},
+	{carPointers{carPointerA, carPointerB, carPointerB, carPointerC}, carPointers{carPointerB}},
+	{carPointers{carPointerC, carPointerA, carPointerB, carPointerA, carPointerC}, carPointers{carPointerC, carPointerA}},
+	{carPointers{carPointerC, carPointerB, carPointerA}, carPointers{carPointerC, carPointerB, carPointerA}},
+}
+
+func TestCarPointers_Mode(t *testing.T) {
+	for _, test := range carPointersModeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Mode())
+		})
+	}
+}
+
+func TestCarPointers_FirstELastE(t *testing.T) {
+	first, err := carPointers(nil).FirstE()
+	assert.Equal(t, &car{}, first)
+	assert.Equal(t, ErrEmptySlice, err)
+
+	last, err := carPointers{carPointerA, carPointerB}.LastE()
+	assert.Equal(t, carPointerB, last)
+	assert.NoError(t, err)
+}
+
+func TestCarPointers_Equals(t *testing.T) {
+	assert.True(t, carPointers(nil).Equals(carPointers{}))
+	assert.True(t, carPointers{carPointerA, carPointerB}.Equals(carPointers{carPointerA, carPointerB}))
+	assert.False(t, carPointers{carPointerA}.Equals(carPointers{&car{"a", "green"}}))
+	assert.True(t, carPointers{carPointerA, carPointerB}.EqualsUnordered(carPointers{carPointerB, carPointerA}))
+}
+
+func TestCarPointersFromJSONString(t *testing.T) {
+	ss, err := carPointersFromJSONString(`[{"Name":"a","Color":"green"},null]`)
+	assert.NoError(t, err)
+	assert.Equal(t, carPointers{carPointerA, nil}, ss)
+
+	data, err := json.Marshal(map[string]carPointers{"cars": nil})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"cars":[]}`, string(data))
+}
+
+func TestCarPointers_CSVString(t *testing.T) {
+	ss := carPointers{carPointerA, nil}
+	csv := "Name,Color\na,green\n,\n"
+
+	assert.Equal(t, csv, ss.CSVString())
+
+	decoded, err := carPointersFromCSVString(csv)
+	assert.NoError(t, err)
+	assert.Equal(t, carPointers{carPointerA, &car{}}, decoded)
+}
+
+func TestCarPointers_Names(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, Strings(nil), carPointers(nil).Names())
+	assert.Equal(t, Strings{"a", "", "b"}, ss.Names())
+	assert.Equal(t, Strings{"green", "", "blue"}, ss.Colors())
+}
+
+func TestCarPointers_WithColor(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers(nil), carPointers(nil).WithColor("green"))
+	assert.Equal(t, carPointers{carPointerA}, ss.WithColor("green"))
+	assert.Equal(t, carPointers(nil), ss.WithColor(""))
+}
+
+func TestCarPointers_SortByName(t *testing.T) {
+	ss := carPointers{carPointerB, nil, carPointerA}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{nil, carPointerA, carPointerB}, ss.SortByName())
+	assert.Equal(t, carPointers{carPointerB, carPointerA, nil}, ss.SortByNameDesc())
+}
+
+func TestCarPointers_GroupByColorAndKeyByName(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, map[string]carPointers{
+		"green": {carPointerA},
+		"blue":  {carPointerB},
+	}, ss.GroupByColor())
+	assert.Equal(t, map[string]*car{
+		"a": carPointerA,
+		"b": carPointerB,
+	}, ss.KeyByName())
+}
+
+var carPointersDropNilTests = []struct {
+	ss       carPointers
+	expected carPointers
+}{
+	{nil, nil},
+	{carPointers{}, nil},
+	{carPointers{nil, nil}, nil},
+	{carPointers{carPointerA, carPointerB}, carPointers{carPointerA, carPointerB}},
+	{carPointers{nil, carPointerA, nil, carPointerB, nil}, carPointers{carPointerA, carPointerB}},
+}
+
+func TestCarPointers_DropNil(t *testing.T) {
+	for _, test := range carPointersDropNilTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCarPointers(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.DropNil())
+		})
+	}
+}
+
+func TestCarPointers_ContainsAndEqualsNil(t *testing.T) {
+	ss := carPointers{carPointerA, nil}
+
+	assert.True(t, ss.Contains(nil))
+	assert.False(t, carPointers{carPointerA}.Contains(nil))
+	assert.True(t, ss.Equals(carPointers{carPointerA, nil}))
+	assert.False(t, ss.Equals(carPointers{carPointerA, carPointerB}))
+	assert.True(t, ss.EqualsUnordered(carPointers{nil, carPointerA}))
+	assert.Equal(t, 1, ss.IndexOf(nil))
+}
+
+func TestCarPointers_TakeAndDrop(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB, carPointerC}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{carPointerA}, ss.Take(1))
+	assert.Equal(t, carPointers{carPointerB, carPointerC}, ss.Drop(1))
+	assert.Equal(t, carPointers(nil), carPointers(nil).Drop(0))
+}
+
+func TestCarPointers_TakeWhileAndDropWhile(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	notNil := func(car *car) bool {
+		return car != nil
+	}
+
+	assert.Equal(t, carPointers{carPointerA}, ss.TakeWhile(notNil))
+	assert.Equal(t, carPointers{nil, carPointerB}, ss.DropWhile(notNil))
+}
+
+func TestCarPointers_Partition(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	matching, rest := ss.Partition(func(car *car) bool {
+		return car != nil
+	})
+	assert.Equal(t, carPointers{carPointerA, carPointerB}, matching)
+	assert.Equal(t, carPointers{nil}, rest)
+}
+
+func TestCarPointers_Zip(t *testing.T) {
+	ss := carPointers{carPointerA, nil}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointersPairs{{carPointerA, carPointerB}, {nil, carPointerC}},
+		ss.Zip(carPointers{carPointerB, carPointerC}))
+	assert.Equal(t, carPointers{carPointerB, carPointerC},
+		ss.ZipWith(carPointers{carPointerB, carPointerC}, func(a, b *car) *car {
+			return b
+		}))
+}
+
+func TestFlattencarPointers(t *testing.T) {
+	assert.Equal(t, carPointers{carPointerA, nil, carPointerB},
+		FlattencarPointers([]carPointers{{carPointerA}, {nil, carPointerB}}))
+}
+
+func TestCarPointers_Interleave(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{carPointerA, nil, carPointerB, carPointerC},
+		ss.Interleave(carPointers{nil, carPointerC}))
+}
+
+func TestCarPointers_InsertDeleteAtAndSplice(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{carPointerA, carPointerB, nil}, ss.Insert(2, nil))
+	assert.Equal(t, carPointers(nil), ss.DeleteAt(0, 1))
+	assert.Equal(t, carPointers{carPointerC, carPointerB}, ss.Splice(0, 1, carPointerC))
+}
+
+func TestCarPointers_PopShiftAndSplitAt(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	last, rest := ss.Pop()
+	assert.Equal(t, carPointerB, last)
+	assert.Equal(t, carPointers{carPointerA}, rest)
+
+	first, rest := carPointers(nil).Shift()
+	assert.Equal(t, carPointerEmpty, first)
+	assert.Equal(t, carPointers(nil), rest)
+
+	left, right := ss.SplitAt(0)
+	assert.Equal(t, carPointers(nil), left)
+	assert.Equal(t, carPointers{carPointerA, carPointerB}, right)
+}
+
+func TestCarPointers_RotateAndSwap(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{nil, carPointerB, carPointerA}, ss.Rotate(2))
+	assert.Equal(t, carPointers{nil, carPointerA, carPointerB}, ss.Swap(0, 1))
+}
+
+func TestCarPointers_CompactAndCoalesceOr(t *testing.T) {
+	ss := carPointers{nil, carPointerA, nil, carPointerEmpty}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, carPointers{carPointerA, carPointerEmpty}, ss.Compact())
+	assert.Equal(t, carPointerA, ss.CoalesceOr(carPointerB))
+	assert.Equal(t, carPointerB, carPointers{nil}.CoalesceOr(carPointerB))
+}
+
+func TestCarPointers_WindowsAndPairwise(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerB}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, []carPointers{{carPointerA, nil}, {nil, carPointerB}}, ss.Windows(2))
+	assert.Equal(t, [][2]*car{{carPointerA, nil}, {nil, carPointerB}}, ss.Pairwise())
+}
+
+func TestCarPointers_Accumulate(t *testing.T) {
+	ss := carPointers{nil, carPointerA, nil}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	lastNonNil := func(acc, value *car) *car {
+		if value != nil {
+			return value
+		}
+
+		return acc
+	}
+
+	assert.Equal(t, carPointers{carPointerB, carPointerA, carPointerA}, ss.Accumulate(carPointerB, lastNonNil))
+}
+
+func TestCarPointers_FrequenciesAndCountUsing(t *testing.T) {
+	ss := carPointers{carPointerA, nil, carPointerA}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, map[*car]int{carPointerA: 2, nil: 1}, ss.Frequencies())
+	assert.Equal(t, 1, ss.CountUsing(func(car *car) bool {
+		return car == nil
+	}))
+}
+
+func TestCarPointers_ToSetAndKeyByString(t *testing.T) {
+	ss := carPointers{carPointerA, carPointerB, carPointerA}
+	defer assertImmutableCarPointers(t, &ss)()
+
+	assert.Equal(t, map[*car]struct{}{carPointerA: {}, carPointerB: {}}, ss.ToSet())
+	assert.Equal(t, map[string]*car{"green": carPointerA, "blue": carPointerB}, ss.KeyByString(func(car *car) string {
+		return car.Color
+	}))
+}
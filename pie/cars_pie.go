@@ -1,11 +1,47 @@
 package pie
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/elliotchance/pie/pie/util"
 	"math/rand"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
 )
 
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss cars) Accumulate(initial car, fn func(acc, value car) car) cars {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(cars, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
+
 // All will return true if all callbacks return true. It follows the same logic
 // as the all() function in Python.
 //
@@ -34,13 +70,22 @@ func (ss cars) Any(fn func(value car) bool) bool {
 	return false
 }
 
-// Append will return a new slice with the elements appended to the end. It is a
-// wrapper for the internal append(). It is offered as a function so that it can
-// more easily chained.
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
 //
 // It is acceptable to provide zero arguments.
 func (ss cars) Append(elements ...car) cars {
-	return append(ss, elements...)
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(cars, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
 }
 
 // Bottom will return n elements from bottom
@@ -59,6 +104,101 @@ func (ss cars) Bottom(n int) (top cars) {
 	return
 }
 
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
+//
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesiancars for more
+// than two slices.
+func (ss cars) Cartesian(ss2 cars) carsPairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
+	}
+
+	pairs := make(carsPairs, 0, len(ss)*len(ss2))
+	for _, s := range ss {
+		for _, s2 := range ss2 {
+			pairs = append(pairs, carsPair{s, s2})
+		}
+	}
+
+	return pairs
+}
+
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
+//
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
+//
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss cars) Chunk(size int) (chunks []cars) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		chunks = append(chunks, ss[i:end:end])
+	}
+
+	return
+}
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss cars) CoalesceOr(defaultValue car) car {
+	var zero car
+
+	for _, s := range ss {
+		if s != zero {
+			return s
+		}
+	}
+
+	return defaultValue
+}
+
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss cars) Combinations(k int) (combinations []cars) {
+	ss.EachCombination(k, func(combination cars) bool {
+		c := make(cars, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
+
+	return
+}
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss cars) Compact() (ss2 cars) {
+	var zero car
+
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
 // Contains returns true if the element exists in the slice.
 //
 // When using slices of pointers it will only compare by address, not value.
@@ -72,6 +212,87 @@ func (ss cars) Contains(lookingFor car) bool {
 	return false
 }
 
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss cars) CountUsing(condition func(car) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
+	}
+
+	return
+}
+
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss cars) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
+}
+
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
+//
+// The returned slice may contain zero elements (nil).
+func (ss cars) DeleteAt(indexes ...int) (ss2 cars) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
+	}
+
+	for i, s := range ss {
+		if !deleted[i] {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss cars) Drop(n int) (ss2 cars) {
+	if n < 0 {
+		n = 0
+	}
+
+	if n >= len(ss) {
+		return nil
+	}
+
+	ss2 = make(cars, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
+}
+
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See TakeWhile().
+func (ss cars) DropWhile(condition func(car) bool) (ss2 cars) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
+	}
+
+	return
+}
+
 // Each is more condensed version of Transform that allows an action to happen
 // on each elements and pass the original slice on.
 //
@@ -95,153 +316,1491 @@ func (ss cars) Each(fn func(car)) cars {
 	return ss
 }
 
-// Extend will return a new slice with the slices of elements appended to the
-// end.
+// EachCartesiancars calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
 //
-// It is acceptable to provide zero arguments.
-func (ss cars) Extend(slices ...cars) (ss2 cars) {
-	ss2 = ss
+//   EachCartesiancars(func(values cars) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesiancars(fn func(cars) bool, slices ...cars) {
+	if len(slices) == 0 {
+		return
+	}
 
-	for _, slice := range slices {
-		ss2 = ss2.Append(slice...)
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
 	}
 
-	return ss2
-}
+	indexes := make([]int, len(slices))
+	values := make(cars, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
 
-// First returns the first element, or zero. Also see FirstOr().
-func (ss cars) First() car {
-	return ss.FirstOr(car{})
-}
+	for {
+		if !fn(values) {
+			return
+		}
 
-// FirstOr returns the first element or a default value if there are no
-// elements.
-func (ss cars) FirstOr(defaultValue car) car {
-	if len(ss) == 0 {
-		return defaultValue
-	}
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
 
-	return ss[0]
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
 }
 
-// JSONString returns the JSON encoded array as a string.
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
 //
-// One important thing to note is that it will treat a nil slice as an empty
-// slice to ensure that the JSON value return is always an array.
-func (ss cars) JSONString() string {
-	if ss == nil {
-		return "[]"
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss cars) EachCombination(k int, fn func(cars) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
 	}
 
-	// An error should not be possible.
-	data, _ := json.Marshal(ss)
+	indexes := make([]int, k)
+	combination := make(cars, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
 
-	return string(data)
-}
+	for {
+		if !fn(combination) {
+			return
+		}
 
-// Last returns the last element, or zero. Also see LastOr().
-func (ss cars) Last() car {
-	return ss.LastOr(car{})
-}
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
 
-// LastOr returns the last element or a default value if there are no elements.
-func (ss cars) LastOr(defaultValue car) car {
-	if len(ss) == 0 {
-		return defaultValue
-	}
+		if i < 0 {
+			return
+		}
 
-	return ss[len(ss)-1]
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
 }
 
-// Len returns the number of elements.
-func (ss cars) Len() int {
-	return len(ss)
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss cars) EachErr(fn func(car) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// Random returns a random element by your rand.Source, or zero
-func (ss cars) Random(source rand.Source) car {
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss cars) EachPermutation(fn func(cars) bool) {
 	n := len(ss)
+	if n == 0 {
+		return
+	}
 
-	// Avoid the extra allocation.
-	if n < 1 {
-		return car{}
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(cars, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
 	}
-	if n < 2 {
-		return ss[0]
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
 	}
-	rnd := rand.New(source)
-	i := rnd.Intn(n)
-	return ss[i]
 }
 
-// Reverse returns a new copy of the slice with the elements ordered in reverse.
-// This is useful when combined with Sort to get a descending sort order:
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
 //
-//   ss.Sort().Reverse()
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
 //
-func (ss cars) Reverse() cars {
-	// Avoid the allocation. If there is one element or less it is already
-	// reversed.
-	if len(ss) < 2 {
-		return ss
+func (ss cars) EachWithIndex(fn func(int, car)) cars {
+	for i, s := range ss {
+		fn(i, s)
 	}
 
-	sorted := make([]car, len(ss))
-	for i := 0; i < len(ss); i++ {
-		sorted[i] = ss[len(ss)-i-1]
+	return ss
+}
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss cars) Equals(ss2 cars) bool {
+	if len(ss) != len(ss2) {
+		return false
 	}
 
-	return sorted
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Select will return a new slice containing only the elements that return
-// true from the condition. The returned slice may contain zero elements (nil).
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
 //
-// Unselect works in the opposite way as Select.
-func (ss cars) Select(condition func(car) bool) (ss2 cars) {
+// When using slices of pointers it will only compare by address, not value.
+func (ss cars) EqualsUnordered(ss2 cars) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[car]int{}
 	for _, s := range ss {
-		if condition(s) {
-			ss2 = append(ss2, s)
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
 		}
+
+		counts[s]--
 	}
 
-	return
+	return true
 }
 
-// Shuffle returns shuffled slice by your rand.Source
-func (ss cars) Shuffle(source rand.Source) cars {
-	n := len(ss)
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// Like Append, the result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss cars) Extend(slices ...cars) cars {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
 
-	// Avoid the extra allocation.
-	if n < 2 {
+	// Avoid the allocation.
+	if n == 0 {
 		return ss
 	}
 
-	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
-	// the algorithm directly from the go source: src/math/rand/rand.go below,
-	// with some adjustments:
-	shuffled := make([]car, n)
-	copy(shuffled, ss)
+	result := make(cars, len(ss), len(ss)+n)
+	copy(result, ss)
 
-	rnd := rand.New(source)
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
 
-	util.Shuffle(rnd, n, func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+	return result
+}
 
-	return shuffled
+// First returns the first element, or zero. Also see FirstOr().
+func (ss cars) First() car {
+	return ss.FirstOr(car{})
 }
 
-// Top will return n elements from head of the slice
-// if the slice has less elements then n that'll return all elements
-// if n < 0 it'll return empty slice.
-func (ss cars) Top(n int) (top cars) {
+// FirstE returns the first element. Unlike First, ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss cars) FirstE() (car, error) {
+	if len(ss) == 0 {
+		return car{}, ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss cars) FirstOr(defaultValue car) car {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss cars) FirstUsing(condition func(car) bool) (car, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return car{}, false
+}
+
+// Flattencars returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func Flattencars(slices []cars) (ss cars) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss cars) Frequencies() map[car]int {
+	frequencies := make(map[car]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
+
+// carsFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func carsFromCSVString(s string) (ss cars, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
+
+// carsFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func carsFromChannel(ch <-chan car) (ss cars) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// carsFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func carsFromJSONString(s string) (ss cars, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss cars) GroupByString(fn func(car) string) map[string]cars {
+	group := map[string]cars{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss cars) IndexOf(lookingFor car) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss cars) Insert(index int, values ...car) cars {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(cars, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss cars) Interleave(ss2 cars) (ss3 cars) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
+
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss cars) JSONBytes() []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return data
+}
+
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+// See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss cars) JSONBytesIndent(prefix, indent string) []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return data
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss cars) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return string(data)
+}
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss cars) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss cars) KeyByString(fn func(car) string) map[string]car {
+	m := make(map[string]car, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss cars) Last() car {
+	return ss.LastOr(car{})
+}
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss cars) LastIndexOf(lookingFor car) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastE returns the last element. Unlike Last, ErrEmptySlice is returned
+// if there are no elements so that a zero value can be distinguished from an
+// empty slice.
+func (ss cars) LastE() (car, error) {
+	if len(ss) == 0 {
+		return car{}, ErrEmptySlice
+	}
+
+	return ss[len(ss)-1], nil
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss cars) LastOr(defaultValue car) car {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss cars) LastUsing(condition func(car) bool) (car, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return car{}, false
+}
+
+// Len returns the number of elements.
+func (ss cars) Len() int {
+	return len(ss)
+}
+
+// carsLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type carsLazy struct {
+	iterate func(fn func(car) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss cars) Lazy() carsLazy {
+	return carsLazy{
+		iterate: func(fn func(car) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l carsLazy) Select(condition func(car) bool) carsLazy {
+	return carsLazy{
+		iterate: func(fn func(car) bool) {
+			l.iterate(func(s car) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l carsLazy) Unselect(condition func(car) bool) carsLazy {
+	return carsLazy{
+		iterate: func(fn func(car) bool) {
+			l.iterate(func(s car) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l carsLazy) Transform(transform func(car) car) carsLazy {
+	return carsLazy{
+		iterate: func(fn func(car) bool) {
+			l.iterate(func(s car) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l carsLazy) Top(n int) carsLazy {
+	return carsLazy{
+		iterate: func(fn func(car) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s car) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l carsLazy) Collect() (ss cars) {
+	l.iterate(func(s car) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss cars) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]car(ss))
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss cars) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []car{}, nil
+	}
+
+	return []car(ss), nil
+}
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss cars) Mode() (mode cars) {
+	counts := map[car]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss cars) OrderBy(less ...func(a, b car) bool) cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss cars) Page(pageNumber, pageSize int) cars {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss cars) Pairwise() (pairs [][2]car) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]car{ss[i-1], ss[i]})
+	}
+
+	return
+}
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss cars) Partition(condition func(car) bool) (matching, rest cars) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss cars) Permutations() (permutations []cars) {
+	ss.EachPermutation(func(permutation cars) bool {
+		p := make(cars, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss cars) Pop() (last car, rest cars) {
+	n := len(ss)
+	if n == 0 {
+		return car{}, nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
+
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
+func (ss cars) Random(source rand.Source) car {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 1 {
+		return car{}
+	}
+	if n < 2 {
+		return ss[0]
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	i := rnd.Intn(n)
+	return ss[i]
+}
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss cars) Reduce(initial car, fn func(acc, value car) car) car {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//   ss.Sort().Reverse()
+//
+func (ss cars) Reverse() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]car, len(ss))
+	for i := 0; i < len(ss); i++ {
+		sorted[i] = ss[len(ss)-i-1]
+	}
+
+	return sorted
+}
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss cars) Rotate(n int) cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(cars, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss cars) RotateInPlace(n int) cars {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s cars) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss cars) Sample(n int, source rand.Source) cars {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(cars, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss cars) ReverseInPlace() cars {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss cars) Select(condition func(car) bool) (ss2 cars) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss cars) SortStableUsing(less func(a, b car) bool) cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss cars) SortUsing(less func(a, b car) bool) cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss cars) Splice(start, deleteCount int, values ...car) cars {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(cars, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss cars) SplitAt(index int) (left, right cars) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss cars) Shift() (first car, rest cars) {
+	if len(ss) == 0 {
+		return car{}, nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss cars) Swap(i, j int) cars {
+	swapped := make(cars, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss cars) SwapInPlace(i, j int) cars {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
+
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
+func (ss cars) Shuffle(source rand.Source) cars {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 2 {
+		return ss
+	}
+
+	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
+	// the algorithm directly from the go source: src/math/rand/rand.go below,
+	// with some adjustments:
+	shuffled := make([]car, n)
+	copy(shuffled, ss)
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	util.Shuffle(rnd, n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss cars) ShuffleInPlace(source rand.Source) cars {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
+
+// carsSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A carsSync must
+// not be copied after first use.
+type carsSync struct {
+	mu sync.RWMutex
+	ss cars
+}
+
+// Append adds the values to the end of the slice.
+func (s *carsSync) Append(values ...car) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *carsSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *carsSync) Snapshot() cars {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(cars, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss cars) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *carsSync) Read(fn func(ss cars)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss cars) Take(n int) (ss2 cars) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(cars, n)
+	copy(ss2, ss)
+
+	return
+}
+
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss cars) TakeWhile(condition func(car) bool) (ss2 cars) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
+}
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss cars) Top(n int) (top cars) {
 	for i := 0; i < len(ss) && n > 0; i++ {
 		top = append(top, ss[i])
 		n--
 	}
 
-	return
+	return
+}
+
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss cars) ToChannel() <-chan car {
+	ch := make(chan car)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss cars) ToChannelCtx(ctx context.Context) <-chan car {
+	ch := make(chan car)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ToFloat64s transforms each element to a float64.
+func (ss cars) ToFloat64s(transform func(car) float64) Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss cars) ToFloat64sErr(transform func(car) (float64, error)) (Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToInts transforms each element to an int.
+func (ss cars) ToInts(transform func(car) int) Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToIntsErr works the same as ToInts, except that transform may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss cars) ToIntsErr(transform func(car) (int, error)) (Ints, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss cars) ToSet() map[car]struct{} {
+	set := make(map[car]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
 }
 
 // ToStrings transforms each element to a string.
@@ -261,6 +1820,22 @@ func (ss cars) ToStrings(transform func(car) string) Strings {
 	return result
 }
 
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss cars) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
+
 // Transform will return a new slice where each element has been transformed.
 // The number of element returned will always be the same as the input.
 //
@@ -280,6 +1855,153 @@ func (ss cars) Transform(fn func(car) car) (ss2 cars) {
 	return
 }
 
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss cars) TransformErr(fn func(car) (car, error)) (cars, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]car, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss cars) TransformInPlace(fn func(car) car) cars {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss cars) TransformParallel(fn func(car) car, workers int) (ss2 cars) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]car, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See AreUnique().
+func (ss cars) Unique() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[car]struct{}{}
+	uniqueValues := cars{}
+
+	for _, value := range ss {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *cars) UnmarshalJSON(data []byte) error {
+	var elements []car
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []car{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *cars) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []car
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []car{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
 // Unselect works the same as Select, with a negated condition. That is, it will
 // return a new slice only containing the elements that returned false from the
 // condition. The returned slice may contain zero elements (nil).
@@ -292,3 +2014,218 @@ func (ss cars) Unselect(condition func(car) bool) (ss2 cars) {
 
 	return
 }
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss cars) Windows(size int) (windows []cars) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
+
+// carsPair is a pair of elements from the same position of two slices.
+type carsPair struct {
+	First, Second car
+}
+
+// carsPairs is created by Zip.
+type carsPairs []carsPair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss cars) Zip(ss2 cars) (pairs carsPairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, carsPair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs carsPairs) Unzip() (ss, ss2 cars) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss cars) ZipWith(ss2 cars, fn func(a, b car) car) (ss3 cars) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
+
+// Names returns the Name field of each element.
+func (ss cars) Names() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	names := make(Strings, len(ss))
+	for i, s := range ss {
+		names[i] = s.Name
+	}
+
+	return names
+}
+
+// Colors returns the Color field of each element.
+func (ss cars) Colors() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	colors := make(Strings, len(ss))
+	for i, s := range ss {
+		colors[i] = s.Color
+	}
+
+	return colors
+}
+
+// SortByName returns a new slice sorted by Name in ascending order.
+// Elements with the same Name will keep their original order.
+func (ss cars) SortByName() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
+
+// SortByNameDesc returns a new slice sorted by Name in descending order.
+// Elements with the same Name will keep their original order.
+func (ss cars) SortByNameDesc() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name > sorted[j].Name
+	})
+
+	return sorted
+}
+
+// SortByColor returns a new slice sorted by Color in ascending order.
+// Elements with the same Color will keep their original order.
+func (ss cars) SortByColor() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Color < sorted[j].Color
+	})
+
+	return sorted
+}
+
+// SortByColorDesc returns a new slice sorted by Color in descending order.
+// Elements with the same Color will keep their original order.
+func (ss cars) SortByColorDesc() cars {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(cars, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Color > sorted[j].Color
+	})
+
+	return sorted
+}
+
+// WithName returns a new slice containing only the elements where Name is
+// equal to name. The returned slice may contain zero elements (nil).
+func (ss cars) WithName(name string) (ss2 cars) {
+	for _, s := range ss {
+		if s.Name == name {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// WithColor returns a new slice containing only the elements where Color is
+// equal to color. The returned slice may contain zero elements (nil).
+func (ss cars) WithColor(color string) (ss2 cars) {
+	for _, s := range ss {
+		if s.Color == color {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// KeyByName returns a map of the elements using their Name field as the
+// key. If more than one element has the same Name the last one is used.
+func (ss cars) KeyByName() map[string]car {
+	keyed := make(map[string]car, len(ss))
+
+	for _, s := range ss {
+		keyed[s.Name] = s
+	}
+
+	return keyed
+}
+
+// GroupByColor returns the elements grouped by their Color field. The
+// elements in each group will retain their original order.
+func (ss cars) GroupByColor() map[string]cars {
+	group := map[string]cars{}
+
+	for _, s := range ss {
+		group[s.Color] = append(group[s.Color], s)
+	}
+
+	return group
+}
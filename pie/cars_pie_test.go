@@ -0,0 +1,101 @@
+//go:build go1.18
+// +build go1.18
+
+package pie
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func checkCarsGenerated(t *testing.T, ss cars) {
+	equal := func(a, b cars) bool {
+		if len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !reflect.DeepEqual(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	original := make(cars, len(ss))
+	copy(original, ss)
+
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+		{"Len", func() bool {
+			return ss.Len() == len(ss)
+		}},
+		{"Reverse", func() bool {
+			return equal(ss.Reverse().Reverse(), ss)
+		}},
+		{"FirstAndLast", func() bool {
+			return func() bool {
+				if len(ss) == 0 {
+					return reflect.DeepEqual(ss.First(), car{}) &&
+						reflect.DeepEqual(ss.Last(), car{})
+				}
+
+				return reflect.DeepEqual(ss.First(), ss[0]) && reflect.DeepEqual(ss.Last(), ss[len(ss)-1])
+			}()
+		}},
+		{"Contains", func() bool {
+			return ss.All(ss.Contains)
+		}},
+		{"TopAndBottom", func() bool {
+			return equal(ss.Top(len(ss)), ss) && equal(ss.Bottom(len(ss)), ss.Reverse())
+		}},
+		{"SelectAndUnselect", func() bool {
+			return equal(ss.Select(func(car) bool { return true }), ss) &&
+				len(ss.Unselect(func(car) bool { return true })) == 0
+		}},
+		{"Shuffle", func() bool {
+			return len(ss.Shuffle(rand.NewSource(0))) == len(ss)
+		}},
+		{"JSON", func() bool {
+			return func() bool {
+				ss2, err := carsFromJSONString(ss.JSONString())
+				return err == nil && ss2.JSONString() == ss.JSONString()
+			}()
+		}},
+	}
+
+	for _, test := range tests {
+		if !test.check() {
+			t.Errorf("%s failed for %v", test.name, ss)
+		}
+
+		if !equal(ss, original) {
+			t.Fatalf("%s modified the slice", test.name)
+		}
+	}
+}
+
+func TestCars_Generated(t *testing.T) {
+	for _, ss := range []cars{nil, {}, {{"Bob", "blue"}, {"Sally", "green"}, {"Bob", "blue"}}} {
+		checkCarsGenerated(t, ss)
+	}
+}
+
+func FuzzCars_Generated(f *testing.F) {
+	for _, ss := range []cars{nil, {}, {{"Bob", "blue"}, {"Sally", "green"}, {"Bob", "blue"}}} {
+		f.Add([]byte(ss.JSONString()))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ss cars
+		if ss.UnmarshalJSON(data) != nil {
+			return
+		}
+
+		checkCarsGenerated(t, ss)
+	})
+}
@@ -2,6 +2,7 @@ package pie
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"testing"
@@ -612,3 +613,564 @@ func TestCars_Random(t *testing.T) {
 		})
 	}
 }
+
+func TestCars_Reduce(t *testing.T) {
+	join := func(acc, value car) car {
+		return car{acc.Name + value.Name, value.Color}
+	}
+
+	assert.Equal(t, car{"x", ""}, cars(nil).Reduce(car{"x", ""}, join))
+	assert.Equal(t, car{"barBaz", "black"},
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}}.Reduce(car{}, join))
+}
+
+var carsUniqueTests = []struct {
+	ss     cars
+	unique cars
+}{
+	{
+		nil,
+		nil,
+	},
+	{
+		cars{},
+		cars{},
+	},
+	{
+		cars{car{"bar", "yellow"}},
+		cars{car{"bar", "yellow"}},
+	},
+	{
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"bar", "yellow"}},
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}},
+	},
+	{
+		cars{car{"bar", "yellow"}, car{"bar", "black"}, car{"foo", "red"}},
+		cars{car{"bar", "yellow"}, car{"bar", "black"}, car{"foo", "red"}},
+	},
+}
+
+func TestCars_Unique(t *testing.T) {
+	for _, test := range carsUniqueTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.unique, test.ss.Unique())
+		})
+	}
+}
+
+func TestCars_GroupByString(t *testing.T) {
+	color := func(car car) string {
+		return car.Color
+	}
+
+	assert.Equal(t, map[string]cars{}, cars(nil).GroupByString(color))
+	assert.Equal(t, map[string]cars{
+		"red":  {car{"foo", "red"}, car{"qux", "red"}},
+		"blue": {car{"bar", "blue"}},
+	}, cars{car{"foo", "red"}, car{"bar", "blue"}, car{"qux", "red"}}.GroupByString(color))
+}
+
+var carsChunkTests = []struct {
+	ss       cars
+	size     int
+	expected []cars
+}{
+	{nil, 2, nil},
+	{cars{}, 2, nil},
+	{cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"foo", "red"}}, 0, nil},
+	{
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"foo", "red"}},
+		2,
+		[]cars{{car{"bar", "yellow"}, car{"Baz", "black"}}, {car{"foo", "red"}}},
+	},
+}
+
+func TestCars_Chunk(t *testing.T) {
+	for _, test := range carsChunkTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Chunk(test.size))
+		})
+	}
+}
+
+func TestCars_ShuffleNilSource(t *testing.T) {
+	ss := cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"foo", "red"}}
+	defer assertImmutableCars(t, &ss)()
+
+	shuffled := ss.Shuffle(nil)
+	assert.Equal(t, ss.Len(), shuffled.Len())
+	assert.True(t, shuffled.All(ss.Contains))
+	assert.True(t, ss.Contains(ss.Random(nil)))
+}
+
+var carsSampleTests = []struct {
+	ss       cars
+	n        int
+	source   rand.Source
+	expected cars
+}{
+	{nil, 2, rand.NewSource(0), nil},
+	{cars{}, 2, rand.NewSource(0), nil},
+	{
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"foo", "red"}},
+		2,
+		rand.NewSource(0),
+		cars{car{"bar", "yellow"}, car{"Baz", "black"}},
+	},
+}
+
+func TestCars_Sample(t *testing.T) {
+	for _, test := range carsSampleTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Sample(test.n, test.source))
+		})
+	}
+}
+
+func TestCars_ToFloat64s(t *testing.T) {
+	nameLength := func(car car) float64 {
+		return float64(len(car.Name))
+	}
+
+	assert.Equal(t, Float64s(nil), cars(nil).ToFloat64s(nameLength))
+	assert.Equal(t, Float64s{3, 1}, cars{car{"bar", "yellow"}, car{"B", "black"}}.ToFloat64s(nameLength))
+}
+
+func TestCars_ToInts(t *testing.T) {
+	nameLength := func(car car) int {
+		return len(car.Name)
+	}
+
+	assert.Equal(t, Ints(nil), cars(nil).ToInts(nameLength))
+	assert.Equal(t, Ints{3, 1}, cars{car{"bar", "yellow"}, car{"B", "black"}}.ToInts(nameLength))
+}
+
+func TestCars_EachWithIndex(t *testing.T) {
+	var values []string
+	collect := func(i int, value car) {
+		values = append(values, fmt.Sprintf("%d:%v", i, value))
+	}
+
+	values = []string{}
+	cars{}.EachWithIndex(collect)
+	assert.Equal(t, []string{}, values)
+
+	values = []string{}
+	ss := cars{car{"bar", "yellow"}, car{"Baz", "black"}}
+	assert.Equal(t, ss, ss.EachWithIndex(collect))
+	assert.Equal(t, []string{"0:{bar yellow}", "1:{Baz black}"}, values)
+}
+
+func TestCars_FirstUsing(t *testing.T) {
+	condition := func(value car) bool {
+		return value.Color == "red"
+	}
+
+	value, ok := cars(nil).FirstUsing(condition)
+	assert.Equal(t, car{}, value)
+	assert.False(t, ok)
+
+	value, ok = cars{car{"bar", "yellow"}, car{"foo", "red"}, car{"qux", "red"}}.FirstUsing(condition)
+	assert.Equal(t, car{"foo", "red"}, value)
+	assert.True(t, ok)
+}
+
+func TestCars_LastUsing(t *testing.T) {
+	condition := func(value car) bool {
+		return value.Color == "red"
+	}
+
+	value, ok := cars(nil).LastUsing(condition)
+	assert.Equal(t, car{}, value)
+	assert.False(t, ok)
+
+	value, ok = cars{car{"bar", "yellow"}, car{"foo", "red"}, car{"qux", "red"}}.LastUsing(condition)
+	assert.Equal(t, car{"qux", "red"}, value)
+	assert.True(t, ok)
+}
+
+var carsIndexOfTests = []struct {
+	ss                   cars
+	lookingFor           car
+	indexOf, lastIndexOf int
+}{
+	{nil, car{"bar", "yellow"}, -1, -1},
+	{cars{}, car{"bar", "yellow"}, -1, -1},
+	{cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"bar", "yellow"}}, car{"bar", "yellow"}, 0, 2},
+	{cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"bar", "yellow"}}, car{"Baz", "black"}, 1, 1},
+	{cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"bar", "yellow"}}, car{"foo", "red"}, -1, -1},
+}
+
+func TestCars_IndexOf(t *testing.T) {
+	for _, test := range carsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.indexOf, test.ss.IndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestCars_LastIndexOf(t *testing.T) {
+	for _, test := range carsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.lastIndexOf, test.ss.LastIndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestCars_SortUsing(t *testing.T) {
+	byName := func(a, b car) bool {
+		return a.Name < b.Name
+	}
+
+	ss := cars{car{"foo", "red"}, car{"bar", "yellow"}, car{"Baz", "black"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars(nil), cars(nil).SortUsing(byName))
+	assert.Equal(t, cars{car{"Baz", "black"}, car{"bar", "yellow"}, car{"foo", "red"}}, ss.SortUsing(byName))
+}
+
+func TestCars_SortStableUsing(t *testing.T) {
+	byColor := func(a, b car) bool {
+		return a.Color < b.Color
+	}
+
+	ss := cars{car{"foo", "red"}, car{"bar", "blue"}, car{"qux", "red"}, car{"Baz", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars(nil), cars(nil).SortStableUsing(byColor))
+	assert.Equal(t,
+		cars{car{"bar", "blue"}, car{"Baz", "blue"}, car{"foo", "red"}, car{"qux", "red"}},
+		ss.SortStableUsing(byColor))
+}
+
+func TestCars_InPlace(t *testing.T) {
+	upper := func(car car) car {
+		car.Name = strings.ToUpper(car.Name)
+
+		return car
+	}
+
+	assert.Equal(t, cars(nil), cars(nil).ReverseInPlace())
+	assert.Equal(t, cars(nil), cars(nil).ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, cars(nil), cars(nil).TransformInPlace(upper))
+
+	ss := cars{car{"bar", "yellow"}, car{"Baz", "black"}}
+
+	assert.Equal(t, cars{car{"Baz", "black"}, car{"bar", "yellow"}}, ss.ReverseInPlace())
+	assert.Equal(t, cars{car{"Baz", "black"}, car{"bar", "yellow"}}, ss)
+
+	assert.Equal(t, cars{car{"BAZ", "black"}, car{"BAR", "yellow"}}, ss.TransformInPlace(upper))
+	assert.Equal(t, cars{car{"BAZ", "black"}, car{"BAR", "yellow"}}, ss)
+
+	shuffled := ss.ShuffleInPlace(nil)
+	assert.Equal(t, ss, shuffled)
+}
+
+var carsModeTests = []struct {
+	ss       cars
+	expected cars
+}{
+	{nil, nil},
+	{cars{}, nil},
+	{cars{car{"bar", "yellow"}}, cars{car{"bar", "yellow"}}},
+	{cars{car{"bar", "yellow"}, car{"Baz", "black"}, car{"Baz", "black"}, car{"foo", "red"}}, cars{car{"Baz", "black"}}},
+	{cars{car{"foo", "red"}, car{"bar", "yellow"}, car{"Baz", "black"}, car{"bar", "yellow"}, car{"foo", "red"}}, cars{car{"foo", "red"}, car{"bar", "yellow"}}},
+	{cars{car{"foo", "red"}, car{"Baz", "black"}, car{"bar", "yellow"}}, cars{car{"foo", "red"}, car{"Baz", "black"}, car{"bar", "yellow"}}},
+}
+
+func TestCars_Mode(t *testing.T) {
+	for _, test := range carsModeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableCars(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Mode())
+		})
+	}
+}
+
+func TestCars_Equals(t *testing.T) {
+	a, b := car{"a", "green"}, car{"b", "blue"}
+
+	assert.True(t, cars(nil).Equals(cars{}))
+	assert.True(t, cars{a, b}.Equals(cars{{"a", "green"}, {"b", "blue"}}))
+	assert.False(t, cars{a, b}.Equals(cars{b, a}))
+	assert.True(t, cars{a, b}.EqualsUnordered(cars{b, a}))
+}
+
+func TestCars_ToChannel(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	assert.Equal(t, ss, carsFromChannel(ss.ToChannel()))
+	assert.Equal(t, cars(nil), carsFromChannel(cars{}.ToChannel()))
+}
+
+func TestCars_Lazy(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "green"}}
+	isGreen := func(c car) bool {
+		return c.Color == "green"
+	}
+	toUpper := func(c car) car {
+		return car{strings.ToUpper(c.Name), c.Color}
+	}
+
+	assert.Equal(t, cars(nil), cars(nil).Lazy().Select(isGreen).Collect())
+	assert.Equal(t, cars{{"A", "green"}, {"C", "green"}}, ss.Lazy().Select(isGreen).Transform(toUpper).Collect())
+	assert.Equal(t, cars{{"b", "blue"}}, ss.Lazy().Unselect(isGreen).Top(5).Collect())
+}
+
+func TestCars_CSVString(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b, c", "blue"}}
+	csv := "Name,Color\na,green\n\"b, c\",blue\n"
+
+	assert.Equal(t, "Name,Color\n", cars(nil).CSVString())
+	assert.Equal(t, csv, ss.CSVString())
+
+	decoded, err := carsFromCSVString(csv)
+	assert.NoError(t, err)
+	assert.Equal(t, ss, decoded)
+
+	decoded, err = carsFromCSVString("Color\nred\n")
+	assert.NoError(t, err)
+	assert.Equal(t, cars{{"", "red"}}, decoded)
+
+	decoded, err = carsFromCSVString("Name,Speed\na,1\n")
+	assert.Equal(t, cars(nil), decoded)
+	assert.EqualError(t, err, `unknown CSV column "Speed"`)
+}
+
+func TestCars_Names(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, Strings(nil), cars(nil).Names())
+	assert.Equal(t, Strings{}, cars{}.Names())
+	assert.Equal(t, Strings{"a", "b"}, ss.Names())
+	assert.Equal(t, Strings{"green", "blue"}, ss.Colors())
+}
+
+func TestCars_WithColor(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars(nil), cars(nil).WithColor("green"))
+	assert.Equal(t, cars{{"a", "green"}, {"c", "green"}}, ss.WithColor("green"))
+	assert.Equal(t, cars(nil), ss.WithColor("red"))
+	assert.Equal(t, cars{{"b", "blue"}}, ss.WithName("b"))
+}
+
+func TestCars_SortByName(t *testing.T) {
+	ss := cars{{"b", "green"}, {"c", "blue"}, {"a", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars(nil), cars(nil).SortByName())
+	assert.Equal(t, cars{{"a", "green"}, {"b", "green"}, {"c", "blue"}}, ss.SortByName())
+	assert.Equal(t, cars{{"c", "blue"}, {"b", "green"}, {"a", "green"}}, ss.SortByNameDesc())
+	assert.Equal(t, cars{{"c", "blue"}, {"b", "green"}, {"a", "green"}}, ss.SortByColor())
+	assert.Equal(t, cars{{"b", "green"}, {"a", "green"}, {"c", "blue"}}, ss.SortByColorDesc())
+}
+
+func TestCars_OrderBy(t *testing.T) {
+	ss := cars{{"b", "green"}, {"c", "blue"}, {"a", "green"}, {"a", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	byColor := func(a, b car) bool {
+		return a.Color < b.Color
+	}
+	byNameDesc := func(a, b car) bool {
+		return a.Name > b.Name
+	}
+
+	assert.Equal(t, cars(nil), cars(nil).OrderBy(byColor))
+	assert.Equal(t, ss, ss.OrderBy())
+	assert.Equal(t, cars{{"c", "blue"}, {"a", "blue"}, {"b", "green"}, {"a", "green"}}, ss.OrderBy(byColor))
+	assert.Equal(t, cars{{"c", "blue"}, {"a", "blue"}, {"b", "green"}, {"a", "green"}}, ss.OrderBy(byColor, byNameDesc))
+	assert.Equal(t, cars{{"c", "blue"}, {"b", "green"}, {"a", "green"}, {"a", "blue"}}, ss.OrderBy(byNameDesc))
+	assert.Equal(t, cars{{"c", "blue"}, {"b", "green"}, {"a", "blue"}, {"a", "green"}}, ss.OrderBy(byNameDesc, byColor))
+}
+
+func TestCars_GroupByColor(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, map[string]cars{}, cars(nil).GroupByColor())
+	assert.Equal(t, map[string]cars{
+		"green": {{"a", "green"}, {"c", "green"}},
+		"blue":  {{"b", "blue"}},
+	}, ss.GroupByColor())
+}
+
+func TestCars_KeyByName(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"a", "red"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, map[string]car{}, cars(nil).KeyByName())
+	assert.Equal(t, map[string]car{
+		"a": {"a", "red"},
+		"b": {"b", "blue"},
+	}, ss.KeyByName())
+}
+
+func TestCars_TakeAndDrop(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "gray"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"a", "green"}}, ss.Take(1))
+	assert.Equal(t, cars{{"b", "blue"}, {"c", "gray"}}, ss.Drop(1))
+	assert.Equal(t, cars(nil), ss.Take(-1))
+	assert.Equal(t, cars(nil), ss.Drop(5))
+}
+
+func TestCars_TakeWhileAndDropWhile(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	isGreen := func(car car) bool {
+		return car.Color == "green"
+	}
+
+	assert.Equal(t, cars{{"a", "green"}}, ss.TakeWhile(isGreen))
+	assert.Equal(t, cars{{"b", "blue"}, {"c", "green"}}, ss.DropWhile(isGreen))
+}
+
+func TestCars_Partition(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	matching, rest := ss.Partition(func(car car) bool {
+		return car.Color == "green"
+	})
+	assert.Equal(t, cars{{"a", "green"}, {"c", "green"}}, matching)
+	assert.Equal(t, cars{{"b", "blue"}}, rest)
+}
+
+func TestCars_Zip(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	pairs := ss.Zip(cars{{"c", "gray"}})
+	assert.Equal(t, carsPairs{{car{"a", "green"}, car{"c", "gray"}}}, pairs)
+
+	first, second := pairs.Unzip()
+	assert.Equal(t, cars{{"a", "green"}}, first)
+	assert.Equal(t, cars{{"c", "gray"}}, second)
+}
+
+func TestFlattencars(t *testing.T) {
+	assert.Equal(t, cars{{"a", "green"}, {"b", "blue"}},
+		Flattencars([]cars{{{"a", "green"}}, nil, {{"b", "blue"}}}))
+}
+
+func TestCars_Interleave(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"a", "green"}, {"c", "gray"}, {"b", "blue"}},
+		ss.Interleave(cars{{"c", "gray"}}))
+}
+
+func TestCars_InsertDeleteAtAndSplice(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"c", "gray"}, {"a", "green"}, {"b", "blue"}}, ss.Insert(0, car{"c", "gray"}))
+	assert.Equal(t, cars{{"a", "green"}}, ss.DeleteAt(1))
+	assert.Equal(t, cars{{"a", "green"}, {"c", "gray"}}, ss.Splice(1, 1, car{"c", "gray"}))
+}
+
+func TestCars_PopShiftAndSplitAt(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	last, rest := ss.Pop()
+	assert.Equal(t, car{"b", "blue"}, last)
+	assert.Equal(t, cars{{"a", "green"}}, rest)
+
+	first, rest := ss.Shift()
+	assert.Equal(t, car{"a", "green"}, first)
+	assert.Equal(t, cars{{"b", "blue"}}, rest)
+
+	left, right := ss.SplitAt(1)
+	assert.Equal(t, cars{{"a", "green"}}, left)
+	assert.Equal(t, cars{{"b", "blue"}}, right)
+}
+
+func TestCars_RotateAndSwap(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"c", "gray"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"c", "gray"}, {"a", "green"}, {"b", "blue"}}, ss.Rotate(1))
+	assert.Equal(t, cars{{"c", "gray"}, {"b", "blue"}, {"a", "green"}}, ss.Swap(2, 0))
+}
+
+func TestCars_CompactAndCoalesceOr(t *testing.T) {
+	ss := cars{{}, {"a", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"a", "green"}}, ss.Compact())
+	assert.Equal(t, car{"a", "green"}, ss.CoalesceOr(car{"b", "blue"}))
+	assert.Equal(t, car{"b", "blue"}, cars{{}}.CoalesceOr(car{"b", "blue"}))
+}
+
+func TestCars_WindowsAndPairwise(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, []cars{{{"a", "green"}, {"b", "blue"}}}, ss.Windows(2))
+	assert.Equal(t, [][2]car{{{"a", "green"}, {"b", "blue"}}}, ss.Pairwise())
+}
+
+func TestCars_Accumulate(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, cars{{"a", "green"}, {"ab", "blue"}}, ss.Accumulate(car{}, func(acc, value car) car {
+		return car{acc.Name + value.Name, value.Color}
+	}))
+}
+
+func TestCars_FrequenciesAndCountUsing(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"a", "green"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, map[car]int{{"a", "green"}: 2, {"b", "blue"}: 1}, ss.Frequencies())
+	assert.Equal(t, 2, ss.CountUsing(func(car car) bool {
+		return car.Color == "green"
+	}))
+}
+
+func TestCars_ToSetAndKeyByString(t *testing.T) {
+	ss := cars{{"a", "green"}, {"b", "blue"}, {"a", "red"}}
+	defer assertImmutableCars(t, &ss)()
+
+	assert.Equal(t, map[car]struct{}{{"a", "green"}: {}, {"b", "blue"}: {}, {"a", "red"}: {}}, ss.ToSet())
+	assert.Equal(t, map[string]car{"a": {"a", "red"}, "b": {"b", "blue"}}, ss.KeyByString(func(car car) string {
+		return car.Name
+	}))
+}
+
+func TestCars_PageAndTotalPages(t *testing.T) {
+	c := cars{{"a", "red"}, {"b", "green"}, {"c", "blue"}, {"d", "red"}, {"e", "green"}}
+	defer assertImmutableCars(t, &c)()
+
+	assert.Equal(t, 3, c.TotalPages(2))
+	assert.Equal(t, 1, c.TotalPages(5))
+	assert.Equal(t, 0, c.TotalPages(0))
+	assert.Equal(t, 0, cars(nil).TotalPages(2))
+
+	assert.Equal(t, cars{{"a", "red"}, {"b", "green"}}, c.Page(1, 2))
+	assert.Equal(t, cars{{"c", "blue"}, {"d", "red"}}, c.Page(2, 2))
+	assert.Equal(t, cars{{"e", "green"}}, c.Page(3, 2))
+	assert.Equal(t, cars(nil), c.Page(4, 2))
+	assert.Equal(t, cars(nil), c.Page(0, 2))
+	assert.Equal(t, cars(nil), c.Page(1, 0))
+	assert.Equal(t, cars(nil), c.Page(math.MaxInt64, 2))
+	assert.Equal(t, c, c.Page(1, math.MaxInt64))
+	assert.Equal(t, cars(nil), cars(nil).Page(1, 2))
+
+	// Appending to a page must not overwrite the next page.
+	_ = append(c.Page(1, 2), car{"z", "black"})
+	assert.Equal(t, cars{{"c", "blue"}, {"d", "red"}}, c.Page(2, 2))
+}
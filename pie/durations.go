@@ -0,0 +1,56 @@
+package pie
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+//go:generate pie -exclude Average,Percentile Durations.*
+type Durations []time.Duration
+
+// Average is the average of all of the durations, or zero if there are no
+// elements.
+func (ss Durations) Average() time.Duration {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	return time.Duration(float64(ss.Sum()) / float64(len(ss)))
+}
+
+// Percentile returns the duration below which p percent of the durations
+// fall. p must be between 0 and 100, values outside of this range will be
+// clamped. When the percentile lies between two durations the result is
+// linearly interpolated between them.
+//
+//   latencies.Percentile(95)
+//
+// Zero is returned if there are no elements or p is NaN. The input slice is
+// not modified.
+func (ss Durations) Percentile(p float64) time.Duration {
+	l := len(ss)
+	if l == 0 || math.IsNaN(p) {
+		return 0
+	}
+
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+
+	sorted := make(Durations, l)
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	rank := p / 100 * float64(l-1)
+	lower := int(rank)
+	if lower == l-1 {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + time.Duration((rank-float64(lower))*float64(sorted[lower+1]-sorted[lower]))
+}
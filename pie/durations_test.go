@@ -0,0 +1,56 @@
+package pie
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the functions for Durations are
+// generated. The more extensive tests for these functions are in
+// float64s_test.go and ints_test.go
+
+var latencies = Durations{
+	120 * time.Millisecond,
+	80 * time.Millisecond,
+	1500 * time.Millisecond,
+	100 * time.Millisecond,
+}
+
+func TestDurations_Sum(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Durations(nil).Sum())
+	assert.Equal(t, 1800*time.Millisecond, latencies.Sum())
+}
+
+func TestDurations_Average(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Durations(nil).Average())
+	assert.Equal(t, 450*time.Millisecond, latencies.Average())
+}
+
+func TestDurations_MinAndMax(t *testing.T) {
+	assert.Equal(t, 80*time.Millisecond, latencies.Min())
+	assert.Equal(t, 1500*time.Millisecond, latencies.Max())
+}
+
+func TestDurations_Percentile(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Durations(nil).Percentile(50))
+	assert.Equal(t, 80*time.Millisecond, latencies.Percentile(-10))
+	assert.Equal(t, 110*time.Millisecond, latencies.Percentile(50))
+	assert.Equal(t, 1500*time.Millisecond, latencies.Percentile(100))
+	assert.Equal(t, time.Duration(0), latencies.Percentile(math.NaN()))
+}
+
+func TestDurations_Sort(t *testing.T) {
+	assert.Equal(t, Durations{
+		80 * time.Millisecond,
+		100 * time.Millisecond,
+		120 * time.Millisecond,
+		1500 * time.Millisecond,
+	}, latencies.Sort())
+}
+
+func TestDurations_Strings(t *testing.T) {
+	assert.Equal(t, Strings{"1s", "1m30s"}, Durations{time.Second, 90 * time.Second}.Strings())
+}
@@ -0,0 +1,25 @@
+package pie
+
+import (
+	"errors"
+)
+
+// ErrEmptySlice is returned by functions that cannot produce a meaningful
+// result when there are no elements.
+var ErrEmptySlice = errors.New("slice is empty")
+
+// ErrLengthMismatch is returned by functions that require two slices to have
+// the same number of elements.
+var ErrLengthMismatch = errors.New("slices have different lengths")
+
+// ErrZeroVariance is returned by functions that are undefined when all of the
+// elements are equal.
+var ErrZeroVariance = errors.New("slice has zero variance")
+
+// ErrZeroWeights is returned by weighted functions when the weights add up to
+// zero.
+var ErrZeroWeights = errors.New("weights sum to zero")
+
+// ErrOverflow is returned when a result is too large to be represented by the
+// element type.
+var ErrOverflow = errors.New("overflow")
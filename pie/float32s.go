@@ -0,0 +1,4 @@
+package pie
+
+//go:generate pie Float32s.*
+type Float32s []float32
@@ -0,0 +1,29 @@
+package pie
+
+import (
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the functions for Float32s are
+// generated. The more extensive tests for these functions are in
+// float64s_test.go
+
+func TestFloat32s_Sum(t *testing.T) {
+	assert.Equal(t, float32(0), Float32s(nil).Sum())
+	assert.Equal(t, float32(6.5), Float32s{3, 1.5, 2}.Sum())
+}
+
+func TestFloat32s_MinAndMax(t *testing.T) {
+	assert.Equal(t, float32(-1.5), Float32s{3, -1.5, 2}.Min())
+	assert.Equal(t, float32(3), Float32s{3, -1.5, 2}.Max())
+}
+
+func TestFloat32s_Sort(t *testing.T) {
+	assert.Equal(t, Float32s{-1.5, 2, 3}, Float32s{3, -1.5, 2}.Sort())
+}
+
+func TestFloat32s_Average(t *testing.T) {
+	assert.Equal(t, 1.25, Float32s{3, -1.5, 2, 1.5}.Average())
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"iter"
+)
+
+// Collectfloat64Batches creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// Collectfloat64Batches is only available when compiling with Go 1.23 or newer.
+func Collectfloat64Batches(seq iter.Seq[Float64s]) (ss float64Batches) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss float64Batches) Iter() iter.Seq[Float64s] {
+	return func(yield func(Float64s) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss float64Batches) IterIndexed() iter.Seq2[int, Float64s] {
+	return func(yield func(int, Float64s) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
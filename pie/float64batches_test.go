@@ -0,0 +1,23 @@
+package pie
+
+import (
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// float64Batches has elements that are slices, so they are compared with
+// Float64s.Equals instead of == and it also has a Flatten method.
+
+func TestFloat64Batches_Flatten(t *testing.T) {
+	assert.Equal(t, Float64s(nil), float64Batches(nil).Flatten())
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, float64Batches{{1.5}, nil, {2.5, 3.5}}.Flatten())
+}
+
+func TestFloat64Batches_Contains(t *testing.T) {
+	ss := float64Batches{{1.5}, {2.5, 3.5}}
+
+	assert.True(t, ss.Contains(Float64s{2.5, 3.5}))
+	assert.False(t, ss.Contains(Float64s{2.5}))
+	assert.Equal(t, float64Batches{{1.5}, {2.5}}, float64Batches{{1.5}, {2.5}, {1.5}}.Unique())
+}
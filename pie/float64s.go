@@ -2,3 +2,9 @@ package pie
 
 //go:generate pie Float64s.*
 type Float64s []float64
+
+//go:generate pie -only Sum,Average,Max -exclude Max myFloat64s.*
+type myFloat64s []float64
+
+//go:generate pie float64Batches.*
+type float64Batches []Float64s
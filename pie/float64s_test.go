@@ -1,8 +1,15 @@
 package pie
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/elliotchance/testify-stats/assert"
@@ -368,12 +375,12 @@ var float64sUniqueTests = []struct {
 	},
 	{
 		Float64s{12.789, -13.2, 12.789},
-		Float64s{-13.2, 12.789},
+		Float64s{12.789, -13.2},
 		false,
 	},
 	{
 		Float64s{12.789, -13.2, 1.234e6, 789},
-		Float64s{-13.2, 12.789, 789, 1.234e6},
+		Float64s{12.789, -13.2, 1.234e6, 789},
 		true,
 	},
 }
@@ -382,10 +389,7 @@ func TestFloat64s_Unique(t *testing.T) {
 	for _, test := range float64sUniqueTests {
 		t.Run("", func(t *testing.T) {
 			defer assertImmutableFloat64s(t, &test.ss)()
-
-			// We have to sort the unique slice because it is always returned in
-			// random order.
-			assert.Equal(t, test.unique, test.ss.Unique().Sort())
+			assert.Equal(t, test.unique, test.ss.Unique())
 		})
 	}
 }
@@ -663,11 +667,26 @@ func TestFloat64s_Bottom(t *testing.T) {
 	}
 }
 
+var float64sMedianTests = []struct {
+	ss       Float64s
+	expected float64
+}{
+	{nil, 0},
+	{Float64s{}, 0},
+	{Float64s{12.3}, 12.3},
+	{Float64s{12.3, 4.5}, 8.4},
+	{Float64s{2.1, 12.3, 4.5}, 4.5},
+	{Float64s{7, 1, 5, 3}, 4},
+	{Float64s{3, 3, 1, 3}, 3},
+}
+
 func TestFloat64s_Median(t *testing.T) {
-	assert.Equal(t, 0.0, Float64s{}.Median())
-	assert.Equal(t, 12.3, Float64s{12.3}.Median())
-	assert.Equal(t, 8.4, Float64s{12.3, 4.5}.Median())
-	assert.Equal(t, 4.5, Float64s{2.1, 12.3, 4.5}.Median())
+	for _, test := range float64sMedianTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Median())
+		})
+	}
 }
 
 func TestFloat64s_Each(t *testing.T) {
@@ -753,7 +772,2278 @@ var float64sAbsTests = []struct {
 func TestFloat64s_Abs(t *testing.T) {
 	for _, test := range float64sAbsTests {
 		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
 			assert.Equal(t, test.abs, test.ss.Abs())
 		})
 	}
 }
+
+func TestFloat64s_Reduce(t *testing.T) {
+	product := func(acc, value float64) float64 {
+		return acc * value
+	}
+
+	assert.Equal(t, 1.0, Float64s(nil).Reduce(1, product))
+	assert.Equal(t, 1.5, Float64s{1.5}.Reduce(1, product))
+	assert.Equal(t, 30.0, Float64s{2, 3, 5}.Reduce(1, product))
+}
+
+var float64sVarianceTests = []struct {
+	ss                Float64s
+	variance          float64
+	standardDeviation float64
+}{
+	{nil, 0, 0},
+	{Float64s{}, 0, 0},
+	{Float64s{1.5}, 0, 0},
+	{Float64s{2, 4, 4, 4, 5, 5, 7, 9}, 4, 2},
+	{Float64s{1, 2, 3, 4}, 1.25, 1.118033988749895},
+}
+
+func TestFloat64s_Variance(t *testing.T) {
+	for _, test := range float64sVarianceTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.variance, test.ss.Variance())
+		})
+	}
+}
+
+func TestFloat64s_StandardDeviation(t *testing.T) {
+	for _, test := range float64sVarianceTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.standardDeviation, test.ss.StandardDeviation())
+		})
+	}
+}
+
+var float64sSetTests = []struct {
+	ss, ss2          Float64s
+	intersect, union Float64s
+	added, removed   Float64s
+}{
+	{
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	},
+	{
+		Float64s{1.5, 2.5},
+		nil,
+		nil,
+		Float64s{1.5, 2.5},
+		nil,
+		Float64s{1.5, 2.5},
+	},
+	{
+		nil,
+		Float64s{1.5, 2.5},
+		nil,
+		Float64s{1.5, 2.5},
+		Float64s{1.5, 2.5},
+		nil,
+	},
+	{
+		Float64s{1.5, 2.5, 3.5},
+		Float64s{3.5, 4.5, 1.5},
+		Float64s{1.5, 3.5},
+		Float64s{1.5, 2.5, 3.5, 4.5},
+		Float64s{4.5},
+		Float64s{2.5},
+	},
+	{
+		Float64s{1.5, 2.5, 1.5},
+		Float64s{2.5, 2.5},
+		Float64s{2.5},
+		Float64s{1.5, 2.5},
+		Float64s{2.5},
+		Float64s{1.5, 1.5},
+	},
+}
+
+func TestFloat64s_Intersect(t *testing.T) {
+	for _, test := range float64sSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.intersect, test.ss.Intersect(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_Union(t *testing.T) {
+	for _, test := range float64sSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.union, test.ss.Union(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_Diff(t *testing.T) {
+	for _, test := range float64sSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			added, removed := test.ss.Diff(test.ss2)
+			assert.Equal(t, test.added, added)
+			assert.Equal(t, test.removed, removed)
+		})
+	}
+}
+
+func TestFloat64s_GroupByString(t *testing.T) {
+	sign := func(value float64) string {
+		if value < 0 {
+			return "negative"
+		}
+
+		return "positive"
+	}
+
+	assert.Equal(t, map[string]Float64s{}, Float64s(nil).GroupByString(sign))
+	assert.Equal(t, map[string]Float64s{
+		"negative": {-1.5, -3},
+		"positive": {2.5, 0},
+	}, Float64s{-1.5, 2.5, -3, 0}.GroupByString(sign))
+}
+
+var float64sChunkTests = []struct {
+	ss       Float64s
+	size     int
+	expected []Float64s
+}{
+	{nil, 2, nil},
+	{Float64s{}, 2, nil},
+	{Float64s{1.5, 2.5, 3.5}, 0, nil},
+	{Float64s{1.5, 2.5, 3.5}, -1, nil},
+	{Float64s{1.5, 2.5, 3.5}, 1, []Float64s{{1.5}, {2.5}, {3.5}}},
+	{Float64s{1.5, 2.5, 3.5}, 2, []Float64s{{1.5, 2.5}, {3.5}}},
+	{Float64s{1.5, 2.5, 3.5}, 3, []Float64s{{1.5, 2.5, 3.5}}},
+	{Float64s{1.5, 2.5, 3.5}, 4, []Float64s{{1.5, 2.5, 3.5}}},
+}
+
+func TestFloat64s_Chunk(t *testing.T) {
+	for _, test := range float64sChunkTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Chunk(test.size))
+		})
+	}
+}
+
+func TestFloat64s_ChunkAppend(t *testing.T) {
+	ss := Float64s{1.5, 2.5, 3.5}
+	chunks := ss.Chunk(2)
+	_ = append(chunks[0], 9.5)
+
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, ss)
+}
+
+var float64sLargestAndSmallestTests = []struct {
+	ss                Float64s
+	n                 int
+	largest, smallest Float64s
+}{
+	{nil, 2, nil, nil},
+	{Float64s{}, 2, nil, nil},
+	{Float64s{1.5, 2.5}, 0, nil, nil},
+	{Float64s{1.5, 2.5}, -1, nil, nil},
+	{Float64s{1.5, 2.5}, 3, Float64s{2.5, 1.5}, Float64s{1.5, 2.5}},
+	{Float64s{3.5, 1.5, 4.5, 1.5, 5.5, 9.5, 2.5}, 1, Float64s{9.5}, Float64s{1.5}},
+	{Float64s{3.5, 1.5, 4.5, 1.5, 5.5, 9.5, 2.5}, 3, Float64s{9.5, 5.5, 4.5}, Float64s{1.5, 1.5, 2.5}},
+	{Float64s{-1, -2, -3}, 2, Float64s{-1, -2}, Float64s{-3, -2}},
+}
+
+func TestFloat64s_Largest(t *testing.T) {
+	for _, test := range float64sLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.largest, test.ss.Largest(test.n))
+		})
+	}
+}
+
+func TestFloat64s_Smallest(t *testing.T) {
+	for _, test := range float64sLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.smallest, test.ss.Smallest(test.n))
+		})
+	}
+}
+
+func TestFloat64s_ShuffleNilSource(t *testing.T) {
+	ss := Float64s{1.5, 2.5, 3.5, 4.5}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, ss, ss.Shuffle(nil).Sort())
+	assert.True(t, ss.Contains(ss.Random(nil)))
+}
+
+var float64sSampleTests = []struct {
+	ss       Float64s
+	n        int
+	source   rand.Source
+	expected Float64s
+}{
+	{nil, 2, rand.NewSource(0), nil},
+	{Float64s{}, 2, rand.NewSource(0), nil},
+	{Float64s{1.5, 2.5, 3.5}, 0, rand.NewSource(0), nil},
+	{Float64s{1.5, 2.5, 3.5}, -1, rand.NewSource(0), nil},
+	{Float64s{1.5, 2.5, 3.5, 4.5, 5.5}, 3, rand.NewSource(0), Float64s{5.5, 4.5, 2.5}},
+}
+
+func TestFloat64s_Sample(t *testing.T) {
+	for _, test := range float64sSampleTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Sample(test.n, test.source))
+		})
+	}
+}
+
+func TestFloat64s_SampleAll(t *testing.T) {
+	ss := Float64s{1.5, 2.5, 3.5, 4.5, 5.5}
+
+	for i := 0; i < 10; i++ {
+		sample := ss.Sample(10, nil)
+		assert.Equal(t, ss, sample.Sort())
+	}
+}
+
+var float64sJoinFormattedTests = []struct {
+	ss         Float64s
+	glue, verb string
+	expected   string
+}{
+	{nil, ",", "%v", ""},
+	{Float64s{}, ",", "%v", ""},
+	{Float64s{1.5}, ",", "%v", "1.5"},
+	{Float64s{1.5, 2.25, 3}, ", ", "%v", "1.5, 2.25, 3"},
+	{Float64s{1.5, 2.25, 3}, "|", "%.1f", "1.5|2.2|3.0"},
+}
+
+func TestFloat64s_JoinFormatted(t *testing.T) {
+	for _, test := range float64sJoinFormattedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.JoinFormatted(test.glue, test.verb))
+		})
+	}
+}
+
+func TestFloat64s_ToFloat64s(t *testing.T) {
+	half := func(value float64) float64 {
+		return value / 2
+	}
+
+	assert.Equal(t, Float64s(nil), Float64s(nil).ToFloat64s(half))
+	assert.Equal(t, Float64s(nil), Float64s{}.ToFloat64s(half))
+	assert.Equal(t, Float64s{0.75, 1.25}, Float64s{1.5, 2.5}.ToFloat64s(half))
+}
+
+func TestFloat64s_ToInts(t *testing.T) {
+	truncate := func(value float64) int {
+		return int(value)
+	}
+
+	assert.Equal(t, Ints(nil), Float64s(nil).ToInts(truncate))
+	assert.Equal(t, Ints(nil), Float64s{}.ToInts(truncate))
+	assert.Equal(t, Ints{1, -2}, Float64s{1.5, -2.5}.ToInts(truncate))
+}
+
+func TestFloat64s_EachWithIndex(t *testing.T) {
+	var values []string
+	collect := func(i int, value float64) {
+		values = append(values, fmt.Sprintf("%d:%v", i, value))
+	}
+
+	values = []string{}
+	Float64s{}.EachWithIndex(collect)
+	assert.Equal(t, []string{}, values)
+
+	values = []string{}
+	ss := Float64s{1.5, 2.5}
+	assert.Equal(t, ss, ss.EachWithIndex(collect))
+	assert.Equal(t, []string{"0:1.5", "1:2.5"}, values)
+}
+
+func TestFloat64s_AllAndAnyStopEarly(t *testing.T) {
+	calls := 0
+	isPositive := func(value float64) bool {
+		calls++
+
+		return value > 0
+	}
+
+	assert.False(t, Float64s{1.5, -2.5, 3.5, 4.5}.All(isPositive))
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	assert.True(t, Float64s{-1.5, 2.5, 3.5, 4.5}.Any(isPositive))
+	assert.Equal(t, 2, calls)
+}
+
+func TestFloat64s_FirstUsing(t *testing.T) {
+	condition := func(value float64) bool {
+		return value > 2
+	}
+
+	value, ok := Float64s(nil).FirstUsing(condition)
+	assert.Equal(t, 0.0, value)
+	assert.False(t, ok)
+
+	value, ok = Float64s{1.5, 2.5, 3.5, 0.5}.FirstUsing(condition)
+	assert.Equal(t, 2.5, value)
+	assert.True(t, ok)
+}
+
+func TestFloat64s_LastUsing(t *testing.T) {
+	condition := func(value float64) bool {
+		return value > 2
+	}
+
+	value, ok := Float64s(nil).LastUsing(condition)
+	assert.Equal(t, 0.0, value)
+	assert.False(t, ok)
+
+	value, ok = Float64s{1.5, 2.5, 3.5, 0.5}.LastUsing(condition)
+	assert.Equal(t, 3.5, value)
+	assert.True(t, ok)
+}
+
+var float64sIndexOfTests = []struct {
+	ss                   Float64s
+	lookingFor           float64
+	indexOf, lastIndexOf int
+}{
+	{nil, 1.5, -1, -1},
+	{Float64s{}, 1.5, -1, -1},
+	{Float64s{1.5, 2.5, 1.5}, 1.5, 0, 2},
+	{Float64s{1.5, 2.5, 1.5}, 2.5, 1, 1},
+	{Float64s{1.5, 2.5, 1.5}, 9.5, -1, -1},
+}
+
+func TestFloat64s_IndexOf(t *testing.T) {
+	for _, test := range float64sIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.indexOf, test.ss.IndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestFloat64s_LastIndexOf(t *testing.T) {
+	for _, test := range float64sIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.lastIndexOf, test.ss.LastIndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestFloat64s_SortUsing(t *testing.T) {
+	descending := func(a, b float64) bool {
+		return a > b
+	}
+
+	ss := Float64s{1.5, 3.5, 2.5}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s(nil), Float64s(nil).SortUsing(descending))
+	assert.Equal(t, Float64s{1.5}, Float64s{1.5}.SortUsing(descending))
+	assert.Equal(t, Float64s{3.5, 2.5, 1.5}, ss.SortUsing(descending))
+	assert.Equal(t, Float64s{3.5, 2.5, 1.5}, ss.SortStableUsing(descending))
+}
+
+func TestFloat64s_InPlace(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Float64s(nil).SortInPlace())
+	assert.Equal(t, Float64s(nil), Float64s(nil).ReverseInPlace())
+	assert.Equal(t, Float64s(nil), Float64s(nil).ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, Float64s(nil), Float64s(nil).TransformInPlace(math.Floor))
+
+	ss := Float64s{2.5, 3.5, 1.5}
+
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, ss.SortInPlace())
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, ss)
+
+	assert.Equal(t, Float64s{3.5, 2.5, 1.5}, ss.ReverseInPlace())
+	assert.Equal(t, Float64s{3.5, 2.5, 1.5}, ss)
+
+	assert.Equal(t, Float64s{3, 2, 1}, ss.TransformInPlace(math.Floor))
+	assert.Equal(t, Float64s{3, 2, 1}, ss)
+
+	assert.Equal(t, Float64s{2, 3, 1}, ss.ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, Float64s{2, 3, 1}, ss)
+}
+
+var float64sPercentileTests = []struct {
+	ss       Float64s
+	p        float64
+	expected float64
+}{
+	{nil, 50, 0},
+	{Float64s{}, 50, 0},
+	{Float64s{7.5}, 0, 7.5},
+	{Float64s{7.5}, 95, 7.5},
+	{Float64s{15, 20, 35, 40, 50}, 0, 15},
+	{Float64s{15, 20, 35, 40, 50}, 40, 29},
+	{Float64s{50, 40, 35, 20, 15}, 50, 35},
+	{Float64s{15, 20, 35, 40, 50}, 95, 48},
+	{Float64s{15, 20, 35, 40, 50}, 100, 50},
+	{Float64s{15, 20, 35, 40, 50}, -10, 15},
+	{Float64s{15, 20, 35, 40, 50}, 110, 50},
+}
+
+func TestFloat64s_Percentile(t *testing.T) {
+	for _, test := range float64sPercentileTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.InDelta(t, test.expected, test.ss.Percentile(test.p), 1e-9)
+		})
+	}
+
+	assert.True(t, math.IsNaN(Float64s{1, 2, 3}.Percentile(math.NaN())))
+	assert.Equal(t, 0.0, Float64s(nil).Percentile(math.NaN()))
+}
+
+var float64sQuantilesTests = []struct {
+	ss       Float64s
+	n        int
+	expected Float64s
+}{
+	{nil, 4, nil},
+	{Float64s{}, 4, nil},
+	{Float64s{1, 2, 3}, 1, nil},
+	{Float64s{1, 2, 3}, 0, nil},
+	{Float64s{5}, 4, Float64s{5, 5, 5}},
+	{Float64s{1, 2, 3, 4, 5}, 2, Float64s{3}},
+	{Float64s{5, 4, 3, 2, 1}, 4, Float64s{2, 3, 4}},
+	{Float64s{1, 2, 3, 4}, 4, Float64s{1.75, 2.5, 3.25}},
+}
+
+func TestFloat64s_Quantiles(t *testing.T) {
+	for _, test := range float64sQuantilesTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Quantiles(test.n))
+		})
+	}
+}
+
+var float64sModeTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, nil},
+	{Float64s{1.5}, Float64s{1.5}},
+	{Float64s{1.5, 2.5, 2.5, 3.5}, Float64s{2.5}},
+	{Float64s{3.5, 1.5, 2.5, 1.5, 3.5}, Float64s{3.5, 1.5}},
+	{Float64s{3.5, 2.5, 1.5}, Float64s{3.5, 2.5, 1.5}},
+}
+
+func TestFloat64s_Mode(t *testing.T) {
+	for _, test := range float64sModeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Mode())
+		})
+	}
+}
+
+var float64sCumulativeSumTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, Float64s{}},
+	{Float64s{1.5}, Float64s{1.5}},
+	{Float64s{1.5, 2.5, -1, 3}, Float64s{1.5, 4, 3, 6}},
+}
+
+func TestFloat64s_CumulativeSum(t *testing.T) {
+	for _, test := range float64sCumulativeSumTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.CumulativeSum())
+		})
+	}
+}
+
+var float64sMovingAverageTests = []struct {
+	ss       Float64s
+	window   int
+	expected Float64s
+}{
+	{nil, 2, nil},
+	{Float64s{}, 1, nil},
+	{Float64s{1, 2, 3}, 0, nil},
+	{Float64s{1, 2, 3}, 4, nil},
+	{Float64s{1, 2, 3}, 1, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, 3, Float64s{2}},
+	{Float64s{2, 4, 6, 8, 10}, 2, Float64s{3, 5, 7, 9}},
+	{Float64s{1.5, 2.5, -1, 3}, 3, Float64s{1, 1.5}},
+}
+
+func TestFloat64s_MovingAverage(t *testing.T) {
+	for _, test := range float64sMovingAverageTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.MovingAverage(test.window))
+		})
+	}
+}
+
+func TestFloat64s_Rolling(t *testing.T) {
+	max := func(window Float64s) float64 {
+		return window.Max()
+	}
+
+	ss := Float64s{1.5, 3.5, 2.5, 0.5, 4.5}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s(nil), Float64s(nil).Rolling(2, max))
+	assert.Equal(t, Float64s(nil), ss.Rolling(0, max))
+	assert.Equal(t, Float64s(nil), ss.Rolling(6, max))
+	assert.Equal(t, Float64s{3.5, 3.5, 2.5, 4.5}, ss.Rolling(2, max))
+	assert.Equal(t, Float64s{3.5, 3.5, 4.5}, ss.Rolling(3, max))
+	assert.Equal(t, ss.MovingAverage(3), ss.Rolling(3, func(window Float64s) float64 {
+		return window.Average()
+	}))
+}
+
+var float64sArithmeticTests = []struct {
+	ss, ss2                         Float64s
+	add, subtract, multiply, divide Float64s
+}{
+	{nil, nil, nil, nil, nil, nil},
+	{nil, Float64s{1}, nil, nil, nil, nil},
+	{Float64s{}, Float64s{1}, Float64s{}, Float64s{}, Float64s{}, Float64s{}},
+	{Float64s{1}, nil, Float64s{}, Float64s{}, Float64s{}, Float64s{}},
+	{
+		Float64s{1.5, 6, -2},
+		Float64s{0.5, 3, 4},
+		Float64s{2, 9, 2},
+		Float64s{1, 3, -6},
+		Float64s{0.75, 18, -8},
+		Float64s{3, 2, -0.5},
+	},
+	{
+		Float64s{1.5, 6, -2},
+		Float64s{0.5},
+		Float64s{2},
+		Float64s{1},
+		Float64s{0.75},
+		Float64s{3},
+	},
+}
+
+func TestFloat64s_Add(t *testing.T) {
+	for _, test := range float64sArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.add, test.ss.Add(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_Subtract(t *testing.T) {
+	for _, test := range float64sArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.subtract, test.ss.Subtract(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_Multiply(t *testing.T) {
+	for _, test := range float64sArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.multiply, test.ss.Multiply(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_Divide(t *testing.T) {
+	for _, test := range float64sArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.divide, test.ss.Divide(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_AddScalar(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Float64s(nil).AddScalar(1.5))
+	assert.Equal(t, Float64s{}, Float64s{}.AddScalar(1.5))
+	assert.Equal(t, Float64s{3, 0.5}, Float64s{1.5, -1}.AddScalar(1.5))
+}
+
+func TestFloat64s_MultiplyScalar(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Float64s(nil).MultiplyScalar(2))
+	assert.Equal(t, Float64s{}, Float64s{}.MultiplyScalar(2))
+	assert.Equal(t, Float64s{3, -2}, Float64s{1.5, -1}.MultiplyScalar(2))
+}
+
+var float64sDotProductTests = []struct {
+	ss, ss2  Float64s
+	expected float64
+	err      error
+}{
+	{nil, nil, 0, nil},
+	{Float64s{}, nil, 0, nil},
+	{Float64s{1.5}, nil, 0, ErrLengthMismatch},
+	{Float64s{1.5, 2}, Float64s{1}, 0, ErrLengthMismatch},
+	{Float64s{1.5, 2}, Float64s{2, -0.5}, 2, nil},
+	{Float64s{1, 3, -5}, Float64s{4, -2, -1}, 3, nil},
+}
+
+func TestFloat64s_DotProduct(t *testing.T) {
+	for _, test := range float64sDotProductTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			product, err := test.ss.DotProduct(test.ss2)
+			assert.Equal(t, test.expected, product)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestFloat64s_Norm(t *testing.T) {
+	assert.Equal(t, 0.0, Float64s(nil).Norm())
+	assert.Equal(t, 1.5, Float64s{-1.5}.Norm())
+	assert.Equal(t, 5.0, Float64s{3, -4}.Norm())
+}
+
+var float64sNormalizeTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, nil},
+	{Float64s{4.5}, Float64s{0}},
+	{Float64s{2, 2, 2}, Float64s{0, 0, 0}},
+	{Float64s{3, 1, 5, 2}, Float64s{0.5, 0, 1, 0.25}},
+	{Float64s{-1, 1}, Float64s{0, 1}},
+}
+
+func TestFloat64s_Normalize(t *testing.T) {
+	for _, test := range float64sNormalizeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Normalize())
+		})
+	}
+}
+
+var float64sZScoreTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, nil},
+	{Float64s{4.5}, Float64s{0}},
+	{Float64s{2, 2, 2}, Float64s{0, 0, 0}},
+	{Float64s{2, 4, 4, 4, 5, 5, 7, 9}, Float64s{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}},
+}
+
+func TestFloat64s_ZScore(t *testing.T) {
+	for _, test := range float64sZScoreTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.ZScore())
+		})
+	}
+}
+
+var float64sEmptyErrorTests = []struct {
+	ss                    Float64s
+	min, max, first, last float64
+	err                   error
+}{
+	{nil, 0, 0, 0, 0, ErrEmptySlice},
+	{Float64s{}, 0, 0, 0, 0, ErrEmptySlice},
+	{Float64s{0}, 0, 0, 0, 0, nil},
+	{Float64s{2.5, -1, 3.5, 1}, -1, 3.5, 2.5, 1, nil},
+}
+
+func TestFloat64s_MinEMaxEFirstELastE(t *testing.T) {
+	for _, test := range float64sEmptyErrorTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			min, err := test.ss.MinE()
+			assert.Equal(t, test.min, min)
+			assert.Equal(t, test.err, err)
+
+			max, err := test.ss.MaxE()
+			assert.Equal(t, test.max, max)
+			assert.Equal(t, test.err, err)
+
+			first, err := test.ss.FirstE()
+			assert.Equal(t, test.first, first)
+			assert.Equal(t, test.err, err)
+
+			last, err := test.ss.LastE()
+			assert.Equal(t, test.last, last)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+var float64sEqualsTests = []struct {
+	ss, ss2                 Float64s
+	equals, equalsUnordered bool
+}{
+	{nil, nil, true, true},
+	{nil, Float64s{}, true, true},
+	{Float64s{}, nil, true, true},
+	{Float64s{1}, nil, false, false},
+	{Float64s{1, 2}, Float64s{1, 2}, true, true},
+	{Float64s{1, 2}, Float64s{2, 1}, false, true},
+	{Float64s{1, 2}, Float64s{1, 2, 3}, false, false},
+	{Float64s{1, 1, 2}, Float64s{1, 2, 2}, false, false},
+	{Float64s{1, 2, 1}, Float64s{1, 1, 2}, false, true},
+}
+
+func TestFloat64s_Equals(t *testing.T) {
+	for _, test := range float64sEqualsTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.equals, test.ss.Equals(test.ss2))
+			assert.Equal(t, test.equalsUnordered, test.ss.EqualsUnordered(test.ss2))
+		})
+	}
+}
+
+var float64sChannelTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, nil},
+	{Float64s{1.5}, Float64s{1.5}},
+	{Float64s{3, 1.5, -2}, Float64s{3, 1.5, -2}},
+}
+
+func TestFloat64s_ToChannel(t *testing.T) {
+	for _, test := range float64sChannelTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, Float64sFromChannel(test.ss.ToChannel()))
+		})
+	}
+}
+
+func TestFloat64s_ToChannelCtx(t *testing.T) {
+	for _, test := range float64sChannelTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			ch := test.ss.ToChannelCtx(context.Background())
+			assert.Equal(t, test.expected, Float64sFromChannel(ch))
+		})
+	}
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(Float64s, 100).ToChannelCtx(ctx)
+		<-ch
+		cancel()
+
+		// The goroutine may still send some values before it notices the
+		// cancellation, but it will not send all of them.
+		assert.True(t, len(Float64sFromChannel(ch)) < 99)
+	})
+}
+
+func TestFloat64s_TransformParallel(t *testing.T) {
+	for _, test := range float64sSelectTests {
+		for _, workers := range []int{-1, 0, 1, 2, 100} {
+			t.Run("", func(t *testing.T) {
+				defer assertImmutableFloat64s(t, &test.ss)()
+				assert.Equal(t, test.expectedTransform, test.ss.TransformParallel(func(a float64) float64 {
+					return a + 5.2
+				}, workers))
+			})
+		}
+	}
+}
+
+func TestFloat64s_Lazy(t *testing.T) {
+	for _, test := range float64sSelectTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expectedSelect, test.ss.Lazy().Select(test.condition).Collect())
+			assert.Equal(t, test.expectedUnselect, test.ss.Lazy().Unselect(test.condition).Collect())
+			assert.Equal(t, test.expectedTransform, test.ss.Lazy().Transform(func(a float64) float64 {
+				return a + 5.2
+			}).Collect())
+		})
+	}
+}
+
+var float64sLazyTopTests = []struct {
+	n         int
+	expected  Float64s
+	evaluated int
+}{
+	{-1, nil, 0},
+	{0, nil, 0},
+	{1, Float64s{20}, 2},
+	{2, Float64s{20, 40}, 4},
+	{10, Float64s{20, 40, 60}, 6},
+}
+
+func TestFloat64s_LazyTop(t *testing.T) {
+	for _, test := range float64sLazyTopTests {
+		t.Run("", func(t *testing.T) {
+			ss := Float64s{1, 2, 3, 4, 5, 6}
+			defer assertImmutableFloat64s(t, &ss)()
+
+			evaluated := 0
+			isEven := func(value float64) bool {
+				evaluated++
+				return int(value)%2 == 0
+			}
+			timesTen := func(value float64) float64 {
+				return value * 10
+			}
+
+			collected := ss.Lazy().Select(isEven).Transform(timesTen).Top(test.n).Collect()
+			assert.Equal(t, test.expected, collected)
+			assert.Equal(t, test.evaluated, evaluated)
+		})
+	}
+}
+
+var errNegativeFloat64 = errors.New("negative")
+
+func sqrtOrError(value float64) (float64, error) {
+	if value < 0 {
+		return 0, errNegativeFloat64
+	}
+
+	return math.Sqrt(value), nil
+}
+
+var float64sTransformErrTests = []struct {
+	ss       Float64s
+	expected Float64s
+	err      error
+}{
+	{nil, nil, nil},
+	{Float64s{}, Float64s{}, nil},
+	{Float64s{4, 9}, Float64s{2, 3}, nil},
+	{Float64s{4, -9, 16}, nil, errNegativeFloat64},
+}
+
+func TestFloat64s_TransformErr(t *testing.T) {
+	for _, test := range float64sTransformErrTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			transformed, err := test.ss.TransformErr(sqrtOrError)
+			assert.Equal(t, test.expected, transformed)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestFloat64s_EachErr(t *testing.T) {
+	var visited Float64s
+	err := Float64s{4, -9, 16}.EachErr(func(value float64) error {
+		visited = append(visited, value)
+		_, err := sqrtOrError(value)
+		return err
+	})
+
+	assert.Equal(t, errNegativeFloat64, err)
+	assert.Equal(t, Float64s{4, -9}, visited)
+
+	assert.NoError(t, Float64s(nil).EachErr(func(float64) error {
+		return errNegativeFloat64
+	}))
+	assert.NoError(t, Float64s{4, 9}.EachErr(func(value float64) error {
+		_, err := sqrtOrError(value)
+		return err
+	}))
+}
+
+var float64sFromJSONStringTests = []struct {
+	json     string
+	expected Float64s
+	err      bool
+}{
+	{`null`, Float64s{}, false},
+	{`[]`, Float64s{}, false},
+	{`[1.5]`, Float64s{1.5}, false},
+	{`[3,1.25,-2]`, Float64s{3, 1.25, -2}, false},
+	{`["a"]`, nil, true},
+	{`[1,`, nil, true},
+}
+
+func TestFloat64sFromJSONString(t *testing.T) {
+	for _, test := range float64sFromJSONStringTests {
+		t.Run("", func(t *testing.T) {
+			ss, err := Float64sFromJSONString(test.json)
+			assert.Equal(t, test.expected, ss)
+			assert.Equal(t, test.err, err != nil)
+		})
+	}
+}
+
+func TestFloat64s_MarshalJSON(t *testing.T) {
+	type wrapper struct {
+		Values Float64s
+	}
+
+	for _, ss := range []Float64s{nil, {}, {1.5, 2}} {
+		t.Run("", func(t *testing.T) {
+			data, err := json.Marshal(wrapper{ss})
+			assert.NoError(t, err)
+			assert.Equal(t, `{"Values":`+ss.JSONString()+`}`, string(data))
+		})
+	}
+}
+
+func TestFloat64s_UnmarshalJSON(t *testing.T) {
+	var w struct {
+		Values Float64s
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Values":null}`), &w))
+	assert.Equal(t, Float64s{}, w.Values)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Values":[1.5,2]}`), &w))
+	assert.Equal(t, Float64s{1.5, 2}, w.Values)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Values":{}}`), &w))
+}
+
+var float64sJSONIndentTests = []struct {
+	ss       Float64s
+	expected string
+}{
+	{nil, `[]`},
+	{Float64s{}, `[]`},
+	{Float64s{1.5}, "[\n>  1.5\n>]"},
+	{Float64s{1.5, -2}, "[\n>  1.5,\n>  -2\n>]"},
+}
+
+func TestFloat64s_JSONStringIndent(t *testing.T) {
+	for _, test := range float64sJSONIndentTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.JSONStringIndent(">", "  "))
+			assert.Equal(t, []byte(test.expected), test.ss.JSONBytesIndent(">", "  "))
+		})
+	}
+}
+
+func TestFloat64s_JSONBytes(t *testing.T) {
+	for _, test := range float64sJSONTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, []byte(test.jsonString), test.ss.JSONBytes())
+		})
+	}
+}
+
+var float64sCSVTests = []struct {
+	ss  Float64s
+	csv string
+}{
+	{nil, ""},
+	{Float64s{1.5}, "1.5\n"},
+	{Float64s{3, -1.25, 1e21}, "3\n-1.25\n1e+21\n"},
+}
+
+func TestFloat64s_CSVString(t *testing.T) {
+	for _, test := range float64sCSVTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.csv, test.ss.CSVString())
+		})
+	}
+}
+
+func TestFloat64sFromCSVString(t *testing.T) {
+	for _, test := range float64sCSVTests {
+		t.Run("", func(t *testing.T) {
+			ss, err := Float64sFromCSVString(test.csv)
+			assert.NoError(t, err)
+			assert.Equal(t, test.ss, ss)
+		})
+	}
+
+	ss, err := Float64sFromCSVString("1,2.5\n3")
+	assert.NoError(t, err)
+	assert.Equal(t, Float64s{1, 2.5, 3}, ss)
+
+	ss, err = Float64sFromCSVString("1\nfoo")
+	assert.Equal(t, Float64s(nil), ss)
+	assert.EqualError(t, err, `record 2: strconv.ParseFloat: parsing "foo": invalid syntax`)
+}
+
+var float64sSQLTests = []struct {
+	ss    Float64s
+	value string
+}{
+	{nil, "{}"},
+	{Float64s{}, "{}"},
+	{Float64s{1.5}, "{1.5}"},
+	{Float64s{3, -1.25, math.Inf(1), math.Inf(-1)}, "{3,-1.25,Infinity,-Infinity}"},
+}
+
+func TestFloat64s_Value(t *testing.T) {
+	for _, test := range float64sSQLTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			value, err := test.ss.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, test.value, value)
+		})
+	}
+}
+
+var float64sScanTests = []struct {
+	src      interface{}
+	expected Float64s
+	err      string
+}{
+	{nil, Float64s{}, ""},
+	{"{}", Float64s{}, ""},
+	{[]byte("{1.5}"), Float64s{1.5}, ""},
+	{"{3,-1.25,Infinity,-Infinity}", Float64s{3, -1.25, math.Inf(1), math.Inf(-1)}, ""},
+	{`{ 1 , "2" }`, Float64s{1, 2}, ""},
+	{"{1,NULL}", nil, "cannot scan NULL element 1 into pie.Float64s"},
+	{"{1,a}", nil, `element 1: strconv.ParseFloat: parsing "a": invalid syntax`},
+	{"{{1,2}}", nil, `invalid array literal: "{{1,2}}"`},
+	{"1,2", nil, `invalid array literal: "1,2"`},
+	{1.5, nil, "cannot scan float64 into pie.Float64s"},
+}
+
+func TestFloat64s_Scan(t *testing.T) {
+	for _, test := range float64sScanTests {
+		t.Run("", func(t *testing.T) {
+			var ss Float64s
+			err := ss.Scan(test.src)
+			assert.Equal(t, test.expected, ss)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+var float64sBinaryTests = []struct {
+	ss   Float64s
+	size int
+}{
+	{nil, 1},
+	{Float64s{}, 1},
+	{Float64s{1.5}, 9},
+	{Float64s{3, -1.25, math.Inf(1), math.MaxFloat64}, 33},
+}
+
+func TestFloat64s_MarshalBinary(t *testing.T) {
+	for _, test := range float64sBinaryTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			data, err := test.ss.MarshalBinary()
+			assert.NoError(t, err)
+			assert.Equal(t, test.size, len(data))
+
+			var ss Float64s
+			assert.NoError(t, ss.UnmarshalBinary(data))
+			assert.Equal(t, test.ss, ss)
+		})
+	}
+}
+
+func TestFloat64s_UnmarshalBinary(t *testing.T) {
+	var ss Float64s
+	assert.Error(t, ss.UnmarshalBinary(nil))
+	assert.Error(t, ss.UnmarshalBinary([]byte{2}))
+	assert.Error(t, ss.UnmarshalBinary([]byte{0, 1}))
+	assert.Error(t, ss.UnmarshalBinary([]byte{1, 1, 2, 3}))
+}
+
+func TestFloat64s_Gob(t *testing.T) {
+	type wrapper struct {
+		Nil, Empty, Values Float64s
+	}
+
+	var buf bytes.Buffer
+	in := wrapper{nil, Float64s{}, Float64s{1.5, -2}}
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+	var out wrapper
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
+
+func TestFloat64s_MarshalYAML(t *testing.T) {
+	for _, test := range []struct {
+		ss       Float64s
+		expected interface{}
+	}{
+		{nil, []float64{}},
+		{Float64s{}, []float64{}},
+		{Float64s{1.5, -2}, []float64{1.5, -2}},
+	} {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			value, err := test.ss.MarshalYAML()
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestFloat64s_UnmarshalYAML(t *testing.T) {
+	// The unmarshal function provided by the YAML package is simulated with
+	// JSON, which is a subset of YAML.
+	unmarshalFrom := func(data string) func(interface{}) error {
+		return func(v interface{}) error {
+			return json.Unmarshal([]byte(data), v)
+		}
+	}
+
+	var ss Float64s
+	assert.NoError(t, ss.UnmarshalYAML(unmarshalFrom(`[]`)))
+	assert.Equal(t, Float64s{}, ss)
+
+	assert.NoError(t, ss.UnmarshalYAML(unmarshalFrom(`[1.5, -2]`)))
+	assert.Equal(t, Float64s{1.5, -2}, ss)
+
+	assert.Error(t, ss.UnmarshalYAML(unmarshalFrom(`"foo"`)))
+	assert.Equal(t, Float64s{1.5, -2}, ss)
+}
+
+func TestFloat64s_OrderBy(t *testing.T) {
+	ss := Float64s{2.5, -3, 1, -2.5}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	byAbs := func(a, b float64) bool {
+		return math.Abs(a) < math.Abs(b)
+	}
+	bySign := func(a, b float64) bool {
+		return a < 0 && b >= 0
+	}
+
+	assert.Equal(t, Float64s{1, 2.5, -2.5, -3}, ss.OrderBy(byAbs))
+	assert.Equal(t, Float64s{1, -2.5, 2.5, -3}, ss.OrderBy(byAbs, bySign))
+}
+
+var float64sTakeAndDropTests = []struct {
+	ss   Float64s
+	n    int
+	take Float64s
+	drop Float64s
+}{
+	{nil, 1, nil, nil},
+	{Float64s{}, 1, nil, nil},
+	{Float64s{1.23, 2.34, 3.45}, 0, nil, Float64s{1.23, 2.34, 3.45}},
+	{Float64s{1.23, 2.34, 3.45}, -1, nil, Float64s{1.23, 2.34, 3.45}},
+	{Float64s{1.23, 2.34, 3.45}, 2, Float64s{1.23, 2.34}, Float64s{3.45}},
+	{Float64s{1.23, 2.34, 3.45}, 3, Float64s{1.23, 2.34, 3.45}, nil},
+	{Float64s{1.23, 2.34, 3.45}, 5, Float64s{1.23, 2.34, 3.45}, nil},
+}
+
+func TestFloat64s_Take(t *testing.T) {
+	for _, test := range float64sTakeAndDropTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.take, test.ss.Take(test.n))
+		})
+	}
+}
+
+func TestFloat64s_Drop(t *testing.T) {
+	for _, test := range float64sTakeAndDropTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.drop, test.ss.Drop(test.n))
+		})
+	}
+}
+
+var float64sTakeWhileAndDropWhileTests = []struct {
+	ss        Float64s
+	takeWhile Float64s
+	dropWhile Float64s
+}{
+	{nil, nil, nil},
+	{Float64s{}, nil, nil},
+	{Float64s{1, 2, 5, 1}, Float64s{1, 2}, Float64s{5, 1}},
+	{Float64s{5, 1}, nil, Float64s{5, 1}},
+	{Float64s{1, 2}, Float64s{1, 2}, nil},
+}
+
+func TestFloat64s_TakeWhile(t *testing.T) {
+	lessThanThree := func(value float64) bool {
+		return value < 3
+	}
+
+	for _, test := range float64sTakeWhileAndDropWhileTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.takeWhile, test.ss.TakeWhile(lessThanThree))
+		})
+	}
+}
+
+func TestFloat64s_DropWhile(t *testing.T) {
+	lessThanThree := func(value float64) bool {
+		return value < 3
+	}
+
+	for _, test := range float64sTakeWhileAndDropWhileTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.dropWhile, test.ss.DropWhile(lessThanThree))
+		})
+	}
+}
+
+var float64sPartitionTests = []struct {
+	ss       Float64s
+	matching Float64s
+	rest     Float64s
+}{
+	{nil, nil, nil},
+	{Float64s{}, nil, nil},
+	{Float64s{1.5, -2.5, 3.5, -4.5}, Float64s{1.5, 3.5}, Float64s{-2.5, -4.5}},
+	{Float64s{1.5, 2.5}, Float64s{1.5, 2.5}, nil},
+	{Float64s{-1.5}, nil, Float64s{-1.5}},
+}
+
+func TestFloat64s_Partition(t *testing.T) {
+	isPositive := func(value float64) bool {
+		return value > 0
+	}
+
+	for _, test := range float64sPartitionTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			matching, rest := test.ss.Partition(isPositive)
+			assert.Equal(t, test.matching, matching)
+			assert.Equal(t, test.rest, rest)
+		})
+	}
+}
+
+var float64sZipTests = []struct {
+	ss, ss2 Float64s
+	zip     Float64sPairs
+	zipWith Float64s
+}{
+	{nil, nil, nil, nil},
+	{Float64s{1.5}, nil, nil, nil},
+	{
+		Float64s{1.5, 2.5},
+		Float64s{3, 4},
+		Float64sPairs{{1.5, 3}, {2.5, 4}},
+		Float64s{4.5, 6.5},
+	},
+	{
+		Float64s{1.5, 2.5, 3.5},
+		Float64s{3},
+		Float64sPairs{{1.5, 3}},
+		Float64s{4.5},
+	},
+}
+
+func TestFloat64s_Zip(t *testing.T) {
+	for _, test := range float64sZipTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.zip, test.ss.Zip(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_ZipWith(t *testing.T) {
+	add := func(a, b float64) float64 {
+		return a + b
+	}
+
+	for _, test := range float64sZipTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.zipWith, test.ss.ZipWith(test.ss2, add))
+		})
+	}
+}
+
+func TestFloat64sPairs_Unzip(t *testing.T) {
+	xs, ys := Float64sPairs(nil).Unzip()
+	assert.Equal(t, Float64s(nil), xs)
+	assert.Equal(t, Float64s(nil), ys)
+
+	xs, ys = Float64s{1.5, 2.5}.Zip(Float64s{3, 4}).Unzip()
+	assert.Equal(t, Float64s{1.5, 2.5}, xs)
+	assert.Equal(t, Float64s{3, 4}, ys)
+}
+
+func TestFlattenFloat64s(t *testing.T) {
+	assert.Equal(t, Float64s(nil), FlattenFloat64s(nil))
+	assert.Equal(t, Float64s(nil), FlattenFloat64s([]Float64s{nil, {}}))
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, FlattenFloat64s([]Float64s{{1.5}, nil, {2.5, 3.5}}))
+}
+
+func TestFloat64s_AppendDoesNotShareArray(t *testing.T) {
+	ss := make(Float64s, 1, 10)
+	a := ss.Append(1.23)
+	b := ss.Append(2.34)
+	c := ss.Extend(Float64s{3.45})
+
+	assert.Equal(t, Float64s{0, 1.23}, a)
+	assert.Equal(t, Float64s{0, 2.34}, b)
+	assert.Equal(t, Float64s{0, 3.45}, c)
+}
+
+var float64sInterleaveTests = []struct {
+	ss, ss2  Float64s
+	expected Float64s
+}{
+	{nil, nil, nil},
+	{Float64s{1.5}, nil, Float64s{1.5}},
+	{nil, Float64s{1.5}, Float64s{1.5}},
+	{Float64s{1, 2}, Float64s{3, 4}, Float64s{1, 3, 2, 4}},
+	{Float64s{1, 2, 5, 6}, Float64s{3}, Float64s{1, 3, 2, 5, 6}},
+	{Float64s{1}, Float64s{3, 4, 5}, Float64s{1, 3, 4, 5}},
+}
+
+func TestFloat64s_Interleave(t *testing.T) {
+	for _, test := range float64sInterleaveTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.expected, test.ss.Interleave(test.ss2))
+		})
+	}
+}
+
+var float64sInsertTests = []struct {
+	ss       Float64s
+	index    int
+	values   Float64s
+	expected Float64s
+}{
+	{nil, 0, nil, nil},
+	{nil, 0, Float64s{1.5}, Float64s{1.5}},
+	{Float64s{1, 2}, 0, Float64s{3}, Float64s{3, 1, 2}},
+	{Float64s{1, 2}, 1, Float64s{3, 4}, Float64s{1, 3, 4, 2}},
+	{Float64s{1, 2}, 2, Float64s{3}, Float64s{1, 2, 3}},
+	{Float64s{1, 2}, -5, Float64s{3}, Float64s{3, 1, 2}},
+	{Float64s{1, 2}, 5, Float64s{3}, Float64s{1, 2, 3}},
+	{Float64s{1, 2}, 1, nil, Float64s{1, 2}},
+}
+
+func TestFloat64s_Insert(t *testing.T) {
+	for _, test := range float64sInsertTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Insert(test.index, test.values...))
+		})
+	}
+}
+
+var float64sDeleteAtTests = []struct {
+	ss       Float64s
+	indexes  []int
+	expected Float64s
+}{
+	{nil, []int{0}, nil},
+	{Float64s{1, 2, 3}, nil, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, []int{1}, Float64s{1, 3}},
+	{Float64s{1, 2, 3}, []int{2, 0, 2}, Float64s{2}},
+	{Float64s{1, 2, 3}, []int{-1, 3}, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, []int{0, 1, 2}, nil},
+}
+
+func TestFloat64s_DeleteAt(t *testing.T) {
+	for _, test := range float64sDeleteAtTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.DeleteAt(test.indexes...))
+		})
+	}
+}
+
+var float64sSpliceTests = []struct {
+	ss                 Float64s
+	start, deleteCount int
+	values             Float64s
+	expected           Float64s
+}{
+	{nil, 0, 0, nil, nil},
+	{nil, 0, 1, Float64s{1}, Float64s{1}},
+	{Float64s{1, 2, 3}, 1, 1, nil, Float64s{1, 3}},
+	{Float64s{1, 2, 3}, 1, 1, Float64s{4, 5}, Float64s{1, 4, 5, 3}},
+	{Float64s{1, 2, 3}, 1, 0, Float64s{4}, Float64s{1, 4, 2, 3}},
+	{Float64s{1, 2, 3}, 1, 10, Float64s{4}, Float64s{1, 4}},
+	{Float64s{1, 2, 3}, -1, -1, Float64s{4}, Float64s{4, 1, 2, 3}},
+	{Float64s{1, 2, 3}, 5, 1, Float64s{4}, Float64s{1, 2, 3, 4}},
+	{Float64s{1, 2, 3}, 0, 3, nil, nil},
+}
+
+func TestFloat64s_Splice(t *testing.T) {
+	for _, test := range float64sSpliceTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Splice(test.start, test.deleteCount, test.values...))
+		})
+	}
+}
+
+func TestFloat64s_InsertDoesNotShareArray(t *testing.T) {
+	ss := make(Float64s, 2, 10)
+	a := ss.Insert(2, 1.5)
+	b := ss.Splice(2, 0, 2.5)
+
+	assert.Equal(t, Float64s{0, 0, 1.5}, a)
+	assert.Equal(t, Float64s{0, 0, 2.5}, b)
+}
+
+var float64sPopAndShiftTests = []struct {
+	ss                 Float64s
+	last, first        float64
+	popRest, shiftRest Float64s
+}{
+	{nil, 0, 0, nil, nil},
+	{Float64s{}, 0, 0, nil, nil},
+	{Float64s{1.5}, 1.5, 1.5, nil, nil},
+	{Float64s{1.5, 2.5, 3.5}, 3.5, 1.5, Float64s{1.5, 2.5}, Float64s{2.5, 3.5}},
+}
+
+func TestFloat64s_Pop(t *testing.T) {
+	for _, test := range float64sPopAndShiftTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			last, rest := test.ss.Pop()
+			assert.Equal(t, test.last, last)
+			assert.Equal(t, test.popRest, rest)
+		})
+	}
+}
+
+func TestFloat64s_Shift(t *testing.T) {
+	for _, test := range float64sPopAndShiftTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			first, rest := test.ss.Shift()
+			assert.Equal(t, test.first, first)
+			assert.Equal(t, test.shiftRest, rest)
+		})
+	}
+}
+
+func TestFloat64s_PopDoesNotShareArray(t *testing.T) {
+	ss := Float64s{1.5, 2.5}
+	_, rest := ss.Pop()
+	_ = rest.Append(3.5)
+	rest = append(rest, 4.5)
+
+	assert.Equal(t, Float64s{1.5, 2.5}, ss)
+	assert.Equal(t, Float64s{1.5, 4.5}, rest)
+}
+
+var float64sSplitAtTests = []struct {
+	ss          Float64s
+	index       int
+	left, right Float64s
+}{
+	{nil, 0, nil, nil},
+	{Float64s{1, 2, 3}, 0, nil, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, 1, Float64s{1}, Float64s{2, 3}},
+	{Float64s{1, 2, 3}, 3, Float64s{1, 2, 3}, nil},
+	{Float64s{1, 2, 3}, -1, nil, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, 5, Float64s{1, 2, 3}, nil},
+}
+
+func TestFloat64s_SplitAt(t *testing.T) {
+	for _, test := range float64sSplitAtTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			left, right := test.ss.SplitAt(test.index)
+			assert.Equal(t, test.left, left)
+			assert.Equal(t, test.right, right)
+		})
+	}
+}
+
+var float64sRotateTests = []struct {
+	ss       Float64s
+	n        int
+	expected Float64s
+}{
+	{nil, 1, nil},
+	{Float64s{1}, 3, Float64s{1}},
+	{Float64s{1, 2, 3}, 0, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, 1, Float64s{3, 1, 2}},
+	{Float64s{1, 2, 3}, 2, Float64s{2, 3, 1}},
+	{Float64s{1, 2, 3}, 3, Float64s{1, 2, 3}},
+	{Float64s{1, 2, 3}, 4, Float64s{3, 1, 2}},
+	{Float64s{1, 2, 3}, -1, Float64s{2, 3, 1}},
+	{Float64s{1, 2, 3}, -5, Float64s{3, 1, 2}},
+}
+
+func TestFloat64s_Rotate(t *testing.T) {
+	for _, test := range float64sRotateTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Rotate(test.n))
+		})
+	}
+}
+
+func TestFloat64s_RotateInPlace(t *testing.T) {
+	for _, test := range float64sRotateTests {
+		t.Run("", func(t *testing.T) {
+			ss := make(Float64s, len(test.ss))
+			copy(ss, test.ss)
+			if test.ss == nil {
+				ss = nil
+			}
+
+			assert.Equal(t, test.expected, ss.RotateInPlace(test.n))
+			assert.Equal(t, test.expected, ss)
+		})
+	}
+}
+
+func TestFloat64s_Swap(t *testing.T) {
+	ss := Float64s{1, 2, 3}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s{3, 2, 1}, ss.Swap(0, 2))
+	assert.Equal(t, Float64s{1, 2, 3}, ss.Swap(1, 1))
+	assert.Panics(t, func() {
+		ss.Swap(0, 3)
+	})
+}
+
+func TestFloat64s_SwapInPlace(t *testing.T) {
+	ss := Float64s{1, 2, 3}
+
+	assert.Equal(t, Float64s{3, 2, 1}, ss.SwapInPlace(0, 2))
+	assert.Equal(t, Float64s{3, 2, 1}, ss)
+}
+
+var float64sCompactTests = []struct {
+	ss         Float64s
+	compact    Float64s
+	coalesceOr float64
+}{
+	{nil, nil, -1},
+	{Float64s{0, 0}, nil, -1},
+	{Float64s{0, 1.5, 0, 2.5}, Float64s{1.5, 2.5}, 1.5},
+	{Float64s{3.5}, Float64s{3.5}, 3.5},
+}
+
+func TestFloat64s_Compact(t *testing.T) {
+	for _, test := range float64sCompactTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.compact, test.ss.Compact())
+		})
+	}
+}
+
+func TestFloat64s_CoalesceOr(t *testing.T) {
+	for _, test := range float64sCompactTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.coalesceOr, test.ss.CoalesceOr(-1))
+		})
+	}
+}
+
+var float64sWindowsTests = []struct {
+	ss       Float64s
+	size     int
+	expected []Float64s
+}{
+	{nil, 2, nil},
+	{Float64s{1, 2}, 0, nil},
+	{Float64s{1, 2}, 3, nil},
+	{Float64s{1, 2}, 2, []Float64s{{1, 2}}},
+	{Float64s{1, 2, 3, 4}, 3, []Float64s{{1, 2, 3}, {2, 3, 4}}},
+	{Float64s{1, 2, 3}, 1, []Float64s{{1}, {2}, {3}}},
+}
+
+func TestFloat64s_Windows(t *testing.T) {
+	for _, test := range float64sWindowsTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Windows(test.size))
+		})
+	}
+}
+
+func TestFloat64s_WindowsDoNotOverwrite(t *testing.T) {
+	ss := Float64s{1, 2, 3}
+	windows := ss.Windows(2)
+	windows[0] = append(windows[0], 4)
+
+	assert.Equal(t, Float64s{1, 2, 3}, ss)
+	assert.Equal(t, Float64s{2, 3}, windows[1])
+}
+
+var float64sPairwiseTests = []struct {
+	ss       Float64s
+	expected [][2]float64
+}{
+	{nil, nil},
+	{Float64s{1.5}, nil},
+	{Float64s{1.5, 2.5}, [][2]float64{{1.5, 2.5}}},
+	{Float64s{1.5, 2.5, 4}, [][2]float64{{1.5, 2.5}, {2.5, 4}}},
+}
+
+func TestFloat64s_Pairwise(t *testing.T) {
+	for _, test := range float64sPairwiseTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Pairwise())
+		})
+	}
+}
+
+func TestFloat64s_Deltas(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Float64s{1.5}.Deltas())
+	assert.Equal(t, Float64s{1, -2.5}, Float64s{1.5, 2.5, 0}.Deltas())
+}
+
+var float64sAccumulateTests = []struct {
+	ss       Float64s
+	expected Float64s
+}{
+	{nil, nil},
+	{Float64s{}, Float64s{}},
+	{Float64s{2}, Float64s{2}},
+	{Float64s{2, 3, 4}, Float64s{2, 6, 24}},
+	{Float64s{1.1, 1.5, 2}, Float64s{1.1, 1.6500000000000001, 3.3000000000000003}},
+}
+
+func TestFloat64s_Accumulate(t *testing.T) {
+	product := func(acc, value float64) float64 {
+		return acc * value
+	}
+
+	for _, test := range float64sAccumulateTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Accumulate(1, product))
+		})
+	}
+}
+
+func TestFloat64s_AccumulateIsCumulativeSum(t *testing.T) {
+	ss := Float64s{1.5, -2.5, 3}
+	assert.Equal(t, ss.CumulativeSum(), ss.Accumulate(0, func(acc, value float64) float64 {
+		return acc + value
+	}))
+}
+
+var float64sSearchSortedTests = []struct {
+	ss    Float64s
+	value float64
+	index int
+	found bool
+}{
+	{nil, 1.5, 0, false},
+	{Float64s{1.5, 2.5, 2.5, 4}, 0, 0, false},
+	{Float64s{1.5, 2.5, 2.5, 4}, 1.5, 0, true},
+	{Float64s{1.5, 2.5, 2.5, 4}, 2.5, 1, true},
+	{Float64s{1.5, 2.5, 2.5, 4}, 3, 3, false},
+	{Float64s{1.5, 2.5, 2.5, 4}, 4, 3, true},
+	{Float64s{1.5, 2.5, 2.5, 4}, 5, 4, false},
+}
+
+func TestFloat64s_SearchSorted(t *testing.T) {
+	for _, test := range float64sSearchSortedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+
+			index, found := test.ss.SearchSorted(test.value)
+			assert.Equal(t, test.index, index)
+			assert.Equal(t, test.found, found)
+		})
+	}
+}
+
+var float64sInsertSortedTests = []struct {
+	ss       Float64s
+	value    float64
+	expected Float64s
+}{
+	{nil, 1.5, Float64s{1.5}},
+	{Float64s{1.5, 2.5}, 0, Float64s{0, 1.5, 2.5}},
+	{Float64s{1.5, 2.5}, 2, Float64s{1.5, 2, 2.5}},
+	{Float64s{1.5, 2.5}, 2.5, Float64s{1.5, 2.5, 2.5}},
+	{Float64s{1.5, 2.5}, 3, Float64s{1.5, 2.5, 3}},
+}
+
+func TestFloat64s_InsertSorted(t *testing.T) {
+	for _, test := range float64sInsertSortedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.InsertSorted(test.value))
+		})
+	}
+}
+
+var float64sMergeSortedTests = []struct {
+	ss, ss2     Float64s
+	mergeSorted Float64s
+	unique      Float64s
+}{
+	{nil, nil, nil, nil},
+	{Float64s{1.5}, nil, Float64s{1.5}, Float64s{1.5}},
+	{nil, Float64s{1.5, 1.5}, Float64s{1.5, 1.5}, Float64s{1.5}},
+	{
+		Float64s{1, 3, 3, 5},
+		Float64s{2, 3, 6},
+		Float64s{1, 2, 3, 3, 3, 5, 6},
+		Float64s{1, 2, 3, 5, 6},
+	},
+	{
+		Float64s{4, 5},
+		Float64s{1, 2},
+		Float64s{1, 2, 4, 5},
+		Float64s{1, 2, 4, 5},
+	},
+}
+
+func TestFloat64s_MergeSorted(t *testing.T) {
+	for _, test := range float64sMergeSortedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.mergeSorted, test.ss.MergeSorted(test.ss2))
+		})
+	}
+}
+
+func TestFloat64s_MergeSortedUnique(t *testing.T) {
+	for _, test := range float64sMergeSortedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+			assert.Equal(t, test.unique, test.ss.MergeSortedUnique(test.ss2))
+		})
+	}
+}
+
+var float64sFrequenciesTests = []struct {
+	ss       Float64s
+	expected map[float64]int
+}{
+	{nil, map[float64]int{}},
+	{Float64s{1.5}, map[float64]int{1.5: 1}},
+	{Float64s{1.5, 2.5, 1.5, 1.5}, map[float64]int{1.5: 3, 2.5: 1}},
+}
+
+func TestFloat64s_Frequencies(t *testing.T) {
+	for _, test := range float64sFrequenciesTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Frequencies())
+		})
+	}
+}
+
+func TestFloat64s_CountUsing(t *testing.T) {
+	isPositive := func(value float64) bool {
+		return value > 0
+	}
+
+	assert.Equal(t, 0, Float64s(nil).CountUsing(isPositive))
+	assert.Equal(t, 2, Float64s{1.5, -2.5, 3.5}.CountUsing(isPositive))
+}
+
+func TestFloat64s_ToSet(t *testing.T) {
+	assert.Equal(t, map[float64]struct{}{}, Float64s(nil).ToSet())
+	assert.Equal(t, map[float64]struct{}{1.5: {}, 2.5: {}}, Float64s{1.5, 2.5, 1.5}.ToSet())
+}
+
+func TestFloat64s_KeyByString(t *testing.T) {
+	format := func(value float64) string {
+		return fmt.Sprintf("%.0f", value)
+	}
+
+	assert.Equal(t, map[string]float64{}, Float64s(nil).KeyByString(format))
+	assert.Equal(t, map[string]float64{"1": 1.2, "2": 2.4}, Float64s{1.1, 2.4, 1.2}.KeyByString(format))
+}
+
+func TestFloat64sSet(t *testing.T) {
+	s := NewFloat64sSet(1.5, 2.5, 1.5)
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains(1.5))
+	assert.False(t, s.Contains(3.5))
+
+	s.Add(3.5).Remove(1.5, 4.5)
+	assert.Equal(t, Float64s{2.5, 3.5}, s.Slice())
+
+	other := Float64s{3.5, 5.5}.Set()
+	assert.Equal(t, Float64s{2.5, 3.5, 5.5}, s.Union(other).Slice())
+	assert.Equal(t, Float64s{3.5}, s.Intersect(other).Slice())
+	assert.Equal(t, Float64s{2.5}, s.Diff(other).Slice())
+	assert.Equal(t, Float64s{5.5}, other.Diff(s).Slice())
+
+	// The set operations do not modify either set.
+	assert.Equal(t, Float64s{2.5, 3.5}, s.Slice())
+	assert.Equal(t, Float64s{3.5, 5.5}, other.Slice())
+
+	assert.Equal(t, Float64s(nil), Float64sSet(nil).Slice())
+	assert.False(t, Float64sSet(nil).Contains(1.5))
+	assert.Equal(t, Float64sSet{1.5: {}}, Float64sSet(Float64s{1.5}.ToSet()))
+}
+
+func TestFloat64sSorted(t *testing.T) {
+	values := Float64s{3.5, 1.5, 2.5}
+	defer assertImmutableFloat64s(t, &values)()
+
+	s := NewFloat64sSorted(values...)
+	assert.Equal(t, Float64s{1.5, 2.5, 3.5}, s.Slice())
+
+	s.Insert(2, 4.5, 0.5, 2)
+	assert.Equal(t, Float64s{0.5, 1.5, 2, 2, 2.5, 3.5, 4.5}, s.Slice())
+	assert.Equal(t, 7, s.Len())
+
+	assert.True(t, s.Contains(2.5))
+	assert.False(t, s.Contains(3))
+	assert.Equal(t, 2, s.Index(2))
+	assert.Equal(t, -1, s.Index(5))
+
+	assert.Equal(t, Float64s{1.5, 2, 2, 2.5}, s.RangeBetween(1, 2.5))
+	assert.Equal(t, Float64s{4.5}, s.RangeBetween(4.5, 10))
+	assert.Equal(t, Float64s(nil), s.RangeBetween(5, 10))
+	assert.Equal(t, Float64s(nil), s.RangeBetween(3, 1))
+
+	assert.True(t, s.Remove(2))
+	assert.False(t, s.Remove(3))
+	assert.Equal(t, Float64s{0.5, 1.5, 2, 2.5, 3.5, 4.5}, s.Slice())
+
+	var empty Float64sSorted
+	assert.Equal(t, Float64s(nil), empty.Slice())
+	assert.False(t, empty.Contains(1))
+	assert.Equal(t, Float64s{1}, empty.Insert(1).Slice())
+}
+
+func TestFloat64sSync(t *testing.T) {
+	var s Float64sSync
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, Float64s(nil), s.Snapshot())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Append(float64(i), float64(i))
+			s.Read(func(ss Float64s) {
+				assert.True(t, ss.Contains(float64(i)))
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	snapshot := s.Snapshot()
+	assert.Equal(t, 20, s.Len())
+	assert.Equal(t, 90.0, snapshot.Sum())
+
+	// The snapshot is not affected by later appends.
+	s.Append(100)
+	assert.Equal(t, 20, len(snapshot))
+	assert.Equal(t, 21, s.Len())
+}
+
+var float64sHistogramTests = []struct {
+	ss          Float64s
+	bucketCount int
+	expected    map[string]int
+}{
+	{nil, 2, map[string]int{}},
+	{Float64s{1, 2}, 0, map[string]int{}},
+	{Float64s{3, 3}, 4, map[string]int{"[3, 3]": 2}},
+	{
+		Float64s{0, 1, 2.5, 4, 10},
+		4,
+		map[string]int{"[0, 2.5)": 2, "[2.5, 5)": 2, "[5, 7.5)": 0, "[7.5, 10]": 1},
+	},
+	{Float64s{-1, 1}, 1, map[string]int{"[-1, 1]": 2}},
+	{Float64s{1, math.NaN(), 3}, 2, map[string]int{"[1, 2)": 1, "[2, 3]": 1}},
+	{Float64s{1, math.Inf(1), 3}, 2, map[string]int{"[1, 2)": 1, "[2, 3]": 1}},
+	{Float64s{math.Inf(-1), 2}, 2, map[string]int{"[2, 2]": 1}},
+	{Float64s{math.NaN()}, 2, map[string]int{}},
+}
+
+func TestFloat64s_Histogram(t *testing.T) {
+	for _, test := range float64sHistogramTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Histogram(test.bucketCount))
+		})
+	}
+}
+
+func TestFloat64s_HistogramWithBounds(t *testing.T) {
+	ss := Float64s{-5, 0, 0.5, 1, 9.9, 10, 50}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, []int{1, 2, 2, 2}, ss.HistogramWithBounds(Float64s{0, 1, 10}))
+	assert.Equal(t, []int{7}, ss.HistogramWithBounds(nil))
+	assert.Equal(t, []int{0, 0}, Float64s(nil).HistogramWithBounds(Float64s{1}))
+}
+
+var float64sCorrelationTests = []struct {
+	ss, ss2     Float64s
+	covariance  float64
+	correlation float64
+	err         error
+}{
+	{nil, nil, 0, 0, ErrEmptySlice},
+	{Float64s{1}, nil, 0, 0, ErrLengthMismatch},
+	{Float64s{1, 2, 3}, Float64s{2, 4, 6}, 4.0 / 3, 1, nil},
+	{Float64s{1, 2, 3}, Float64s{3, 2, 1}, -2.0 / 3, -1, nil},
+	{Float64s{1, 2, 3, 4}, Float64s{1, 3, 2, 4}, 1, 0.8, nil},
+	{Float64s{1, 2, 3}, Float64s{5, 5, 5}, 0, 0, ErrZeroVariance},
+}
+
+func TestFloat64s_CorrelationAndCovariance(t *testing.T) {
+	for _, test := range float64sCorrelationTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			defer assertImmutableFloat64s(t, &test.ss2)()
+
+			correlation, err := test.ss.Correlation(test.ss2)
+			assert.Equal(t, test.err, err)
+			assert.InDelta(t, test.correlation, correlation, 1e-9)
+
+			covariance, err := test.ss.Covariance(test.ss2)
+			if test.err == ErrZeroVariance {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, test.err, err)
+			}
+			assert.InDelta(t, test.covariance, covariance, 1e-9)
+		})
+	}
+}
+
+var float64sMeanTests = []struct {
+	ss                      Float64s
+	geometricMean, harmonic float64
+}{
+	{nil, 0, 0},
+	{Float64s{4}, 4, 4},
+	{Float64s{2, 8}, 4, 3.2},
+	{Float64s{1, 3, 9}, 3, 27.0 / 13},
+	{Float64s{1, 0, 9}, 0, 0},
+}
+
+func TestFloat64s_GeometricMeanAndHarmonicMean(t *testing.T) {
+	for _, test := range float64sMeanTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.InDelta(t, test.geometricMean, test.ss.GeometricMean(), 1e-9)
+			assert.InDelta(t, test.harmonic, test.ss.HarmonicMean(), 1e-9)
+		})
+	}
+
+	assert.True(t, math.IsNaN(Float64s{1, -2}.GeometricMean()))
+
+	// The product of these would overflow a float64.
+	assert.InDelta(t, 1e200, Float64s{1e200, 1e200, 1e200}.GeometricMean(), 1e188)
+}
+
+func TestFloat64s_WeightedAverage(t *testing.T) {
+	ss := Float64s{1, 2, 4}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	average, err := ss.WeightedAverage(Float64s{1, 1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2.75, average)
+
+	_, err = ss.WeightedAverage(Float64s{1})
+	assert.Equal(t, ErrLengthMismatch, err)
+
+	_, err = Float64s{}.WeightedAverage(nil)
+	assert.Equal(t, ErrEmptySlice, err)
+
+	_, err = ss.WeightedAverage(Float64s{1, -1, 0})
+	assert.Equal(t, ErrZeroWeights, err)
+}
+
+func TestFloat64s_OutliersAndRemoveOutliers(t *testing.T) {
+	// Q1 is 1.75 and Q3 is 5.25, so values outside of -3.5 to 10.5 are outliers.
+	ss := Float64s{3, 100, 2, 4, 5, -50, 6, 1}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s{100, -50}, ss.Outliers(1.5))
+	assert.Equal(t, Float64s{3, 2, 4, 5, 6, 1}, ss.RemoveOutliers(1.5))
+	assert.Equal(t, Float64s(nil), Float64s{1, 2, 3}.Outliers(1.5))
+	assert.Equal(t, Float64s(nil), Float64s(nil).RemoveOutliers(1.5))
+}
+
+var float64sTrimmedMeanTests = []struct {
+	ss       Float64s
+	fraction float64
+	expected float64
+}{
+	{nil, 0.1, 0},
+	{Float64s{1, 2, 3}, 0, 2},
+	{Float64s{1, 2, 3}, -1, 2},
+	{Float64s{100, 1, 2, 3, -50}, 0.2, 2},
+	{Float64s{100, 1, 2, 3, -50}, 0.1, 11.2},
+	{Float64s{100, 1, 2, 4, -50}, 0.5, 2},
+	{Float64s{100, 1, 2, -50}, 1, 1.5},
+}
+
+func TestFloat64s_TrimmedMean(t *testing.T) {
+	for _, test := range float64sTrimmedMeanTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &test.ss)()
+			assert.InDelta(t, test.expected, test.ss.TrimmedMean(test.fraction), 1e-9)
+		})
+	}
+}
+
+func TestFloat64s_NaNAndInf(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	ss := Float64s{1, nan, inf, 2, -inf, nan}
+
+	assert.True(t, ss.HasNaN())
+	assert.False(t, Float64s{1, inf}.HasNaN())
+	assert.False(t, Float64s(nil).HasNaN())
+
+	assert.Equal(t, Float64s{1, inf, 2, -inf}, ss.DropNaN())
+	assert.Equal(t, 4, len(ss.DropInf()))
+	assert.True(t, ss.DropInf().HasNaN())
+	assert.Equal(t, Float64s{1, 2}, ss.DropNaN().DropInf())
+
+	assert.True(t, math.IsNaN(Float64s{1, nan}.Sum()))
+	assert.Equal(t, 3.0, Float64s{1, nan, 2}.SumSkipNaN())
+	assert.Equal(t, 1.5, Float64s{1, nan, 2}.AverageSkipNaN())
+	assert.Equal(t, 0.0, Float64s{nan}.AverageSkipNaN())
+}
+
+func TestFloat64s_SortNaN(t *testing.T) {
+	nan := math.NaN()
+	ss := Float64s{3, nan, 1, nan, 2}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	sorted := ss.Sort()
+	assert.True(t, math.IsNaN(sorted[0]))
+	assert.True(t, math.IsNaN(sorted[1]))
+	assert.Equal(t, Float64s{1, 2, 3}, sorted[2:])
+	assert.True(t, sorted.AreSorted())
+	assert.False(t, Float64s{1, nan}.AreSorted())
+}
+
+func TestFloat64s_SumAccurate(t *testing.T) {
+	ss := make(Float64s, 1000000)
+	for i := range ss {
+		ss[i] = 0.1
+	}
+
+	assert.NotEqual(t, 100000.0, ss.Sum())
+	assert.Equal(t, 100000.0, ss.SumAccurate())
+	assert.Equal(t, 0.1, ss.AverageAccurate())
+
+	// The small values would be lost entirely with a naive sum.
+	assert.Equal(t, 2.0, Float64s{1, 1e100, 1, -1e100}.SumAccurate())
+
+	assert.Equal(t, 0.0, Float64s(nil).SumAccurate())
+	assert.Equal(t, 0.0, Float64s(nil).AverageAccurate())
+}
+
+func TestFloat64s_Approx(t *testing.T) {
+	// Constant expressions are exact, so this needs to be calculated at
+	// runtime to get a rounding error.
+	a, b := 0.1, 0.2
+	ss := Float64s{a + b, 1, math.NaN()}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.False(t, ss.Contains(0.3))
+	assert.True(t, ss.ContainsApprox(0.3, 1e-9))
+	assert.True(t, ss.ContainsApprox(1.05, 0.1))
+	assert.False(t, ss.ContainsApprox(2, 0.1))
+	assert.False(t, ss.ContainsApprox(math.NaN(), 1))
+
+	assert.True(t, Float64s{a + b, 1}.EqualsApprox(Float64s{0.3, 1}, 1e-9))
+	assert.False(t, Float64s{0.3, 1}.EqualsApprox(Float64s{0.3, 1.1}, 1e-9))
+	assert.False(t, Float64s{0.3}.EqualsApprox(Float64s{0.3, 1}, 1e-9))
+	assert.False(t, ss.EqualsApprox(ss, 1e-9))
+	assert.True(t, Float64s(nil).EqualsApprox(Float64s{}, 0))
+
+	assert.Equal(t, Float64s{0.3, 1}, Float64s{0.3, a + b, 1, 1.0000001}.UniqueApprox(1e-6))
+	assert.Equal(t, Float64s(nil), Float64s(nil).UniqueApprox(1))
+	assert.Equal(t, 2, len(Float64s{math.NaN(), math.NaN()}.UniqueApprox(1)))
+}
+
+func TestFloat64s_Clamp(t *testing.T) {
+	ss := Float64s{-5, 0.5, 3, 10}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s{0, 0.5, 3, 5}, ss.Clamp(0, 5))
+	assert.Equal(t, Float64s{1, 1, 1, 1}, ss.Clamp(2, 1))
+	assert.Equal(t, Float64s(nil), Float64s(nil).Clamp(0, 1))
+}
+
+func TestFloat64s_MathTransforms(t *testing.T) {
+	ss := Float64s{-1.55, 0, 2.25, 4}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Float64s{-1.6, 0, 2.3, 4}, ss.Round(1))
+	assert.Equal(t, Float64s{-2, 0, 2, 4}, ss.Round(0))
+	assert.Equal(t, Float64s{1200, 100}, Float64s{1234.5, 50}.Round(-2))
+	assert.Equal(t, Float64s{-2, 0, 2, 4}, ss.Floor())
+	assert.Equal(t, Float64s{-1, 0, 3, 4}, ss.Ceil())
+
+	sqrt := ss.Sqrt()
+	assert.True(t, math.IsNaN(sqrt[0]))
+	assert.Equal(t, Float64s{0, 1.5, 2}, sqrt[1:])
+
+	log := Float64s{0, 1, math.E}.Log()
+	assert.Equal(t, Float64s{math.Inf(-1), 0, 1}, log)
+	assert.Equal(t, Float64s{1, math.E}, Float64s{0, 1}.Exp())
+
+	for _, transform := range []func() Float64s{
+		Float64s(nil).Floor, Float64s(nil).Ceil, Float64s(nil).Sqrt,
+		Float64s(nil).Log, Float64s(nil).Exp,
+	} {
+		assert.Equal(t, Float64s(nil), transform())
+	}
+	assert.Equal(t, Float64s(nil), Float64s(nil).Round(2))
+}
+
+func TestFloat64s_ArgMinArgMaxAndArgSort(t *testing.T) {
+	ss := Float64s{3, 1, 4, 1, 5}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, 1, ss.ArgMin())
+	assert.Equal(t, 4, ss.ArgMax())
+	assert.Equal(t, []int{1, 3, 0, 2, 4}, ss.ArgSort())
+
+	assert.Equal(t, -1, Float64s(nil).ArgMin())
+	assert.Equal(t, -1, Float64s(nil).ArgMax())
+	assert.Equal(t, []int(nil), Float64s(nil).ArgSort())
+
+	assert.Equal(t, []int{1, 2, 0}, Float64s{2, math.NaN(), 1}.ArgSort())
+}
+
+var float64sRankTests = []struct {
+	method   RankMethod
+	expected Float64s
+}{
+	{RankAverage, Float64s{4, 2.5, 1, 2.5, 5}},
+	{RankMin, Float64s{4, 2, 1, 2, 5}},
+	{RankMax, Float64s{4, 3, 1, 3, 5}},
+	{RankDense, Float64s{3, 2, 1, 2, 4}},
+}
+
+func TestFloat64s_Rank(t *testing.T) {
+	ss := Float64s{30, 20, 10, 20, 40}
+
+	for _, test := range float64sRankTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableFloat64s(t, &ss)()
+			assert.Equal(t, test.expected, ss.Rank(test.method))
+			assert.Equal(t, Float64s(nil), Float64s(nil).Rank(test.method))
+		})
+	}
+
+	// NaN values are equal to each other and ranked lowest.
+	ss = Float64s{30, math.NaN(), 10, math.NaN()}
+	assert.Equal(t, Float64s{4, 1.5, 3, 1.5}, ss.Rank(RankAverage))
+	assert.Equal(t, Float64s{4, 1, 3, 1}, ss.Rank(RankMin))
+	assert.Equal(t, Float64s{4, 2, 3, 2}, ss.Rank(RankMax))
+	assert.Equal(t, Float64s{3, 1, 2, 1}, ss.Rank(RankDense))
+}
+
+func TestFloat64s_Softmax(t *testing.T) {
+	ss := Float64s{1, 2, 3}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	softmax := ss.Softmax()
+	assert.InDelta(t, 0.09003057, softmax[0], 1e-8)
+	assert.InDelta(t, 0.24472847, softmax[1], 1e-8)
+	assert.InDelta(t, 0.66524096, softmax[2], 1e-8)
+	assert.InDelta(t, 1, softmax.Sum(), 1e-12)
+
+	// These would overflow without subtracting the largest element.
+	assert.Equal(t, Float64s{0.5, 0.5}, Float64s{1000, 1000}.Softmax())
+	assert.Equal(t, Float64s(nil), Float64s(nil).Softmax())
+}
+
+func TestFloat64s_ProportionsAndEntropy(t *testing.T) {
+	ss := Float64s{1, 1, 2}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	proportions := ss.Proportions()
+	assert.Equal(t, Float64s{0.25, 0.25, 0.5}, proportions)
+	assert.InDelta(t, 1.5, proportions.Entropy()/math.Ln2, 1e-12)
+	assert.Equal(t, Float64s(nil), Float64s(nil).Proportions())
+
+	assert.Equal(t, 0.0, Float64s{1, 0}.Entropy())
+	assert.Equal(t, 0.0, Float64s(nil).Entropy())
+	assert.InDelta(t, math.Ln2, Float64s{0.5, 0.5}.Entropy(), 1e-12)
+}
+
+func TestFloat64sRangeAndLinspace(t *testing.T) {
+	assert.Equal(t, Float64s{0, 0.5, 1, 1.5}, Float64sRange(0, 2, 0.5))
+	assert.Equal(t, 10, len(Float64sRange(0, 1, 0.1)))
+
+	assert.Equal(t, Float64s{0, 0.25, 0.5, 0.75, 1}, Float64sLinspace(0, 1, 5))
+	assert.Equal(t, Float64s{1, 0}, Float64sLinspace(1, 0, 2))
+	assert.Equal(t, Float64s{3}, Float64sLinspace(3, 5, 1))
+	assert.Equal(t, Float64s(nil), Float64sLinspace(0, 1, 0))
+}
+
+func TestRandomFloat64s(t *testing.T) {
+	ss := RandomFloat64s(100, -1, 1, rand.NewSource(1))
+	assert.Equal(t, 100, len(ss))
+	assert.True(t, ss.Min() >= -1)
+	assert.True(t, ss.Max() < 1)
+
+	assert.Equal(t, ss, RandomFloat64s(100, -1, 1, rand.NewSource(1)))
+	assert.Equal(t, Float64s{5, 5}, RandomFloat64s(2, 5, 5, nil))
+	assert.Equal(t, Float64s(nil), RandomFloat64s(0, 0, 1, nil))
+}
+
+func TestFloat64s_FormatAndStrings(t *testing.T) {
+	ss := Float64s{1.5, 20, -0.125}
+	defer assertImmutableFloat64s(t, &ss)()
+
+	assert.Equal(t, Strings{"1.50", "20.00", "-0.12"}, ss.Format("%.2f"))
+	assert.Equal(t, Strings{"1.5", "20", "-0.125"}, ss.Strings())
+	assert.Equal(t, Strings(nil), Float64s(nil).Format("%f"))
+	assert.Equal(t, Strings(nil), Float64s(nil).Strings())
+}
@@ -0,0 +1,4 @@
+package pie
+
+//go:generate pie Int32s.*
+type Int32s []int32
@@ -0,0 +1,3784 @@
+package pie
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/elliotchance/pie/pie/util"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Abs returns a new slice with the absolute value of each element. The input
+// slice is not modified.
+//
+// For signed integers the absolute value of the smallest value cannot be
+// represented, so it is returned unchanged. For example, math.MinInt64 for
+// Int64s.
+func (ss Int32s) Abs() Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Int32s, len(ss))
+	for i, s := range ss {
+		if s < 0 {
+			s = -s
+		}
+
+		result[i] = s
+	}
+
+	return result
+}
+
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss Int32s) Accumulate(initial int32, fn func(acc, value int32) int32) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(Int32s, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
+
+// Add returns a new slice where each element is the sum of the elements at the
+// same position in ss and ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss Int32s) Add(ss2 Int32s) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(Int32s, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] + ss2[i]
+	}
+
+	return result
+}
+
+// AddScalar returns a new slice with value added to each element.
+func (ss Int32s) AddScalar(value int32) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Int32s, len(ss))
+	for i, s := range ss {
+		result[i] = s + value
+	}
+
+	return result
+}
+
+// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
+func (ss Int32s) All(fn func(value int32) bool) bool {
+	for _, value := range ss {
+		if !fn(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
+func (ss Int32s) Any(fn func(value int32) bool) bool {
+	for _, value := range ss {
+		if fn(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
+//
+// It is acceptable to provide zero arguments.
+func (ss Int32s) Append(elements ...int32) Int32s {
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(Int32s, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
+}
+
+// ArgMax returns the index of the largest element. If there are several
+// largest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Max to get the value instead.
+func (ss Int32s) ArgMax() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s > ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+
+// ArgMin returns the index of the smallest element. If there are several
+// smallest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Min to get the value instead.
+func (ss Int32s) ArgMin() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s < ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
+//
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order. The input slice is not modified
+// and nil is returned if there are no elements.
+func (ss Int32s) ArgSort() []int {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ss[indexes[i]] < ss[indexes[j]]
+	})
+
+	return indexes
+}
+
+// AreSorted will return true if the slice is already sorted. It is a wrapper
+// for sort.Int32sAreSorted.
+func (ss Int32s) AreSorted() bool {
+	return sort.SliceIsSorted(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+}
+
+// AreUnique will return true if the slice contains elements that are all
+// different (unique) from each other.
+func (ss Int32s) AreUnique() bool {
+	return ss.Unique().Len() == ss.Len()
+}
+
+// Average is the average of all of the elements, or zero if there are no
+// elements.
+func (ss Int32s) Average() float64 {
+	if l := int32(len(ss)); l > 0 {
+		return float64(ss.Sum()) / float64(l)
+	}
+
+	return 0
+}
+
+// AverageBig is the exact average of all of the elements. The sum is
+// calculated with a big.Int so it cannot overflow, unlike Average which sums
+// the elements with the element type.
+//
+// Zero is returned if there are no elements.
+func (ss Int32s) AverageBig() *big.Rat {
+	if len(ss) == 0 {
+		return new(big.Rat)
+	}
+
+	sum, value := new(big.Int), new(big.Int)
+	for _, s := range ss {
+		if s < 0 {
+			value.SetInt64(int64(s))
+		} else {
+			value.SetUint64(uint64(s))
+		}
+
+		sum.Add(sum, value)
+	}
+
+	return new(big.Rat).SetFrac(sum, big.NewInt(int64(len(ss))))
+}
+
+// Bottom will return n elements from bottom
+//
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss Int32s) Bottom(n int) (top Int32s) {
+	var lastIndex = len(ss) - 1
+	for i := lastIndex; i > -1 && n > 0; i-- {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
+//
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesianInt32s for more
+// than two slices.
+func (ss Int32s) Cartesian(ss2 Int32s) Int32sPairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
+	}
+
+	pairs := make(Int32sPairs, 0, len(ss)*len(ss2))
+	for _, s := range ss {
+		for _, s2 := range ss2 {
+			pairs = append(pairs, Int32sPair{s, s2})
+		}
+	}
+
+	return pairs
+}
+
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
+//
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
+//
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss Int32s) Chunk(size int) (chunks []Int32s) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		chunks = append(chunks, ss[i:end:end])
+	}
+
+	return
+}
+
+// Clamp returns a new slice where each element less than min is replaced with
+// min, and each element greater than max is replaced with max.
+//
+// If min is greater than max every element will be max.
+func (ss Int32s) Clamp(min, max int32) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Int32s, len(ss))
+	for i, s := range ss {
+		if s < min {
+			s = min
+		}
+
+		if s > max {
+			s = max
+		}
+
+		result[i] = s
+	}
+
+	return result
+}
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss Int32s) CoalesceOr(defaultValue int32) int32 {
+	var zero int32
+
+	for _, s := range ss {
+		if s != zero {
+			return s
+		}
+	}
+
+	return defaultValue
+}
+
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss Int32s) Combinations(k int) (combinations []Int32s) {
+	ss.EachCombination(k, func(combination Int32s) bool {
+		c := make(Int32s, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
+
+	return
+}
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Int32s) Compact() (ss2 Int32s) {
+	var zero int32
+
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Int32s) Contains(lookingFor int32) bool {
+	for _, s := range ss {
+		if s == lookingFor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Correlation is the Pearson correlation coefficient between the elements at
+// the same position in each slice. The result is between -1 and 1, where 1 is
+// a perfect positive linear relationship, -1 is a perfect negative linear
+// relationship and 0 means there is no linear relationship.
+//
+// ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and ErrEmptySlice if there are no elements. The correlation
+// is undefined when either slice has all equal elements, in which case
+// ErrZeroVariance is returned.
+func (ss Int32s) Correlation(ss2 Int32s) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var covariance, variance, variance2 float64
+	for i, s := range ss {
+		diff, diff2 := float64(s)-mean, float64(ss2[i])-mean2
+		covariance += diff * diff2
+		variance += diff * diff
+		variance2 += diff2 * diff2
+	}
+
+	if variance == 0 || variance2 == 0 {
+		return 0, ErrZeroVariance
+	}
+
+	return covariance / math.Sqrt(variance*variance2), nil
+}
+
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss Int32s) CountUsing(condition func(int32) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
+	}
+
+	return
+}
+
+// Covariance is the population covariance between the elements at the same
+// position in each slice. A positive result means the values tend to increase
+// together, and a negative result means one tends to decrease as the other
+// increases.
+//
+// ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and ErrEmptySlice if there are no elements.
+func (ss Int32s) Covariance(ss2 Int32s) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var sum float64
+	for i, s := range ss {
+		sum += (float64(s) - mean) * (float64(ss2[i]) - mean2)
+	}
+
+	return sum / float64(len(ss)), nil
+}
+
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss Int32s) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
+}
+
+// CumulativeSum returns a new slice where each element is the running total of
+// all of the elements up to and including that position:
+//
+//   Ints{1, 2, 3, 4}.CumulativeSum() // Ints{1, 3, 6, 10}
+//
+func (ss Int32s) CumulativeSum() Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	sums := make(Int32s, len(ss))
+	var sum int32
+	for i, s := range ss {
+		sum += s
+		sums[i] = sum
+	}
+
+	return sums
+}
+
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Int32s) DeleteAt(indexes ...int) (ss2 Int32s) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
+	}
+
+	for i, s := range ss {
+		if !deleted[i] {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Deltas returns a new slice containing the difference between each element and
+// the element before it. It is the inverse of CumulativeSum (without the first
+// element):
+//
+//   Ints{1, 3, 6, 10}.Deltas() // Ints{2, 3, 4}
+//
+// The result has one less element than the input. If there are less than two
+// elements nil is returned.
+//
+// For unsigned types a decreasing value will wrap around.
+func (ss Int32s) Deltas() Int32s {
+	if len(ss) < 2 {
+		return nil
+	}
+
+	deltas := make(Int32s, len(ss)-1)
+	for i := 1; i < len(ss); i++ {
+		deltas[i-1] = ss[i] - ss[i-1]
+	}
+
+	return deltas
+}
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+func (ss Int32s) Diff(against Int32s) (added, removed Int32s) {
+	counts := map[int32]int{}
+	for _, value := range ss {
+		counts[value]++
+	}
+
+	for _, value := range against {
+		if counts[value] > 0 {
+			counts[value]--
+		} else {
+			added = append(added, value)
+		}
+	}
+
+	// Anything remaining in counts has been removed. We iterate ss again to
+	// keep the original order.
+	for _, value := range ss {
+		if counts[value] > 0 {
+			counts[value]--
+			removed = append(removed, value)
+		}
+	}
+
+	return
+}
+
+// Divide returns a new slice where each element of ss has been divided by the
+// element at the same position in ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+//
+// Dividing integers by zero will panic, in the same way as the / operator.
+func (ss Int32s) Divide(ss2 Int32s) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(Int32s, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] / ss2[i]
+	}
+
+	return result
+}
+
+// DotProduct returns the sum of the products of the elements at the same
+// position in each slice.
+//
+// ErrLengthMismatch is returned if the slices do not have the same number
+// of elements. The dot product of two empty slices is zero.
+func (ss Int32s) DotProduct(ss2 Int32s) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, ErrLengthMismatch
+	}
+
+	var product float64
+	for i, s := range ss {
+		product += float64(s) * float64(ss2[i])
+	}
+
+	return product, nil
+}
+
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss Int32s) Drop(n int) (ss2 Int32s) {
+	if n < 0 {
+		n = 0
+	}
+
+	if n >= len(ss) {
+		return nil
+	}
+
+	ss2 = make(Int32s, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
+}
+
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See TakeWhile().
+func (ss Int32s) DropWhile(condition func(int32) bool) (ss2 Int32s) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
+	}
+
+	return
+}
+
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass the original slice on.
+//
+//   cars.Each(func (car *Car) {
+//       fmt.Printf("Car color is: %s\n", car.Color)
+//   })
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//   // Set all car colors to Red.
+//   cars.Each(func (car *Car) {
+//       car.Color = "Red"
+//   })
+//
+func (ss Int32s) Each(fn func(int32)) Int32s {
+	for _, s := range ss {
+		fn(s)
+	}
+
+	return ss
+}
+
+// EachCartesianInt32s calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
+//
+//   EachCartesianInt32s(func(values Int32s) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesianInt32s(fn func(Int32s) bool, slices ...Int32s) {
+	if len(slices) == 0 {
+		return
+	}
+
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	values := make(Int32s, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
+
+	for {
+		if !fn(values) {
+			return
+		}
+
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
+
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
+//
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss Int32s) EachCombination(k int, fn func(Int32s) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	combination := make(Int32s, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
+
+	for {
+		if !fn(combination) {
+			return
+		}
+
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
+}
+
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss Int32s) EachErr(fn func(int32) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss Int32s) EachPermutation(fn func(Int32s) bool) {
+	n := len(ss)
+	if n == 0 {
+		return
+	}
+
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(Int32s, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
+	}
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+}
+
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
+//
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
+//
+func (ss Int32s) EachWithIndex(fn func(int, int32)) Int32s {
+	for i, s := range ss {
+		fn(i, s)
+	}
+
+	return ss
+}
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Int32s) Equals(ss2 Int32s) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Int32s) EqualsUnordered(ss2 Int32s) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[int32]int{}
+	for _, s := range ss {
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
+		}
+
+		counts[s]--
+	}
+
+	return true
+}
+
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// Like Append, the result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss Int32s) Extend(slices ...Int32s) Int32s {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
+
+	// Avoid the allocation.
+	if n == 0 {
+		return ss
+	}
+
+	result := make(Int32s, len(ss), len(ss)+n)
+	copy(result, ss)
+
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// First returns the first element, or zero. Also see FirstOr().
+func (ss Int32s) First() int32 {
+	return ss.FirstOr(0)
+}
+
+// FirstE returns the first element. Unlike First, ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss Int32s) FirstE() (int32, error) {
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss Int32s) FirstOr(defaultValue int32) int32 {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss Int32s) FirstUsing(condition func(int32) bool) (int32, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+// FlattenInt32s returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func FlattenInt32s(slices []Int32s) (ss Int32s) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
+
+// Format returns a new slice with each element formatted with fmt.Sprintf
+// using verb, which may include flags, width and precision:
+//
+//   prices.Format("%.2f")  // ["1.50", "20.00"]
+//   ids.Format("%05d")     // ["00042", "01337"]
+//
+// See Strings for the default format.
+func (ss Int32s) Format(verb string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprintf(verb, s)
+	}
+
+	return result
+}
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Int32s) Frequencies() map[int32]int {
+	frequencies := make(map[int32]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
+
+// Int32sFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func Int32sFromCSVString(s string) (ss Int32s, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
+
+// Int32sFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func Int32sFromChannel(ch <-chan int32) (ss Int32s) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// Int32sFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func Int32sFromJSONString(s string) (ss Int32s, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
+
+// GeometricMean is the nth root of the product of the n elements. It is the
+// appropriate average for rates of change and ratios, such as growth rates.
+//
+// The logarithms of the elements are summed rather than multiplying them so
+// that large slices do not overflow. If any element is zero the result is zero,
+// and if any element is negative the result is NaN. Zero is returned if there
+// are no elements.
+func (ss Int32s) GeometricMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		switch v := float64(s); {
+		case v < 0:
+			return math.NaN()
+
+		case v == 0:
+			return 0
+
+		default:
+			sum += math.Log(v)
+		}
+	}
+
+	return math.Exp(sum / float64(len(ss)))
+}
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Int32s) GroupByString(fn func(int32) string) map[string]Int32s {
+	group := map[string]Int32s{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
+
+// HarmonicMean is the number of elements divided by the sum of their
+// reciprocals. It is the appropriate average for rates, such as speeds over the
+// same distance.
+//
+// If any element is zero the result is zero. Zero is returned if there are no
+// elements.
+func (ss Int32s) HarmonicMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		if s == 0 {
+			return 0
+		}
+
+		sum += 1 / float64(s)
+	}
+
+	return float64(len(ss)) / sum
+}
+
+// Histogram divides the range between the smallest and largest element into
+// bucketCount buckets of equal width and counts the elements in each.
+//
+// Each key describes the bucket, for example "[0, 2.5)". Every bucket is
+// half-open except for the last one, which also includes the largest element.
+// Buckets with no elements are included with a count of zero.
+//
+// NaN and infinite elements are excluded because they do not fall into any
+// bucket.
+//
+// If all of the elements are equal there is a single bucket, such as "[3, 3]".
+// An empty map is returned if there are no elements or bucketCount is less
+// than one.
+func (ss Int32s) Histogram(bucketCount int) map[string]int {
+	histogram := map[string]int{}
+	if bucketCount < 1 {
+		return histogram
+	}
+
+	var values []float64
+	for _, s := range ss {
+		if v := float64(s); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return histogram
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+
+	if min == max {
+		histogram[fmt.Sprintf("[%v, %v]", min, max)] = len(values)
+		return histogram
+	}
+
+	width := (max - min) / float64(bucketCount)
+	counts := make([]int, bucketCount)
+	for _, v := range values {
+		i := int((v - min) / width)
+		if i < 0 {
+			i = 0
+		} else if i >= bucketCount {
+			i = bucketCount - 1
+		}
+
+		counts[i]++
+	}
+
+	for i, count := range counts {
+		lower := min + float64(i)*width
+		if i == bucketCount-1 {
+			histogram[fmt.Sprintf("[%v, %v]", lower, max)] = count
+		} else {
+			histogram[fmt.Sprintf("[%v, %v)", lower, min+float64(i+1)*width)] = count
+		}
+	}
+
+	return histogram
+}
+
+// HistogramWithBounds counts the elements that fall between each of the bounds,
+// which must be sorted in ascending order. There is one more count than there
+// are bounds:
+//
+//   counts[0] is the number of elements less than bounds[0]
+//   counts[i] is the number of elements >= bounds[i-1] and < bounds[i]
+//   counts[len(bounds)] is the number of elements >= the last bound
+//
+// The result is undefined if the bounds are not sorted.
+func (ss Int32s) HistogramWithBounds(bounds Float64s) []int {
+	counts := make([]int, len(bounds)+1)
+	for _, s := range ss {
+		v := float64(s)
+		i := sort.Search(len(bounds), func(i int) bool {
+			return bounds[i] > v
+		})
+		counts[i]++
+	}
+
+	return counts
+}
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss Int32s) IndexOf(lookingFor int32) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss Int32s) Insert(index int, values ...int32) Int32s {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(Int32s, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
+
+// InsertSorted returns a new slice with value inserted into a slice that is
+// already sorted in ascending order, so that the result is also sorted. If
+// value already exists it is inserted after the existing elements.
+//
+// The result is undefined if the slice is not sorted.
+func (ss Int32s) InsertSorted(value int32) Int32s {
+	index := sort.Search(len(ss), func(i int) bool {
+		return ss[i] > value
+	})
+
+	result := make(Int32s, len(ss)+1)
+	copy(result, ss[:index])
+	result[index] = value
+	copy(result[index+1:], ss[index:])
+
+	return result
+}
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Int32s) Interleave(ss2 Int32s) (ss3 Int32s) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+func (ss Int32s) Intersect(ss2 Int32s) (intersect Int32s) {
+	lookup := map[int32]struct{}{}
+	for _, value := range ss2 {
+		lookup[value] = struct{}{}
+	}
+
+	for _, value := range ss {
+		if _, ok := lookup[value]; ok {
+			intersect = append(intersect, value)
+
+			// Make sure the same element is not added again.
+			delete(lookup, value)
+		}
+	}
+
+	return
+}
+
+// JoinFormatted returns a string from joining each of the elements after they
+// have been formatted with the fmt verb provided:
+//
+//   Float64s{1.5, 2.25}.JoinFormatted(", ", "%.1f") // "1.5, 2.2"
+//
+func (ss Int32s) JoinFormatted(glue, verb string) string {
+	var sb strings.Builder
+	for i, element := range ss {
+		if i > 0 {
+			sb.WriteString(glue)
+		}
+
+		fmt.Fprintf(&sb, verb, element)
+	}
+
+	return sb.String()
+}
+
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Int32s) JSONBytes() []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return data
+}
+
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+// See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Int32s) JSONBytesIndent(prefix, indent string) []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return data
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Int32s) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return string(data)
+}
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Int32s) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Int32s) KeyByString(fn func(int32) string) map[string]int32 {
+	m := make(map[string]int32, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
+
+// Largest returns the n largest elements in descending order. If the slice has
+// less than n elements then all elements are returned. If n < 1 it will return
+// nil.
+//
+// Unlike Sort().Reverse().Top(n) it does not sort the whole slice. Only the n
+// largest elements seen so far are kept, which is much faster when n is small
+// compared to the number of elements.
+//
+// This is not called Top because Top() already returns the first n elements
+// by position, regardless of their value.
+func (ss Int32s) Largest(n int) (largest Int32s) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(largest) < n {
+			largest = append(largest, s)
+		} else if !(largest[n-1] < s) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the smallest that will be dropped.
+		i := sort.Search(len(largest)-1, func(i int) bool {
+			return largest[i] < s
+		})
+		copy(largest[i+1:], largest[i:len(largest)-1])
+		largest[i] = s
+	}
+
+	return
+}
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss Int32s) Last() int32 {
+	return ss.LastOr(0)
+}
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss Int32s) LastIndexOf(lookingFor int32) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastE returns the last element. Unlike Last, ErrEmptySlice is returned
+// if there are no elements so that a zero value can be distinguished from an
+// empty slice.
+func (ss Int32s) LastE() (int32, error) {
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	return ss[len(ss)-1], nil
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss Int32s) LastOr(defaultValue int32) int32 {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss Int32s) LastUsing(condition func(int32) bool) (int32, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return 0, false
+}
+
+// Len returns the number of elements.
+func (ss Int32s) Len() int {
+	return len(ss)
+}
+
+// Int32sLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type Int32sLazy struct {
+	iterate func(fn func(int32) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss Int32s) Lazy() Int32sLazy {
+	return Int32sLazy{
+		iterate: func(fn func(int32) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l Int32sLazy) Select(condition func(int32) bool) Int32sLazy {
+	return Int32sLazy{
+		iterate: func(fn func(int32) bool) {
+			l.iterate(func(s int32) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l Int32sLazy) Unselect(condition func(int32) bool) Int32sLazy {
+	return Int32sLazy{
+		iterate: func(fn func(int32) bool) {
+			l.iterate(func(s int32) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l Int32sLazy) Transform(transform func(int32) int32) Int32sLazy {
+	return Int32sLazy{
+		iterate: func(fn func(int32) bool) {
+			l.iterate(func(s int32) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l Int32sLazy) Top(n int) Int32sLazy {
+	return Int32sLazy{
+		iterate: func(fn func(int32) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s int32) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l Int32sLazy) Collect() (ss Int32s) {
+	l.iterate(func(s int32) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Each element is encoded
+// with a fixed number of bytes, which is much more compact than JSON. This is
+// also used by encoding/gob.
+//
+// Unlike JSONString, a nil slice and an empty slice are encoded differently so
+// that UnmarshalBinary can restore either one.
+func (ss Int32s) MarshalBinary() ([]byte, error) {
+	return util.MarshalBinary(reflect.ValueOf(ss))
+}
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss Int32s) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]int32(ss))
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss Int32s) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []int32{}, nil
+	}
+
+	return []int32(ss), nil
+}
+
+// Max is the maximum value, or zero.
+func (ss Int32s) Max() (max int32) {
+	if len(ss) == 0 {
+		return
+	}
+
+	max = ss[0]
+	for _, s := range ss {
+		if s > max {
+			max = s
+		}
+	}
+
+	return
+}
+
+// MaxE is the maximum value. Unlike Max, ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss Int32s) MaxE() (int32, error) {
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	return ss.Max(), nil
+}
+
+// Median returns the value separating the higher half from the lower half of a
+// data sample. When there is an even number of elements the mean of the two
+// middle values is used. The input slice is not modified.
+//
+// For integer types the mean of the two middle values is rounded toward zero,
+// so Ints{1, 2}.Median() is 1. It is calculated in a way that cannot overflow.
+//
+// Zero is returned if there are no elements in the slice.
+func (ss Int32s) Median() int32 {
+	l := len(ss)
+
+	switch {
+	case l == 0:
+		return 0
+
+	case l == 1:
+		return ss[0]
+	}
+
+	sorted := ss.Sort()
+
+	if l%2 != 0 {
+		return sorted[l/2]
+	}
+
+	// Each case avoids an overflow and rounds integers toward zero, the same
+	// as integer division.
+	a, b := sorted[l/2-1], sorted[l/2]
+	switch {
+	case a >= 0:
+		return a + (b-a)/2
+
+	case b < 0:
+		return b - (b-a)/2
+	}
+
+	return (a + b) / 2
+}
+
+// MergeSorted merges two slices that are already sorted in ascending order into
+// a new sorted slice in O(n+m), without needing to sort again. Duplicate
+// elements are retained. See MergeSortedUnique.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss Int32s) MergeSorted(ss2 Int32s) (merged Int32s) {
+	if len(ss)+len(ss2) == 0 {
+		return nil
+	}
+
+	merged = make(Int32s, 0, len(ss)+len(ss2))
+	i, j := 0, 0
+	for i < len(ss) && j < len(ss2) {
+		if ss2[j] < ss[i] {
+			merged = append(merged, ss2[j])
+			j++
+		} else {
+			merged = append(merged, ss[i])
+			i++
+		}
+	}
+
+	merged = append(merged, ss[i:]...)
+
+	return append(merged, ss2[j:]...)
+}
+
+// MergeSortedUnique works the same as MergeSorted, except that each value will
+// only appear once in the result, even if it appears more than once in either
+// slice.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss Int32s) MergeSortedUnique(ss2 Int32s) (merged Int32s) {
+	add := func(value int32) {
+		if len(merged) == 0 || merged[len(merged)-1] != value {
+			merged = append(merged, value)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ss) || j < len(ss2) {
+		if i == len(ss) || (j < len(ss2) && ss2[j] < ss[i]) {
+			add(ss2[j])
+			j++
+		} else {
+			add(ss[i])
+			i++
+		}
+	}
+
+	return
+}
+
+// Min is the minimum value, or zero.
+func (ss Int32s) Min() (min int32) {
+	if len(ss) == 0 {
+		return
+	}
+
+	min = ss[0]
+	for _, s := range ss {
+		if s < min {
+			min = s
+		}
+	}
+
+	return
+}
+
+// MinE is the minimum value. Unlike Min, ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss Int32s) MinE() (int32, error) {
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	return ss.Min(), nil
+}
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss Int32s) Mode() (mode Int32s) {
+	counts := map[int32]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
+
+// MovingAverage returns the simple moving average of each window of size
+// consecutive elements. The result will have len(ss)-window+1 elements.
+//
+// It keeps a running total so each element is only visited twice, regardless
+// of the window size.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss Int32s) MovingAverage(window int) Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	var sum float64
+	for _, s := range ss[:window] {
+		sum += float64(s)
+	}
+
+	averages := make(Float64s, len(ss)-window+1)
+	averages[0] = sum / float64(window)
+
+	for i := window; i < len(ss); i++ {
+		sum += float64(ss[i]) - float64(ss[i-window])
+		averages[i-window+1] = sum / float64(window)
+	}
+
+	return averages
+}
+
+// Multiply returns a new slice where each element is the product of the
+// elements at the same position in ss and ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss Int32s) Multiply(ss2 Int32s) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(Int32s, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] * ss2[i]
+	}
+
+	return result
+}
+
+// MultiplyScalar returns a new slice with each element multiplied by value. It
+// can be used to scale all of the elements.
+func (ss Int32s) MultiplyScalar(value int32) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Int32s, len(ss))
+	for i, s := range ss {
+		result[i] = s * value
+	}
+
+	return result
+}
+
+// Norm returns the Euclidean norm (also known as the magnitude or L2 norm) of
+// the elements, treating the slice as a vector. Zero is returned if there are
+// no elements.
+func (ss Int32s) Norm() float64 {
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s) * float64(s)
+	}
+
+	return math.Sqrt(sum)
+}
+
+// Normalize returns a new slice where each element has been rescaled to be
+// between 0 and 1 (min-max scaling). The smallest element becomes 0 and the
+// largest element becomes 1.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss Int32s) Normalize() Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	min, max := float64(ss.Min()), float64(ss.Max())
+	spread := max - min
+
+	normalized := make(Float64s, len(ss))
+	if spread == 0 {
+		return normalized
+	}
+
+	for i, s := range ss {
+		normalized[i] = (float64(s) - min) / spread
+	}
+
+	return normalized
+}
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss Int32s) OrderBy(less ...func(a, b int32) bool) Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Int32s, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+// Outliers returns the elements that are more than multiplier times the
+// interquartile range (IQR) below the first quartile or above the third
+// quartile. A multiplier of 1.5 is the conventional choice, and 3 only finds
+// extreme outliers.
+//
+// The elements are returned in their original order. See RemoveOutliers.
+func (ss Int32s) Outliers(multiplier float64) Int32s {
+	q1, q3 := float64(ss.Percentile(25)), float64(ss.Percentile(75))
+	lower, upper := q1-multiplier*(q3-q1), q3+multiplier*(q3-q1)
+
+	return ss.Select(func(s int32) bool {
+		return float64(s) < lower || float64(s) > upper
+	})
+}
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss Int32s) Page(pageNumber, pageSize int) Int32s {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss Int32s) Pairwise() (pairs [][2]int32) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]int32{ss[i-1], ss[i]})
+	}
+
+	return
+}
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss Int32s) Partition(condition func(int32) bool) (matching, rest Int32s) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
+
+// Percentile returns the value below which p percent of the elements fall. p
+// must be between 0 and 100, values outside of this range will be clamped.
+//
+// When the percentile lies between two elements the result is linearly
+// interpolated between them. This is the same method used by Excel's
+// PERCENTILE.INC and NumPy's default percentile.
+//
+//   latencies.Percentile(95)
+//
+// Zero is returned if there are no elements and NaN is returned if p is NaN.
+// The input slice is not modified.
+func (ss Int32s) Percentile(p float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(l-1)
+	lower := int(rank)
+	if lower == l-1 {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss Int32s) Permutations() (permutations []Int32s) {
+	ss.EachPermutation(func(permutation Int32s) bool {
+		p := make(Int32s, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss Int32s) Pop() (last int32, rest Int32s) {
+	n := len(ss)
+	if n == 0 {
+		return 0, nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
+
+// Quantiles returns the n-1 cut points that divide the elements into n groups
+// of equal size. For example, Quantiles(4) returns the quartiles and
+// Quantiles(100) returns the percentiles.
+//
+// Each cut point is linearly interpolated in the same way as Percentile(), but
+// the elements are only sorted once. If there are no elements, or n is less
+// than 2, nil is returned.
+func (ss Int32s) Quantiles(n int) Float64s {
+	l := len(ss)
+	if l == 0 || n < 2 {
+		return nil
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	quantiles := make(Float64s, n-1)
+	for i := range quantiles {
+		rank := float64(i+1) / float64(n) * float64(l-1)
+		lower := int(rank)
+
+		if lower == l-1 {
+			quantiles[i] = sorted[lower]
+		} else {
+			quantiles[i] = sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+		}
+	}
+
+	return quantiles
+}
+
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
+func (ss Int32s) Random(source rand.Source) int32 {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 1 {
+		return 0
+	}
+	if n < 2 {
+		return ss[0]
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	i := rnd.Intn(n)
+	return ss[i]
+}
+
+// RandomInt32s creates a slice of n random values that are greater
+// than or equal to min and less than max. The values are evenly distributed
+// across the whole numbers in that range, which may be as wide as the element
+// type allows.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one, and every value will be min if
+// max is not greater than min.
+func RandomInt32s(n int, min, max int32, source rand.Source) Int32s {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	ss := make(Int32s, n)
+	for i := range ss {
+		ss[i] = min
+	}
+
+	if max <= min {
+		return ss
+	}
+
+	// The width is calculated with uint64 so that it cannot overflow, even for
+	// the full range of an int64. Values at or above limit are rejected so
+	// that the modulo does not favour the smaller values.
+	width := uint64(max) - uint64(min)
+	limit := math.MaxUint64 - math.MaxUint64%width
+
+	rnd := rand.New(source)
+	for i := range ss {
+		v := rnd.Uint64()
+		for v >= limit {
+			v = rnd.Uint64()
+		}
+
+		ss[i] += int32(v % width)
+	}
+
+	return ss
+}
+
+// Int32sRange creates a slice of the values from start up to, but not
+// including, stop, increasing by step each time. A negative step counts down
+// from start to stop instead:
+//
+//   Int32sRange(0, 10, 3)  // [0 3 6 9]
+//   Int32sRange(5, 0, -2)  // [5 3 1]
+//
+// The number of values is calculated with uint64 so that it is exact, even
+// for the full range of an int64. nil is returned if step is zero or moves away
+// from stop.
+func Int32sRange(start, stop, step int32) Int32s {
+	var width, size uint64
+	switch {
+	case step > 0 && stop > start:
+		width, size = uint64(stop)-uint64(start), uint64(step)
+
+	case step < 0 && stop < start:
+		width, size = uint64(start)-uint64(stop), -uint64(step)
+
+	default:
+		return nil
+	}
+
+	n := width / size
+	if width%size != 0 {
+		n++
+	}
+
+	ss := make(Int32s, n)
+	for i := range ss {
+		ss[i] = start + int32(i)*step
+	}
+
+	return ss
+}
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see RankMethod.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss Int32s) Rank(method RankMethod) Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) && ss[indexes[end]] == ss[indexes[start]] {
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case RankMin:
+			rank = float64(start + 1)
+
+		case RankMax:
+			rank = float64(end)
+
+		case RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss Int32s) Reduce(initial int32, fn func(acc, value int32) int32) int32 {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
+
+// RemoveOutliers returns a new slice without the elements that are more than
+// multiplier times the interquartile range (IQR) below the first quartile or
+// above the third quartile. A multiplier of 1.5 is the conventional choice.
+//
+// The remaining elements keep their original order. See Outliers.
+func (ss Int32s) RemoveOutliers(multiplier float64) Int32s {
+	q1, q3 := float64(ss.Percentile(25)), float64(ss.Percentile(75))
+	lower, upper := q1-multiplier*(q3-q1), q3+multiplier*(q3-q1)
+
+	return ss.Select(func(s int32) bool {
+		return float64(s) >= lower && float64(s) <= upper
+	})
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//   ss.Sort().Reverse()
+//
+func (ss Int32s) Reverse() Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]int32, len(ss))
+	for i := 0; i < len(ss); i++ {
+		sorted[i] = ss[len(ss)-i-1]
+	}
+
+	return sorted
+}
+
+// Rolling calls fn for every window of size consecutive elements and returns
+// the results. The window slides one element at a time, so the result will
+// have len(ss)-window+1 elements:
+//
+//   prices.Rolling(3, func (window Float64s) float64 {
+//       return window.Max()
+//   })
+//
+// The window passed to fn shares memory with the input slice. It must not be
+// modified or retained after fn returns.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss Int32s) Rolling(window int, fn func(Int32s) float64) Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	results := make(Float64s, len(ss)-window+1)
+	for i := range results {
+		results[i] = fn(ss[i : i+window : i+window])
+	}
+
+	return results
+}
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss Int32s) Rotate(n int) Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(Int32s, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss Int32s) RotateInPlace(n int) Int32s {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s Int32s) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss Int32s) Sample(n int, source rand.Source) Int32s {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(Int32s, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss Int32s) ReverseInPlace() Int32s {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
+
+// Scan implements sql.Scanner so that an array column can be scanned directly
+// into the slice. It accepts a one-dimensional PostgreSQL array literal, such
+// as {1,2,3}.
+//
+// NULL is scanned as an empty slice. An error is returned if any of the
+// elements are NULL.
+func (ss *Int32s) Scan(src interface{}) error {
+	return util.ScanArrayLiteral(src, reflect.ValueOf(ss))
+}
+
+// SearchSorted uses a binary search to find value in a slice that is already
+// sorted in ascending order (see Sort). If value exists, index is the position
+// of the first occurrence and found is true. Otherwise, index is the position
+// where value would need to be inserted to keep the slice sorted.
+//
+// The result is undefined if the slice is not sorted.
+func (ss Int32s) SearchSorted(value int32) (index int, found bool) {
+	index = sort.Search(len(ss), func(i int) bool {
+		return ss[i] >= value
+	})
+
+	return index, index < len(ss) && ss[index] == value
+}
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss Int32s) Select(condition func(int32) bool) (ss2 Int32s) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Smallest returns the n smallest elements in ascending order. If the slice
+// has less than n elements then all elements are returned. If n < 1 it will
+// return nil.
+//
+// Unlike Sort().Top(n) it does not sort the whole slice. Only the n smallest
+// elements seen so far are kept, which is much faster when n is small compared
+// to the number of elements.
+//
+// This is not called Bottom because Bottom() already returns the last n
+// elements by position, regardless of their value.
+func (ss Int32s) Smallest(n int) (smallest Int32s) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(smallest) < n {
+			smallest = append(smallest, s)
+		} else if !(s < smallest[n-1]) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the largest that will be dropped.
+		i := sort.Search(len(smallest)-1, func(i int) bool {
+			return s < smallest[i]
+		})
+		copy(smallest[i+1:], smallest[i:len(smallest)-1])
+		smallest[i] = s
+	}
+
+	return
+}
+
+// Sort works similar to sort.Int32s(). However, unlike sort.Int32s the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// See Reverse() and AreSorted().
+func (ss Int32s) Sort() Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]int32, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+// SortInPlace works the same as Sort, except that the elements are sorted in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Sort if you need to keep the original.
+func (ss Int32s) SortInPlace() Int32s {
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss Int32s) SortStableUsing(less func(a, b int32) bool) Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Int32s, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss Int32s) SortUsing(less func(a, b int32) bool) Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Int32s, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// Int32sSorted keeps its elements sorted in ascending order as they are
+// inserted. This allows Contains, Index and RangeBetween to use a binary
+// search rather than scanning every element.
+//
+// The zero value is an empty sorted slice that is ready to use.
+type Int32sSorted struct {
+	ss Int32s
+}
+
+// NewInt32sSorted creates a sorted slice containing each of the values.
+// The values are copied so they are not modified.
+func NewInt32sSorted(values ...int32) *Int32sSorted {
+	ss := make(Int32s, len(values))
+	copy(ss, values)
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return &Int32sSorted{ss: ss}
+}
+
+// search returns the index of the first element that is not less than value.
+func (s *Int32sSorted) search(value int32) int {
+	return sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] >= value
+	})
+}
+
+// Insert adds each of the values in their sorted position. Each insert is
+// O(log n) to find the position and O(n) to make room for the value.
+func (s *Int32sSorted) Insert(values ...int32) *Int32sSorted {
+	for _, value := range values {
+		i := s.search(value)
+		s.ss = append(s.ss, 0)
+		copy(s.ss[i+1:], s.ss[i:])
+		s.ss[i] = value
+	}
+
+	return s
+}
+
+// Remove deletes the first occurrence of value and returns true. If value does
+// not exist false is returned.
+func (s *Int32sSorted) Remove(value int32) bool {
+	i := s.Index(value)
+	if i == -1 {
+		return false
+	}
+
+	s.ss = append(s.ss[:i], s.ss[i+1:]...)
+
+	return true
+}
+
+// Contains returns true if the value exists.
+func (s *Int32sSorted) Contains(value int32) bool {
+	return s.Index(value) != -1
+}
+
+// Index returns the position of the first occurrence of value, or -1 if it
+// does not exist.
+func (s *Int32sSorted) Index(value int32) int {
+	i := s.search(value)
+	if i < len(s.ss) && s.ss[i] == value {
+		return i
+	}
+
+	return -1
+}
+
+// RangeBetween returns a new slice of the elements that are greater than or
+// equal to min and less than or equal to max, in ascending order. If min is
+// greater than max nil is returned.
+func (s *Int32sSorted) RangeBetween(min, max int32) Int32s {
+	start := s.search(min)
+	end := sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] > max
+	})
+
+	if start >= end {
+		return nil
+	}
+
+	result := make(Int32s, end-start)
+	copy(result, s.ss[start:end])
+
+	return result
+}
+
+// Len returns the number of elements.
+func (s *Int32sSorted) Len() int {
+	return len(s.ss)
+}
+
+// Slice returns a copy of the elements in ascending order. An empty sorted
+// slice returns nil.
+func (s *Int32sSorted) Slice() Int32s {
+	if len(s.ss) == 0 {
+		return nil
+	}
+
+	result := make(Int32s, len(s.ss))
+	copy(result, s.ss)
+
+	return result
+}
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Int32s) Splice(start, deleteCount int, values ...int32) Int32s {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(Int32s, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss Int32s) SplitAt(index int) (left, right Int32s) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
+
+// StandardDeviation is the population standard deviation of the elements. It
+// is the square root of Variance(). Zero is returned if there are no elements.
+func (ss Int32s) StandardDeviation() float64 {
+	return math.Sqrt(ss.Variance())
+}
+
+// Strings returns a new slice with each element formatted in the default
+// format, the same as fmt.Sprint. Element types that have a String method,
+// such as time.Duration, use that method. See Format to control the format.
+func (ss Int32s) Strings() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprint(s)
+	}
+
+	return result
+}
+
+// Subtract returns a new slice where each element of ss2 has been subtracted
+// from the element at the same position in ss.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss Int32s) Subtract(ss2 Int32s) Int32s {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(Int32s, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] - ss2[i]
+	}
+
+	return result
+}
+
+// Sum is the sum of all of the elements.
+func (ss Int32s) Sum() (sum int32) {
+	for _, s := range ss {
+		sum += s
+	}
+
+	return
+}
+
+// SumChecked is the sum of all of the elements. Unlike Sum, which silently
+// wraps around, ErrOverflow is returned if the sum cannot be represented by
+// the element type.
+//
+// Overflow is checked after each addition, so an error may be returned even if
+// later elements would have brought the sum back into range.
+func (ss Int32s) SumChecked() (int32, error) {
+	var sum int32
+	for _, s := range ss {
+		next := sum + s
+		if (s > 0 && next < sum) || (s < 0 && next > sum) {
+			return 0, ErrOverflow
+		}
+
+		sum = next
+	}
+
+	return sum, nil
+}
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss Int32s) Shift() (first int32, rest Int32s) {
+	if len(ss) == 0 {
+		return 0, nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss Int32s) Swap(i, j int) Int32s {
+	swapped := make(Int32s, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss Int32s) SwapInPlace(i, j int) Int32s {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
+
+// Int32sSet is an unordered collection of unique elements backed by a map.
+// Unlike the slice based Contains, checking membership is O(1), which makes it
+// a better fit for membership-heavy workloads.
+//
+// A nil set can be read from but not added to. Use NewInt32sSet or convert
+// the result of ToSet to create one.
+type Int32sSet map[int32]struct{}
+
+// NewInt32sSet creates a set containing each of the values. Duplicate values
+// are only stored once.
+func NewInt32sSet(values ...int32) Int32sSet {
+	s := make(Int32sSet, len(values))
+
+	return s.Add(values...)
+}
+
+// Set returns a set of the unique elements in the slice.
+func (ss Int32s) Set() Int32sSet {
+	return NewInt32sSet(ss...)
+}
+
+// Add will insert each of the values into the set. The set is modified in place
+// and returned for chaining.
+func (s Int32sSet) Add(values ...int32) Int32sSet {
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+
+	return s
+}
+
+// Remove will delete each of the values from the set. Values that are not in
+// the set are ignored. The set is modified in place and returned for chaining.
+func (s Int32sSet) Remove(values ...int32) Int32sSet {
+	for _, value := range values {
+		delete(s, value)
+	}
+
+	return s
+}
+
+// Contains returns true if the value is in the set.
+func (s Int32sSet) Contains(value int32) bool {
+	_, ok := s[value]
+
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Int32sSet) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing the elements that are in either set.
+func (s Int32sSet) Union(s2 Int32sSet) Int32sSet {
+	union := make(Int32sSet, len(s)+len(s2))
+	for value := range s {
+		union[value] = struct{}{}
+	}
+
+	for value := range s2 {
+		union[value] = struct{}{}
+	}
+
+	return union
+}
+
+// Intersect returns a new set containing the elements that are in both sets.
+func (s Int32sSet) Intersect(s2 Int32sSet) Int32sSet {
+	// Iterate over the smaller set.
+	if len(s2) < len(s) {
+		s, s2 = s2, s
+	}
+
+	intersect := make(Int32sSet)
+	for value := range s {
+		if _, ok := s2[value]; ok {
+			intersect[value] = struct{}{}
+		}
+	}
+
+	return intersect
+}
+
+// Diff returns a new set containing the elements that are in s but not in s2.
+//
+// This is different from the slice Diff, which returns both the added and
+// removed elements.
+func (s Int32sSet) Diff(s2 Int32sSet) Int32sSet {
+	diff := make(Int32sSet)
+	for value := range s {
+		if _, ok := s2[value]; !ok {
+			diff[value] = struct{}{}
+		}
+	}
+
+	return diff
+}
+
+// Slice returns the elements of the set as a slice in ascending order. An empty
+// set returns nil.
+func (s Int32sSet) Slice() Int32s {
+	if len(s) == 0 {
+		return nil
+	}
+
+	ss := make(Int32s, 0, len(s))
+	for value := range s {
+		ss = append(ss, value)
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
+func (ss Int32s) Shuffle(source rand.Source) Int32s {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 2 {
+		return ss
+	}
+
+	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
+	// the algorithm directly from the go source: src/math/rand/rand.go below,
+	// with some adjustments:
+	shuffled := make([]int32, n)
+	copy(shuffled, ss)
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	util.Shuffle(rnd, n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss Int32s) ShuffleInPlace(source rand.Source) Int32s {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
+
+// Int32sSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A Int32sSync must
+// not be copied after first use.
+type Int32sSync struct {
+	mu sync.RWMutex
+	ss Int32s
+}
+
+// Append adds the values to the end of the slice.
+func (s *Int32sSync) Append(values ...int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *Int32sSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *Int32sSync) Snapshot() Int32s {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(Int32s, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss Int32s) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *Int32sSync) Read(fn func(ss Int32s)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss Int32s) Take(n int) (ss2 Int32s) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(Int32s, n)
+	copy(ss2, ss)
+
+	return
+}
+
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss Int32s) TakeWhile(condition func(int32) bool) (ss2 Int32s) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
+}
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss Int32s) Top(n int) (top Int32s) {
+	for i := 0; i < len(ss) && n > 0; i++ {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss Int32s) ToChannel() <-chan int32 {
+	ch := make(chan int32)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss Int32s) ToChannelCtx(ctx context.Context) <-chan int32 {
+	ch := make(chan int32)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ToFloat64s transforms each element to a float64.
+func (ss Int32s) ToFloat64s(transform func(int32) float64) Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss Int32s) ToFloat64sErr(transform func(int32) (float64, error)) (Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToInts transforms each element to an int.
+func (ss Int32s) ToInts(transform func(int32) int) Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToIntsErr works the same as ToInts, except that transform may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Int32s) ToIntsErr(transform func(int32) (int, error)) (Ints, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Int32s) ToSet() map[int32]struct{} {
+	set := make(map[int32]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
+
+// ToStrings transforms each element to a string.
+func (ss Int32s) ToStrings(transform func(int32) string) Strings {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Strings, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss Int32s) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
+
+// Transform will return a new slice where each element has been transformed.
+// The number of element returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+func (ss Int32s) Transform(fn func(int32) int32) (ss2 Int32s) {
+	if ss == nil {
+		return nil
+	}
+
+	ss2 = make([]int32, len(ss))
+	for i, s := range ss {
+		ss2[i] = fn(s)
+	}
+
+	return
+}
+
+// TrimmedMean is the average of the elements after discarding the smallest and
+// largest fraction of them. For example, a fraction of 0.1 ignores the lowest
+// 10% and highest 10% of the elements. This is more robust against outliers
+// than Average.
+//
+// The number of elements discarded from each end is rounded down. If the
+// fraction would discard every element only the middle one or two elements are
+// used, which is the same as the Median. A fraction of zero or less is the same
+// as Average. Zero is returned if there are no elements.
+func (ss Int32s) TrimmedMean(fraction float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	trim := 0
+	if fraction > 0 {
+		trim = int(fraction * float64(l))
+	}
+
+	if 2*trim >= l {
+		trim = (l - 1) / 2
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, s := range sorted[trim : l-trim] {
+		sum += s
+	}
+
+	return sum / float64(l-2*trim)
+}
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+func (ss Int32s) Union(ss2 Int32s) (union Int32s) {
+	seen := map[int32]struct{}{}
+
+	for _, slice := range []Int32s{ss, ss2} {
+		for _, value := range slice {
+			if _, ok := seen[value]; !ok {
+				seen[value] = struct{}{}
+				union = append(union, value)
+			}
+		}
+	}
+
+	return
+}
+
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Int32s) TransformErr(fn func(int32) (int32, error)) (Int32s, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]int32, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss Int32s) TransformInPlace(fn func(int32) int32) Int32s {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss Int32s) TransformParallel(fn func(int32) int32, workers int) (ss2 Int32s) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]int32, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See AreUnique().
+func (ss Int32s) Unique() Int32s {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[int32]struct{}{}
+	uniqueValues := Int32s{}
+
+	for _, value := range ss {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes data that
+// was created with MarshalBinary.
+func (ss *Int32s) UnmarshalBinary(data []byte) error {
+	return util.UnmarshalBinary(data, reflect.ValueOf(ss))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *Int32s) UnmarshalJSON(data []byte) error {
+	var elements []int32
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []int32{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *Int32s) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []int32
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []int32{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// Unselect works the same as Select, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss Int32s) Unselect(condition func(int32) bool) (ss2 Int32s) {
+	for _, s := range ss {
+		if !condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Value implements driver.Valuer so that the slice can be used as a query
+// argument for an array column. It is encoded as a PostgreSQL array literal,
+// such as {1,2,3}.
+//
+// A nil slice is encoded as an empty array rather than NULL.
+func (ss Int32s) Value() (driver.Value, error) {
+	return util.ArrayLiteral(reflect.ValueOf(ss)), nil
+}
+
+// Variance is the population variance of the elements, that is, the average of
+// the squared differences from the mean. Zero is returned if there are no
+// elements.
+//
+// If the slice is only a sample of a larger population you may want to
+// multiply the result by n/(n-1) to get the unbiased sample variance.
+func (ss Int32s) Variance() float64 {
+	l := float64(len(ss))
+	if l == 0 {
+		return 0
+	}
+
+	mean := float64(ss.Average())
+
+	var sum float64
+	for _, s := range ss {
+		diff := float64(s) - mean
+		sum += diff * diff
+	}
+
+	return sum / l
+}
+
+// WeightedAverage is the sum of each element multiplied by the weight at the
+// same position, divided by the sum of the weights.
+//
+// ErrLengthMismatch is returned if there is not exactly one weight for each
+// element, ErrEmptySlice if there are no elements and ErrZeroWeights if
+// the weights add up to zero.
+func (ss Int32s) WeightedAverage(weights Float64s) (float64, error) {
+	if len(ss) != len(weights) {
+		return 0, ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	var sum, totalWeight float64
+	for i, s := range ss {
+		sum += float64(s) * weights[i]
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return 0, ErrZeroWeights
+	}
+
+	return sum / totalWeight, nil
+}
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss Int32s) Windows(size int) (windows []Int32s) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
+
+// Int32sPair is a pair of elements from the same position of two slices.
+type Int32sPair struct {
+	First, Second int32
+}
+
+// Int32sPairs is created by Zip.
+type Int32sPairs []Int32sPair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss Int32s) Zip(ss2 Int32s) (pairs Int32sPairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, Int32sPair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs Int32sPairs) Unzip() (ss, ss2 Int32s) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss Int32s) ZipWith(ss2 Int32s, fn func(a, b int32) int32) (ss3 Int32s) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
+
+// ZScore returns a new slice containing the standard score of each element.
+// That is, the number of standard deviations (see StandardDeviation) that the
+// element is above or below the mean.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss Int32s) ZScore() Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	mean := float64(ss.Average())
+	stddev := ss.StandardDeviation()
+
+	scores := make(Float64s, len(ss))
+	if stddev == 0 {
+		return scores
+	}
+
+	for i, s := range ss {
+		scores[i] = (float64(s) - mean) / stddev
+	}
+
+	return scores
+}
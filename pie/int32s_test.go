@@ -0,0 +1,40 @@
+package pie
+
+import (
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the functions for Int32s are
+// generated. The more extensive tests for these functions are in ints_test.go
+
+func TestInt32s_Sum(t *testing.T) {
+	assert.Equal(t, int32(0), Int32s(nil).Sum())
+	assert.Equal(t, int32(6), Int32s{3, 1, 2}.Sum())
+}
+
+func TestInt32s_MinAndMax(t *testing.T) {
+	assert.Equal(t, int32(-1), Int32s{3, -1, 2}.Min())
+	assert.Equal(t, int32(3), Int32s{3, -1, 2}.Max())
+}
+
+func TestInt32s_Sort(t *testing.T) {
+	assert.Equal(t, Int32s{-1, 2, 3}, Int32s{3, -1, 2}.Sort())
+}
+
+func TestInt32s_Median(t *testing.T) {
+	assert.Equal(t, int32(2), Int32s{3, -1, 2}.Median())
+}
+
+func TestInt32s_MarshalBinary(t *testing.T) {
+	ss := Int32s{0, 1, -1, -32768}
+
+	data, err := ss.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, 17, len(data))
+
+	var decoded Int32s
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, ss, decoded)
+}
@@ -0,0 +1,4 @@
+package pie
+
+//go:generate pie Int64s.*
+type Int64s []int64
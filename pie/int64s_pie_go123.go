@@ -0,0 +1,48 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"iter"
+)
+
+// CollectInt64s creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// CollectInt64s is only available when compiling with Go 1.23 or newer.
+func CollectInt64s(seq iter.Seq[int64]) (ss Int64s) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss Int64s) Iter() iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss Int64s) IterIndexed() iter.Seq2[int, int64] {
+	return func(yield func(int, int64) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
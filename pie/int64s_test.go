@@ -0,0 +1,72 @@
+package pie
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the functions for Int64s are generated
+// and do not lose precision. The more extensive tests for these functions are
+// in ints_test.go
+
+func TestInt64s_Sum(t *testing.T) {
+	assert.Equal(t, int64(0), Int64s(nil).Sum())
+	assert.Equal(t, int64(math.MaxInt64), Int64s{math.MaxInt64 - 1, 1}.Sum())
+}
+
+func TestInt64s_MinAndMax(t *testing.T) {
+	ss := Int64s{9007199254740993, 9007199254740992, 9007199254740994}
+	assert.Equal(t, int64(9007199254740992), ss.Min())
+	assert.Equal(t, int64(9007199254740994), ss.Max())
+}
+
+func TestInt64s_Contains(t *testing.T) {
+	assert.True(t, Int64s{9007199254740993}.Contains(9007199254740993))
+	assert.False(t, Int64s{9007199254740993}.Contains(9007199254740992))
+}
+
+func TestInt64s_Sort(t *testing.T) {
+	assert.Equal(t, Int64s{-3, 1, 9007199254740993}, Int64s{9007199254740993, -3, 1}.Sort())
+}
+
+func TestInt64s_Abs(t *testing.T) {
+	ss := Int64s{math.MaxInt64 - 1, -(math.MaxInt64 - 1), math.MinInt64, 9007199254740993}
+	assert.Equal(t, Int64s{math.MaxInt64 - 1, math.MaxInt64 - 1, math.MinInt64, 9007199254740993}, ss.Abs())
+	assert.Equal(t, int64(-(math.MaxInt64 - 1)), ss[1])
+}
+
+func TestInt64s_Median(t *testing.T) {
+	assert.Equal(t, int64(math.MaxInt64-1), Int64s{math.MaxInt64, math.MaxInt64 - 2}.Median())
+	assert.Equal(t, int64(math.MaxInt64-1), Int64s{math.MaxInt64, math.MaxInt64 - 1}.Median())
+	assert.Equal(t, int64(math.MinInt64+1), Int64s{math.MinInt64, math.MinInt64 + 2}.Median())
+	assert.Equal(t, int64(math.MinInt64+2), Int64s{math.MinInt64, math.MinInt64 + 3}.Median())
+	assert.Equal(t, int64(0), Int64s{math.MinInt64, math.MaxInt64}.Median())
+}
+
+func TestInt64sRange(t *testing.T) {
+	assert.Equal(t, Int64s{math.MaxInt64 - 3, math.MaxInt64 - 2, math.MaxInt64 - 1},
+		Int64sRange(math.MaxInt64-3, math.MaxInt64, 1))
+	assert.Equal(t, Int64s{math.MinInt64 + 2, math.MinInt64 + 1},
+		Int64sRange(math.MinInt64+2, math.MinInt64, -1))
+	assert.Equal(t, Int64s{math.MinInt64, -1, math.MaxInt64 - 1},
+		Int64sRange(math.MinInt64, math.MaxInt64, math.MaxInt64))
+	assert.Equal(t, Int64s{9007199254740993, 9007199254740994}, Int64sRange(9007199254740993, 9007199254740995, 1))
+}
+
+func TestInt64s_Unique(t *testing.T) {
+	assert.Equal(t, Int64s{2, 1}, Int64s{2, 1, 2}.Unique())
+}
+
+func TestRandomInt64s(t *testing.T) {
+	ss := RandomInt64s(1000, math.MinInt64, math.MaxInt64, rand.NewSource(1))
+	assert.Equal(t, ss, RandomInt64s(1000, math.MinInt64, math.MaxInt64, rand.NewSource(1)))
+	assert.True(t, ss.Min() < math.MinInt64/2)
+	assert.True(t, ss.Max() > math.MaxInt64/2)
+	assert.Len(t, ss.Unique(), 1000)
+
+	assert.Equal(t, Int64s{-3, -2, -1}, RandomInt64s(1000, -3, 0, rand.NewSource(1)).Unique().Sort())
+	assert.Equal(t, Int64s{5, 5}, RandomInt64s(2, 5, 5, nil))
+}
@@ -1,6 +1,7 @@
 package pie
 
-//go:generate pie Ints.*
+//go:generate pie -tests Ints.*
+//pie:sample {3, -1, 2, 3}
 type Ints []int
 
 //go:generate pie myInts.Sum.Average
@@ -0,0 +1,110 @@
+//go:build go1.18
+// +build go1.18
+
+package pie
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func checkIntsGenerated(t *testing.T, ss Ints) {
+	equal := func(a, b Ints) bool {
+		if len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !reflect.DeepEqual(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	original := make(Ints, len(ss))
+	copy(original, ss)
+
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+		{"Len", func() bool {
+			return ss.Len() == len(ss)
+		}},
+		{"Reverse", func() bool {
+			return equal(ss.Reverse().Reverse(), ss)
+		}},
+		{"FirstAndLast", func() bool {
+			return func() bool {
+				if len(ss) == 0 {
+					return reflect.DeepEqual(ss.First(), 0) &&
+						reflect.DeepEqual(ss.Last(), 0)
+				}
+
+				return reflect.DeepEqual(ss.First(), ss[0]) && reflect.DeepEqual(ss.Last(), ss[len(ss)-1])
+			}()
+		}},
+		{"Contains", func() bool {
+			return ss.All(ss.Contains)
+		}},
+		{"TopAndBottom", func() bool {
+			return equal(ss.Top(len(ss)), ss) && equal(ss.Bottom(len(ss)), ss.Reverse())
+		}},
+		{"SelectAndUnselect", func() bool {
+			return equal(ss.Select(func(int) bool { return true }), ss) &&
+				len(ss.Unselect(func(int) bool { return true })) == 0
+		}},
+		{"Shuffle", func() bool {
+			return len(ss.Shuffle(rand.NewSource(0))) == len(ss)
+		}},
+		{"JSON", func() bool {
+			return func() bool {
+				ss2, err := IntsFromJSONString(ss.JSONString())
+				return err == nil && ss2.JSONString() == ss.JSONString()
+			}()
+		}},
+		{"Unique", func() bool {
+			return ss.Unique().AreUnique()
+		}},
+		{"Sort", func() bool {
+			return len(ss.Sort()) == len(ss) && ss.Sort().AreSorted()
+		}},
+		{"MinAndMax", func() bool {
+			return ss.All(func(s int) bool { return ss.Min() <= s && s <= ss.Max() })
+		}},
+	}
+
+	for _, test := range tests {
+		if !test.check() {
+			t.Errorf("%s failed for %v", test.name, ss)
+		}
+
+		if !equal(ss, original) {
+			t.Fatalf("%s modified the slice", test.name)
+		}
+	}
+}
+
+func TestInts_Generated(t *testing.T) {
+	for _, ss := range []Ints{nil, {}, {3, -1, 2, 3}} {
+		checkIntsGenerated(t, ss)
+	}
+}
+
+func FuzzInts_Generated(f *testing.F) {
+	for _, ss := range []Ints{nil, {}, {3, -1, 2, 3}} {
+		f.Add([]byte(ss.JSONString()))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ss Ints
+		if ss.UnmarshalJSON(data) != nil {
+			return
+		}
+
+		checkIntsGenerated(t, ss)
+	})
+}
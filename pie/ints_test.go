@@ -2,6 +2,7 @@ package pie
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 
@@ -354,12 +355,12 @@ var intsUniqueTests = []struct {
 	},
 	{
 		Ints{12, -13, 12},
-		Ints{-13, 12},
+		Ints{12, -13},
 		false,
 	},
 	{
 		Ints{12, -13, 14e6, 789},
-		Ints{-13, 12, 789, 14e6},
+		Ints{12, -13, 14e6, 789},
 		true,
 	},
 }
@@ -368,10 +369,7 @@ func TestInts_Unique(t *testing.T) {
 	for _, test := range intsUniqueTests {
 		t.Run("", func(t *testing.T) {
 			defer assertImmutableInts(t, &test.ss)()
-
-			// We have to sort the unique slice because it is always returned in
-			// random order.
-			assert.Equal(t, test.unique, test.ss.Unique().Sort())
+			assert.Equal(t, test.unique, test.ss.Unique())
 		})
 	}
 }
@@ -649,11 +647,30 @@ func TestInts_Bottom(t *testing.T) {
 	}
 }
 
+var intsMedianTests = []struct {
+	ss       Ints
+	expected int
+}{
+	{nil, 0},
+	{Ints{}, 0},
+	{Ints{12}, 12},
+	{Ints{12, 4}, 8},
+	{Ints{2, 12, 4}, 4},
+	{Ints{7, 1, 5, 3}, 4},
+	{Ints{3, 3, 1, 3}, 3},
+	{Ints{1, 2}, 1},
+	{Ints{2, 1, 4, 7}, 3},
+	{Ints{-1, -2}, -1},
+	{Ints{-3, 2}, 0},
+}
+
 func TestInts_Median(t *testing.T) {
-	assert.Equal(t, 0, Ints{}.Median())
-	assert.Equal(t, 12, Ints{12}.Median())
-	assert.Equal(t, 8, Ints{12, 4}.Median())
-	assert.Equal(t, 4, Ints{2, 12, 4}.Median())
+	for _, test := range intsMedianTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Median())
+		})
+	}
 }
 
 func TestInts_Each(t *testing.T) {
@@ -723,3 +740,726 @@ func TestInts_Abs(t *testing.T) {
 	assert.Equal(t, Ints{689845, 688969, 220373, 89437, 308836}, Ints{-689845, -688969, -220373, -89437, 308836}.Abs())
 	assert.Equal(t, Ints{1, 2}, Ints{1, 2}.Abs())
 }
+
+func TestInts_Reduce(t *testing.T) {
+	max := func(acc, value int) int {
+		if value > acc {
+			return value
+		}
+
+		return acc
+	}
+
+	assert.Equal(t, -1, Ints(nil).Reduce(-1, max))
+	assert.Equal(t, 7, Ints{3, 7, 5}.Reduce(-1, max))
+}
+
+var intsVarianceTests = []struct {
+	ss                Ints
+	variance          float64
+	standardDeviation float64
+}{
+	{nil, 0, 0},
+	{Ints{}, 0, 0},
+	{Ints{3}, 0, 0},
+	{Ints{2, 4, 4, 4, 5, 5, 7, 9}, 4, 2},
+	{Ints{1, 2, 3, 4}, 1.25, 1.118033988749895},
+}
+
+func TestInts_Variance(t *testing.T) {
+	for _, test := range intsVarianceTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.variance, test.ss.Variance())
+		})
+	}
+}
+
+func TestInts_StandardDeviation(t *testing.T) {
+	for _, test := range intsVarianceTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.standardDeviation, test.ss.StandardDeviation())
+		})
+	}
+}
+
+var intsSetTests = []struct {
+	ss, ss2          Ints
+	intersect, union Ints
+	added, removed   Ints
+}{
+	{
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	},
+	{
+		Ints{1, 2},
+		nil,
+		nil,
+		Ints{1, 2},
+		nil,
+		Ints{1, 2},
+	},
+	{
+		nil,
+		Ints{1, 2},
+		nil,
+		Ints{1, 2},
+		Ints{1, 2},
+		nil,
+	},
+	{
+		Ints{1, 2, 3},
+		Ints{3, 4, 1},
+		Ints{1, 3},
+		Ints{1, 2, 3, 4},
+		Ints{4},
+		Ints{2},
+	},
+	{
+		Ints{1, 2, 1},
+		Ints{2, 2},
+		Ints{2},
+		Ints{1, 2},
+		Ints{2},
+		Ints{1, 1},
+	},
+}
+
+func TestInts_Intersect(t *testing.T) {
+	for _, test := range intsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.intersect, test.ss.Intersect(test.ss2))
+		})
+	}
+}
+
+func TestInts_Union(t *testing.T) {
+	for _, test := range intsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.union, test.ss.Union(test.ss2))
+		})
+	}
+}
+
+func TestInts_Diff(t *testing.T) {
+	for _, test := range intsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			added, removed := test.ss.Diff(test.ss2)
+			assert.Equal(t, test.added, added)
+			assert.Equal(t, test.removed, removed)
+		})
+	}
+}
+
+func TestInts_GroupByString(t *testing.T) {
+	parity := func(value int) string {
+		if value%2 == 0 {
+			return "even"
+		}
+
+		return "odd"
+	}
+
+	assert.Equal(t, map[string]Ints{}, Ints(nil).GroupByString(parity))
+	assert.Equal(t, map[string]Ints{
+		"even": {2, 4},
+		"odd":  {1, 3, 5},
+	}, Ints{1, 2, 3, 4, 5}.GroupByString(parity))
+}
+
+var intsChunkTests = []struct {
+	ss       Ints
+	size     int
+	expected []Ints
+}{
+	{nil, 2, nil},
+	{Ints{}, 2, nil},
+	{Ints{1, 2, 3, 4}, 0, nil},
+	{Ints{1, 2, 3, 4}, 1, []Ints{{1}, {2}, {3}, {4}}},
+	{Ints{1, 2, 3, 4}, 2, []Ints{{1, 2}, {3, 4}}},
+	{Ints{1, 2, 3, 4}, 3, []Ints{{1, 2, 3}, {4}}},
+	{Ints{1, 2, 3, 4}, 5, []Ints{{1, 2, 3, 4}}},
+}
+
+func TestInts_Chunk(t *testing.T) {
+	for _, test := range intsChunkTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Chunk(test.size))
+		})
+	}
+}
+
+var intsLargestAndSmallestTests = []struct {
+	ss                Ints
+	n                 int
+	largest, smallest Ints
+}{
+	{nil, 2, nil, nil},
+	{Ints{}, 2, nil, nil},
+	{Ints{1, 2}, 0, nil, nil},
+	{Ints{1, 2}, 3, Ints{2, 1}, Ints{1, 2}},
+	{Ints{3, 1, 4, 1, 5, 9, 2, 6}, 1, Ints{9}, Ints{1}},
+	{Ints{3, 1, 4, 1, 5, 9, 2, 6}, 4, Ints{9, 6, 5, 4}, Ints{1, 1, 2, 3}},
+	{Ints{5, 5, 5}, 2, Ints{5, 5}, Ints{5, 5}},
+}
+
+func TestInts_Largest(t *testing.T) {
+	for _, test := range intsLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.largest, test.ss.Largest(test.n))
+		})
+	}
+}
+
+func TestInts_Smallest(t *testing.T) {
+	for _, test := range intsLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.smallest, test.ss.Smallest(test.n))
+		})
+	}
+}
+
+func TestInts_ShuffleNilSource(t *testing.T) {
+	ss := Ints{1, 2, 3, 4}
+	defer assertImmutableInts(t, &ss)()
+
+	assert.Equal(t, ss, ss.Shuffle(nil).Sort())
+	assert.True(t, ss.Contains(ss.Random(nil)))
+}
+
+var intsSampleTests = []struct {
+	ss       Ints
+	n        int
+	source   rand.Source
+	expected Ints
+}{
+	{nil, 2, rand.NewSource(0), nil},
+	{Ints{}, 2, rand.NewSource(0), nil},
+	{Ints{1, 2, 3}, 0, rand.NewSource(0), nil},
+	{Ints{1, 2, 3, 4, 5}, 3, rand.NewSource(1), Ints{2, 5, 1}},
+}
+
+func TestInts_Sample(t *testing.T) {
+	for _, test := range intsSampleTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Sample(test.n, test.source))
+		})
+	}
+}
+
+func TestInts_SampleIsUnique(t *testing.T) {
+	ss := Ints{}
+	for i := 0; i < 1000; i++ {
+		ss = append(ss, i)
+	}
+
+	sample := ss.Sample(100, nil)
+	assert.Equal(t, 100, sample.Len())
+	assert.True(t, sample.AreUnique())
+}
+
+var intsJoinFormattedTests = []struct {
+	ss         Ints
+	glue, verb string
+	expected   string
+}{
+	{nil, ",", "%d", ""},
+	{Ints{}, ",", "%d", ""},
+	{Ints{1}, ",", "%d", "1"},
+	{Ints{1, 22, 333}, ",", "%d", "1,22,333"},
+	{Ints{1, 22, 333}, " ", "%03d", "001 022 333"},
+}
+
+func TestInts_JoinFormatted(t *testing.T) {
+	for _, test := range intsJoinFormattedTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.JoinFormatted(test.glue, test.verb))
+		})
+	}
+}
+
+func TestInts_ToFloat64s(t *testing.T) {
+	half := func(value int) float64 {
+		return float64(value) / 2
+	}
+
+	assert.Equal(t, Float64s(nil), Ints(nil).ToFloat64s(half))
+	assert.Equal(t, Float64s(nil), Ints{}.ToFloat64s(half))
+	assert.Equal(t, Float64s{0.5, 1.5}, Ints{1, 3}.ToFloat64s(half))
+}
+
+func TestInts_ToInts(t *testing.T) {
+	square := func(value int) int {
+		return value * value
+	}
+
+	assert.Equal(t, Ints(nil), Ints(nil).ToInts(square))
+	assert.Equal(t, Ints(nil), Ints{}.ToInts(square))
+	assert.Equal(t, Ints{1, 9}, Ints{1, 3}.ToInts(square))
+}
+
+func TestInts_EachWithIndex(t *testing.T) {
+	var values []string
+	collect := func(i int, value int) {
+		values = append(values, fmt.Sprintf("%d:%v", i, value))
+	}
+
+	values = []string{}
+	Ints{}.EachWithIndex(collect)
+	assert.Equal(t, []string{}, values)
+
+	values = []string{}
+	ss := Ints{3, 5}
+	assert.Equal(t, ss, ss.EachWithIndex(collect))
+	assert.Equal(t, []string{"0:3", "1:5"}, values)
+}
+
+func TestInts_FirstUsing(t *testing.T) {
+	condition := func(value int) bool {
+		return value > 2
+	}
+
+	value, ok := Ints(nil).FirstUsing(condition)
+	assert.Equal(t, 0, value)
+	assert.False(t, ok)
+
+	value, ok = Ints{1, 3, 4, 2}.FirstUsing(condition)
+	assert.Equal(t, 3, value)
+	assert.True(t, ok)
+}
+
+func TestInts_LastUsing(t *testing.T) {
+	condition := func(value int) bool {
+		return value > 2
+	}
+
+	value, ok := Ints(nil).LastUsing(condition)
+	assert.Equal(t, 0, value)
+	assert.False(t, ok)
+
+	value, ok = Ints{1, 3, 4, 2}.LastUsing(condition)
+	assert.Equal(t, 4, value)
+	assert.True(t, ok)
+}
+
+var intsIndexOfTests = []struct {
+	ss                   Ints
+	lookingFor           int
+	indexOf, lastIndexOf int
+}{
+	{nil, 1, -1, -1},
+	{Ints{}, 1, -1, -1},
+	{Ints{1, 2, 1}, 1, 0, 2},
+	{Ints{1, 2, 1}, 2, 1, 1},
+	{Ints{1, 2, 1}, 9, -1, -1},
+}
+
+func TestInts_IndexOf(t *testing.T) {
+	for _, test := range intsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.indexOf, test.ss.IndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestInts_LastIndexOf(t *testing.T) {
+	for _, test := range intsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.lastIndexOf, test.ss.LastIndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestInts_SortUsing(t *testing.T) {
+	descending := func(a, b int) bool {
+		return a > b
+	}
+
+	ss := Ints{1, 3, 2}
+	defer assertImmutableInts(t, &ss)()
+
+	assert.Equal(t, Ints(nil), Ints(nil).SortUsing(descending))
+	assert.Equal(t, Ints{3, 2, 1}, ss.SortUsing(descending))
+	assert.Equal(t, Ints{3, 2, 1}, ss.SortStableUsing(descending))
+}
+
+func TestInts_InPlace(t *testing.T) {
+	double := func(value int) int {
+		return value * 2
+	}
+
+	assert.Equal(t, Ints(nil), Ints(nil).SortInPlace())
+	assert.Equal(t, Ints(nil), Ints(nil).ReverseInPlace())
+	assert.Equal(t, Ints(nil), Ints(nil).ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, Ints(nil), Ints(nil).TransformInPlace(double))
+
+	ss := Ints{2, 4, 1, 3}
+
+	assert.Equal(t, Ints{1, 2, 3, 4}, ss.SortInPlace())
+	assert.Equal(t, Ints{1, 2, 3, 4}, ss)
+
+	assert.Equal(t, Ints{4, 3, 2, 1}, ss.ReverseInPlace())
+	assert.Equal(t, Ints{4, 3, 2, 1}, ss)
+
+	assert.Equal(t, Ints{8, 6, 4, 2}, ss.TransformInPlace(double))
+	assert.Equal(t, Ints{8, 6, 4, 2}, ss)
+
+	shuffled := ss.ShuffleInPlace(nil)
+	assert.Equal(t, ss, shuffled)
+	assert.Equal(t, Ints{2, 4, 6, 8}, ss.Sort())
+}
+
+var intsPercentileTests = []struct {
+	ss       Ints
+	p        float64
+	expected float64
+}{
+	{nil, 50, 0},
+	{Ints{}, 50, 0},
+	{Ints{7}, 99, 7},
+	{Ints{1, 2, 3, 4}, 50, 2.5},
+	{Ints{4, 3, 2, 1}, 25, 1.75},
+	{Ints{1, 2, 3, 4}, 100, 4},
+}
+
+func TestInts_Percentile(t *testing.T) {
+	for _, test := range intsPercentileTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Percentile(test.p))
+		})
+	}
+
+	assert.True(t, math.IsNaN(Ints{1, 2, 3}.Percentile(math.NaN())))
+}
+
+var intsQuantilesTests = []struct {
+	ss       Ints
+	n        int
+	expected Float64s
+}{
+	{nil, 4, nil},
+	{Ints{1, 2, 3}, 1, nil},
+	{Ints{1, 2, 3, 4}, 4, Float64s{1.75, 2.5, 3.25}},
+	{Ints{3, 1, 2}, 2, Float64s{2}},
+}
+
+func TestInts_Quantiles(t *testing.T) {
+	for _, test := range intsQuantilesTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Quantiles(test.n))
+		})
+	}
+}
+
+var intsModeTests = []struct {
+	ss       Ints
+	expected Ints
+}{
+	{nil, nil},
+	{Ints{}, nil},
+	{Ints{1}, Ints{1}},
+	{Ints{1, 2, 2, 3}, Ints{2}},
+	{Ints{3, 1, 2, 1, 3}, Ints{3, 1}},
+	{Ints{3, 2, 1}, Ints{3, 2, 1}},
+}
+
+func TestInts_Mode(t *testing.T) {
+	for _, test := range intsModeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Mode())
+		})
+	}
+}
+
+var intsCumulativeSumTests = []struct {
+	ss       Ints
+	expected Ints
+}{
+	{nil, nil},
+	{Ints{}, Ints{}},
+	{Ints{3}, Ints{3}},
+	{Ints{1, 2, 3, 4}, Ints{1, 3, 6, 10}},
+	{Ints{5, -5, 2}, Ints{5, 0, 2}},
+}
+
+func TestInts_CumulativeSum(t *testing.T) {
+	for _, test := range intsCumulativeSumTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.CumulativeSum())
+		})
+	}
+}
+
+var intsMovingAverageTests = []struct {
+	ss       Ints
+	window   int
+	expected Float64s
+}{
+	{nil, 2, nil},
+	{Ints{1, 2, 3}, 0, nil},
+	{Ints{1, 2, 3}, 4, nil},
+	{Ints{1, 2, 3, 4}, 2, Float64s{1.5, 2.5, 3.5}},
+	{Ints{1, 2, 3, 4}, 4, Float64s{2.5}},
+}
+
+func TestInts_MovingAverage(t *testing.T) {
+	for _, test := range intsMovingAverageTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.MovingAverage(test.window))
+		})
+	}
+}
+
+func TestInts_Rolling(t *testing.T) {
+	sum := func(window Ints) float64 {
+		return float64(window.Sum())
+	}
+
+	assert.Equal(t, Float64s(nil), Ints(nil).Rolling(2, sum))
+	assert.Equal(t, Float64s{3, 5, 7}, Ints{1, 2, 3, 4}.Rolling(2, sum))
+}
+
+var intsArithmeticTests = []struct {
+	ss, ss2                         Ints
+	add, subtract, multiply, divide Ints
+}{
+	{nil, nil, nil, nil, nil, nil},
+	{nil, Ints{1}, nil, nil, nil, nil},
+	{Ints{}, Ints{1}, Ints{}, Ints{}, Ints{}, Ints{}},
+	{Ints{7, 6, -2}, Ints{2, 3, 4}, Ints{9, 9, 2}, Ints{5, 3, -6}, Ints{14, 18, -8}, Ints{3, 2, 0}},
+	{Ints{7, 6}, Ints{2, 3, 4}, Ints{9, 9}, Ints{5, 3}, Ints{14, 18}, Ints{3, 2}},
+}
+
+func TestInts_Add(t *testing.T) {
+	for _, test := range intsArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.add, test.ss.Add(test.ss2))
+		})
+	}
+}
+
+func TestInts_Subtract(t *testing.T) {
+	for _, test := range intsArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.subtract, test.ss.Subtract(test.ss2))
+		})
+	}
+}
+
+func TestInts_Multiply(t *testing.T) {
+	for _, test := range intsArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.multiply, test.ss.Multiply(test.ss2))
+		})
+	}
+}
+
+func TestInts_Divide(t *testing.T) {
+	for _, test := range intsArithmeticTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			defer assertImmutableInts(t, &test.ss2)()
+			assert.Equal(t, test.divide, test.ss.Divide(test.ss2))
+		})
+	}
+}
+
+func TestInts_AddScalar(t *testing.T) {
+	assert.Equal(t, Ints(nil), Ints(nil).AddScalar(1))
+	assert.Equal(t, Ints{3, 0}, Ints{2, -1}.AddScalar(1))
+}
+
+func TestInts_MultiplyScalar(t *testing.T) {
+	assert.Equal(t, Ints(nil), Ints(nil).MultiplyScalar(3))
+	assert.Equal(t, Ints{6, -3}, Ints{2, -1}.MultiplyScalar(3))
+}
+
+func TestInts_DotProduct(t *testing.T) {
+	product, err := Ints{1, 3, -5}.DotProduct(Ints{4, -2, -1})
+	assert.Equal(t, 3.0, product)
+	assert.NoError(t, err)
+
+	product, err = Ints{1, 3}.DotProduct(Ints{4})
+	assert.Equal(t, 0.0, product)
+	assert.Equal(t, ErrLengthMismatch, err)
+}
+
+func TestInts_Norm(t *testing.T) {
+	assert.Equal(t, 0.0, Ints(nil).Norm())
+	assert.Equal(t, 5.0, Ints{3, -4}.Norm())
+}
+
+func TestInts_Normalize(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Ints(nil).Normalize())
+	assert.Equal(t, Float64s{0.5, 0, 1, 0.25}, Ints{3, 1, 5, 2}.Normalize())
+}
+
+func TestInts_ZScore(t *testing.T) {
+	assert.Equal(t, Float64s(nil), Ints(nil).ZScore())
+	assert.Equal(t, Float64s{-1, 1}, Ints{1, 3}.ZScore())
+}
+
+func TestInts_MarshalBinary(t *testing.T) {
+	ss := Ints{0, 1, -1, math.MaxInt32, math.MinInt32}
+
+	data, err := ss.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, 41, len(data))
+
+	var decoded Ints
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, ss, decoded)
+}
+
+var intsDeltasTests = []struct {
+	ss       Ints
+	expected Ints
+}{
+	{nil, nil},
+	{Ints{}, nil},
+	{Ints{3}, nil},
+	{Ints{1, 3, 6, 10}, Ints{2, 3, 4}},
+	{Ints{5, 0, 2}, Ints{-5, 2}},
+}
+
+func TestInts_Deltas(t *testing.T) {
+	for _, test := range intsDeltasTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableInts(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Deltas())
+		})
+	}
+}
+
+func TestInts_DeltasIsInverseOfCumulativeSum(t *testing.T) {
+	ss := Ints{4, -2, 7, 1}
+	assert.Equal(t, ss[1:], ss.CumulativeSum().Deltas())
+}
+
+func TestInts_SearchSortedAndInsertSorted(t *testing.T) {
+	ss := Ints{1, 3, 5}
+	defer assertImmutableInts(t, &ss)()
+
+	index, found := ss.SearchSorted(3)
+	assert.Equal(t, 1, index)
+	assert.True(t, found)
+
+	assert.Equal(t, Ints{1, 3, 4, 5}, ss.InsertSorted(4))
+}
+
+func TestInts_MergeSorted(t *testing.T) {
+	ss := Ints{1, 4, 4}
+	defer assertImmutableInts(t, &ss)()
+
+	assert.Equal(t, Ints{1, 2, 4, 4, 4}, ss.MergeSorted(Ints{2, 4}))
+	assert.Equal(t, Ints{1, 2, 4}, ss.MergeSortedUnique(Ints{2, 4}))
+}
+
+func TestInts_Histogram(t *testing.T) {
+	ss := Ints{1, 2, 3, 4, 5}
+
+	assert.Equal(t, map[string]int{"[1, 3)": 2, "[3, 5]": 3}, ss.Histogram(2))
+	assert.Equal(t, []int{1, 3, 1}, ss.HistogramWithBounds(Float64s{2, 5}))
+}
+
+func TestInts_SumChecked(t *testing.T) {
+	sum, err := Ints{1, 2, 3}.SumChecked()
+	assert.NoError(t, err)
+	assert.Equal(t, 6, sum)
+
+	sum, err = Ints{math.MaxInt64, -1, 1}.SumChecked()
+	assert.NoError(t, err)
+	assert.Equal(t, math.MaxInt64, sum)
+
+	_, err = Ints{math.MaxInt64, 1}.SumChecked()
+	assert.Equal(t, ErrOverflow, err)
+
+	_, err = Ints{math.MinInt64, -1}.SumChecked()
+	assert.Equal(t, ErrOverflow, err)
+}
+
+func TestInts_AverageBig(t *testing.T) {
+	assert.Equal(t, "0/1", Ints(nil).AverageBig().String())
+	assert.Equal(t, "5/2", Ints{1, 2, 3, 4}.AverageBig().String())
+
+	// The intermediate sums would overflow an int.
+	assert.Equal(t, "9223372036854775807/1", Ints{math.MaxInt64, math.MaxInt64}.AverageBig().String())
+	assert.Equal(t, "-9223372036854775808/1", Ints{math.MinInt64, math.MinInt64}.AverageBig().String())
+}
+
+var intsRangeTests = []struct {
+	start, stop, step int
+	expected          Ints
+}{
+	{0, 5, 1, Ints{0, 1, 2, 3, 4}},
+	{0, 10, 3, Ints{0, 3, 6, 9}},
+	{5, 0, -2, Ints{5, 3, 1}},
+	{0, 0, 1, nil},
+	{0, 5, 0, nil},
+	{0, 5, -1, nil},
+	{5, 0, 1, nil},
+}
+
+func TestIntsRange(t *testing.T) {
+	for _, test := range intsRangeTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, IntsRange(test.start, test.stop, test.step))
+		})
+	}
+}
+
+func TestRandomInts(t *testing.T) {
+	ss := RandomInts(1000, 1, 4, rand.NewSource(1))
+	assert.Equal(t, Ints{1, 2, 3}, ss.Unique().Sort())
+	assert.Equal(t, ss, RandomInts(1000, 1, 4, rand.NewSource(1)))
+	assert.Equal(t, Ints(nil), RandomInts(-1, 1, 4, nil))
+}
+
+func TestInts_PermutationsAndCombinationsCount(t *testing.T) {
+	ss := IntsRange(0, 6, 1)
+
+	var permutations Strings
+	for _, p := range ss.Permutations() {
+		permutations = append(permutations, fmt.Sprint(p))
+	}
+
+	assert.Equal(t, 720, len(permutations))
+	assert.Equal(t, 720, len(permutations.Unique()))
+	assert.Equal(t, 20, len(ss.Combinations(3)))
+}
+
+func TestInts_FormatAndStrings(t *testing.T) {
+	assert.Equal(t, Strings{"42", "-7"}, Ints{42, -7}.Strings())
+	assert.Equal(t, Strings{"00042", "-0007"}, Ints{42, -7}.Format("%05d"))
+	assert.Equal(t, "1,2,3", Ints{1, 2, 3}.Strings().Join(","))
+}
@@ -0,0 +1,47 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"iter"
+)
+
+// Iter returns an iterator over the elements, in order.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss Slice[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss Slice[T]) IterIndexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
+
+// Collect creates a Slice from all of the values produced by seq. If there are
+// no values then nil is returned.
+//
+// Collect is only available when compiling with Go 1.23 or newer.
+func Collect[T comparable](seq iter.Seq[T]) (ss Slice[T]) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
@@ -0,0 +1,62 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+func TestFloat64s_Iter(t *testing.T) {
+	assert.Equal(t, Float64s(nil), CollectFloat64s(Float64s(nil).Iter()))
+	assert.Equal(t, Float64s{1.5, -2, 3}, CollectFloat64s(Float64s{1.5, -2, 3}.Iter()))
+
+	var seen Float64s
+	for s := range (Float64s{1.5, -2, 3}).Iter() {
+		seen = append(seen, s)
+		if s < 0 {
+			break
+		}
+	}
+	assert.Equal(t, Float64s{1.5, -2}, seen)
+}
+
+func TestFloat64s_IterIndexed(t *testing.T) {
+	assert.Equal(t, map[int]float64{0: 1.5, 1: -2},
+		maps.Collect(Float64s{1.5, -2}.IterIndexed()))
+}
+
+func TestInts_Iter(t *testing.T) {
+	assert.Equal(t, Ints{1, 2, 3}, CollectInts(slices.Values([]int{1, 2, 3})))
+	assert.Equal(t, []int{3, 1, 2}, slices.Collect(Ints{3, 1, 2}.Iter()))
+	assert.Equal(t, map[int]int{0: 3, 1: 1}, maps.Collect(Ints{3, 1}.IterIndexed()))
+}
+
+func TestStrings_Iter(t *testing.T) {
+	assert.Equal(t, Strings{"a", "b"}, CollectStrings(Strings{"a", "b"}.Iter()))
+	assert.Equal(t, map[int]string{0: "a", 1: "b"}, maps.Collect(Strings{"a", "b"}.IterIndexed()))
+}
+
+func TestTimes_Iter(t *testing.T) {
+	ss := Times{time1, time2}
+	assert.Equal(t, ss, CollectTimes(ss.Iter()))
+	assert.Equal(t, map[int]time.Time{0: time1, 1: time2}, maps.Collect(ss.IterIndexed()))
+}
+
+func TestCars_Iter(t *testing.T) {
+	ss := cars{{"a", "red"}, {"b", "blue"}}
+	assert.Equal(t, cars(nil), Collectcars(cars(nil).Iter()))
+	assert.Equal(t, ss, Collectcars(ss.Iter()))
+	assert.Equal(t, []car{{"a", "red"}}, slices.Collect(ss[:1].Iter()))
+}
+
+func TestSlice_Iter(t *testing.T) {
+	assert.Equal(t, Slice[int](nil), Collect(Slice[int](nil).Iter()))
+	assert.Equal(t, Slice[car]{{"a", "red"}}, Collect(Slice[car]{{"a", "red"}}.Iter()))
+	assert.Equal(t, map[int]string{0: "a"}, maps.Collect(Slice[string]{"a"}.IterIndexed()))
+}
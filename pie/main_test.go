@@ -60,3 +60,13 @@ func assertImmutableCarPointers(t *testing.T, ss *carPointers) func() {
 		assert.True(t, before == after)
 	}
 }
+
+func assertImmutableTimes(t *testing.T, ss *Times) func() {
+	before := (*ss).JSONString()
+
+	return func() {
+		after := (*ss).JSONString()
+		assert.Equal(t, before, after)
+		assert.True(t, before == after)
+	}
+}
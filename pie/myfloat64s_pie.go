@@ -0,0 +1,20 @@
+package pie
+
+// Average is the average of all of the elements, or zero if there are no
+// elements.
+func (ss myFloat64s) Average() float64 {
+	if l := float64(len(ss)); l > 0 {
+		return float64(ss.Sum()) / float64(l)
+	}
+
+	return 0
+}
+
+// Sum is the sum of all of the elements.
+func (ss myFloat64s) Sum() (sum float64) {
+	for _, s := range ss {
+		sum += s
+	}
+
+	return
+}
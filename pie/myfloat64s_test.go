@@ -0,0 +1,29 @@
+package pie
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the -only and -exclude flags are
+// applied when generating myFloat64s. The more extensive tests for these
+// functions are in float64s_test.go
+
+func TestMyFloat64s_Average(t *testing.T) {
+	assert.Equal(t, 0.0, myFloat64s(nil).Average())
+	assert.Equal(t, 2.5, myFloat64s{1.5, 3.5}.Average())
+}
+
+func TestMyFloat64s_Sum(t *testing.T) {
+	assert.Equal(t, 5.0, myFloat64s{1.5, 3.5}.Sum())
+}
+
+func TestMyFloat64s_OnlyAndExclude(t *testing.T) {
+	ty := reflect.TypeOf(myFloat64s{})
+	assert.Equal(t, 2, ty.NumMethod())
+
+	_, ok := ty.MethodByName("Max")
+	assert.False(t, ok)
+}
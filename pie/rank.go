@@ -0,0 +1,24 @@
+package pie
+
+// RankMethod controls how Rank handles elements that are equal.
+type RankMethod int
+
+const (
+	// RankAverage gives equal elements the average of the ranks they would
+	// have had, so the ranks of 10, 20, 20, 30 are 1, 2.5, 2.5, 4. This is
+	// the method used by Spearman correlation.
+	RankAverage RankMethod = iota
+
+	// RankMin gives equal elements the lowest of the ranks they would have
+	// had, so the ranks of 10, 20, 20, 30 are 1, 2, 2, 4. This is the usual
+	// ranking for competitions and leaderboards.
+	RankMin
+
+	// RankMax gives equal elements the highest of the ranks they would have
+	// had, so the ranks of 10, 20, 20, 30 are 1, 3, 3, 4.
+	RankMax
+
+	// RankDense is like RankMin except the next rank after equal elements is
+	// not skipped, so the ranks of 10, 20, 20, 30 are 1, 2, 2, 3.
+	RankDense
+)
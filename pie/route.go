@@ -0,0 +1,16 @@
+package pie
+
+// route contains a slice, so it cannot be compared with ==. The generated
+// functions use the Equals method instead.
+//
+//go:generate pie routes.*
+type routes []route
+
+type route struct {
+	Name  string
+	Stops []string
+}
+
+func (r route) Equals(other route) bool {
+	return r.Name == other.Name && Strings(r.Stops).Equals(other.Stops)
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"iter"
+)
+
+// Collectroutes creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// Collectroutes is only available when compiling with Go 1.23 or newer.
+func Collectroutes(seq iter.Seq[route]) (ss routes) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss routes) Iter() iter.Seq[route] {
+	return func(yield func(route) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss routes) IterIndexed() iter.Seq2[int, route] {
+	return func(yield func(int, route) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package pie
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are to make sure that the functions that compare elements use
+// route.Equals because routes cannot be compared with ==.
+
+var (
+	routeA  = route{"A", []string{"Central", "Museum"}}
+	routeA2 = route{"A", []string{"Central", "Museum"}}
+	routeB  = route{"B", []string{"Central"}}
+)
+
+func TestRoutes_Contains(t *testing.T) {
+	assert.False(t, routes(nil).Contains(routeA))
+	assert.True(t, routes{routeB, routeA}.Contains(routeA2))
+	assert.False(t, routes{routeA}.Contains(routeB))
+}
+
+func TestRoutes_IndexOf(t *testing.T) {
+	assert.Equal(t, 1, routes{routeB, routeA, routeA}.IndexOf(routeA2))
+	assert.Equal(t, 2, routes{routeB, routeA, routeA}.LastIndexOf(routeA2))
+	assert.Equal(t, -1, routes{routeA}.IndexOf(routeB))
+}
+
+func TestRoutes_Unique(t *testing.T) {
+	assert.Equal(t, routes(nil), routes(nil).Unique())
+	assert.Equal(t, routes{routeA, routeB}, routes{routeA, routeB, routeA2}.Unique())
+}
+
+func TestRoutes_Equals(t *testing.T) {
+	assert.True(t, routes{routeA, routeB}.Equals(routes{routeA2, routeB}))
+	assert.False(t, routes{routeA, routeB}.Equals(routes{routeB, routeA2}))
+	assert.True(t, routes{routeA, routeB}.EqualsUnordered(routes{routeB, routeA2}))
+	assert.False(t, routes{routeA, routeA}.EqualsUnordered(routes{routeA, routeB}))
+}
+
+func TestRoutes_Mode(t *testing.T) {
+	assert.Equal(t, routes(nil), routes(nil).Mode())
+	assert.Equal(t, routes{routeA}, routes{routeA, routeB, routeA2}.Mode())
+	assert.Equal(t, routes{routeA, routeB}, routes{routeA, routeB}.Mode())
+}
+
+func TestRoutes_Compact(t *testing.T) {
+	assert.Equal(t, routes{routeA}, routes{{}, routeA}.Compact())
+	assert.Equal(t, routeB, routes{{}}.CoalesceOr(routeB))
+}
+
+func TestRoutes_Frequencies(t *testing.T) {
+	// Frequencies cannot be generated because routes cannot be map keys.
+	_, ok := reflect.TypeOf(routes{}).MethodByName("Frequencies")
+	assert.False(t, ok)
+}
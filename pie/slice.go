@@ -0,0 +1,453 @@
+//go:build go1.18
+// +build go1.18
+
+package pie
+
+import (
+	"encoding/json"
+	"math/rand"
+	"sort"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// Ordered is any type that supports the < operator.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Number is any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Slice is a generic alternative to the generated slice types. It can be used
+// with any comparable element type without needing to run "go generate".
+//
+// Go does not allow methods to add extra constraints to a type parameter, so
+// the functions that need to order or add elements (Sort, AreSorted, Min, Max,
+// Sum and Average) are package-level functions that accept a Slice. The same is
+// true for functions that need another type parameter, such as ToMap.
+//
+// Slice is only available when compiling with Go 1.18 or newer.
+type Slice[T comparable] []T
+
+// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
+func (ss Slice[T]) All(fn func(value T) bool) bool {
+	for _, value := range ss {
+		if !fn(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
+func (ss Slice[T]) Any(fn func(value T) bool) bool {
+	for _, value := range ss {
+		if fn(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Append will return a new slice with the elements appended to the end. The
+// result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss Slice[T]) Append(elements ...T) Slice[T] {
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(Slice[T], len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
+}
+
+// AreUnique will return true if the slice contains elements that are all
+// different (unique) from each other.
+func (ss Slice[T]) AreUnique() bool {
+	return ss.Unique().Len() == ss.Len()
+}
+
+// Bottom will return n elements from the end of the slice, in reverse order.
+// If the slice has less elements then n that'll return all elements. If n < 0
+// it'll return an empty slice.
+func (ss Slice[T]) Bottom(n int) (bottom Slice[T]) {
+	for i := len(ss) - 1; i > -1 && n > 0; i-- {
+		bottom = append(bottom, ss[i])
+		n--
+	}
+
+	return
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Slice[T]) Contains(lookingFor T) bool {
+	for _, s := range ss {
+		if s == lookingFor {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Each allows an action to happen on each element and passes the original
+// slice on.
+func (ss Slice[T]) Each(fn func(T)) Slice[T] {
+	for _, s := range ss {
+		fn(s)
+	}
+
+	return ss
+}
+
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// It is acceptable to provide zero arguments.
+func (ss Slice[T]) Extend(slices ...Slice[T]) (ss2 Slice[T]) {
+	ss2 = ss
+
+	for _, slice := range slices {
+		ss2 = ss2.Append(slice...)
+	}
+
+	return ss2
+}
+
+// First returns the first element, or zero. Also see FirstOr().
+func (ss Slice[T]) First() T {
+	var zero T
+
+	return ss.FirstOr(zero)
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss Slice[T]) FirstOr(defaultValue T) T {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Slice[T]) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal([]T(ss))
+
+	return string(data)
+}
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss Slice[T]) Last() T {
+	var zero T
+
+	return ss.LastOr(zero)
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss Slice[T]) LastOr(defaultValue T) T {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+
+// Len returns the number of elements.
+func (ss Slice[T]) Len() int {
+	return len(ss)
+}
+
+// Random returns a random element by your rand.Source, or zero.
+func (ss Slice[T]) Random(source rand.Source) T {
+	var zero T
+
+	switch len(ss) {
+	case 0:
+		return zero
+
+	case 1:
+		return ss[0]
+	}
+
+	return ss[rand.New(source).Intn(len(ss))]
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+func (ss Slice[T]) Reverse() Slice[T] {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	reversed := make(Slice[T], len(ss))
+	for i := 0; i < len(ss); i++ {
+		reversed[i] = ss[len(ss)-i-1]
+	}
+
+	return reversed
+}
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss Slice[T]) Select(condition func(T) bool) (ss2 Slice[T]) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Shuffle returns shuffled slice by your rand.Source.
+func (ss Slice[T]) Shuffle(source rand.Source) Slice[T] {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 2 {
+		return ss
+	}
+
+	shuffled := make(Slice[T], n)
+	copy(shuffled, ss)
+
+	util.Shuffle(rand.New(source), n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Slice[T]) ToSet() map[T]struct{} {
+	set := make(map[T]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
+
+// Top will return n elements from head of the slice. If the slice has less
+// elements then n that'll return all elements. If n < 0 it'll return an empty
+// slice.
+func (ss Slice[T]) Top(n int) (top Slice[T]) {
+	for i := 0; i < len(ss) && n > 0; i++ {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+
+// ToStrings transforms each element to a string.
+func (ss Slice[T]) ToStrings(transform func(T) string) Strings {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Strings, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// Transform will return a new slice where each element has been transformed.
+// The number of element returned will always be the same as the input.
+func (ss Slice[T]) Transform(fn func(T) T) (ss2 Slice[T]) {
+	if ss == nil {
+		return nil
+	}
+
+	ss2 = make(Slice[T], len(ss))
+	for i, s := range ss {
+		ss2[i] = fn(s)
+	}
+
+	return
+}
+
+// Unique returns a new slice with all of the unique values. Unlike the
+// generated Unique, the elements will retain the order in which they first
+// appeared.
+//
+// A slice with zero elements is considered to be unique.
+func (ss Slice[T]) Unique() Slice[T] {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[T]struct{}{}
+	unique := Slice[T]{}
+
+	for _, value := range ss {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
+
+// Unselect works the same as Select, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss Slice[T]) Unselect(condition func(T) bool) (ss2 Slice[T]) {
+	for _, s := range ss {
+		if !condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// ToMap returns a map of the elements by the key returned from keyFn. If more
+// than one element has the same key the last one is used.
+//
+// An empty slice returns an empty (not nil) map.
+func ToMap[K comparable, T comparable](ss Slice[T], keyFn func(T) K) map[K]T {
+	m := make(map[K]T, len(ss))
+	for _, s := range ss {
+		m[keyFn(s)] = s
+	}
+
+	return m
+}
+
+// Repeat creates a slice containing value n times. nil is returned if n is less
+// than one.
+func Repeat[T comparable](value T, n int) Slice[T] {
+	if n < 1 {
+		return nil
+	}
+
+	ss := make(Slice[T], n)
+	for i := range ss {
+		ss[i] = value
+	}
+
+	return ss
+}
+
+// AreSorted will return true if the slice is already sorted.
+func AreSorted[T Ordered](ss Slice[T]) bool {
+	return sort.SliceIsSorted(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+}
+
+// Sort returns a new sorted slice. Unlike sort.Slice the input slice is not
+// modified.
+func Sort[T Ordered](ss Slice[T]) Slice[T] {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Slice[T], len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+// Min is the minimum value, or zero.
+func Min[T Ordered](ss Slice[T]) (min T) {
+	if len(ss) == 0 {
+		return
+	}
+
+	min = ss[0]
+	for _, s := range ss {
+		if s < min {
+			min = s
+		}
+	}
+
+	return
+}
+
+// Max is the maximum value, or zero.
+func Max[T Ordered](ss Slice[T]) (max T) {
+	if len(ss) == 0 {
+		return
+	}
+
+	max = ss[0]
+	for _, s := range ss {
+		if s > max {
+			max = s
+		}
+	}
+
+	return
+}
+
+// Sum is the sum of all of the elements.
+func Sum[T Number](ss Slice[T]) (sum T) {
+	for _, s := range ss {
+		sum += s
+	}
+
+	return
+}
+
+// Average is the average of all of the elements, or zero if there are no
+// elements.
+func Average[T Number](ss Slice[T]) float64 {
+	if l := len(ss); l > 0 {
+		return float64(Sum(ss)) / float64(l)
+	}
+
+	return 0
+}
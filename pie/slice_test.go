@@ -0,0 +1,132 @@
+//go:build go1.18
+// +build go1.18
+
+package pie
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+func TestSlice_Contains(t *testing.T) {
+	assert.False(t, Slice[int](nil).Contains(1))
+	assert.True(t, Slice[int]{1, 2, 3}.Contains(2))
+	assert.False(t, Slice[int]{1, 2, 3}.Contains(4))
+	assert.True(t, Slice[car]{{"a", "red"}, {"b", "blue"}}.Contains(car{"b", "blue"}))
+}
+
+func TestSlice_SelectAndUnselect(t *testing.T) {
+	ss := Slice[string]{"Bob", "Sally", "John", "Jane"}
+	isJ := func(name string) bool {
+		return strings.HasPrefix(name, "J")
+	}
+
+	assert.Equal(t, Slice[string](nil), Slice[string](nil).Select(isJ))
+	assert.Equal(t, Slice[string]{"John", "Jane"}, ss.Select(isJ))
+	assert.Equal(t, Slice[string]{"Bob", "Sally"}, ss.Unselect(isJ))
+}
+
+func TestSlice_Transform(t *testing.T) {
+	assert.Equal(t, Slice[string](nil), Slice[string](nil).Transform(strings.ToUpper))
+	assert.Equal(t, Slice[string]{"A", "B"}, Slice[string]{"a", "b"}.Transform(strings.ToUpper))
+}
+
+func TestSlice_FirstAndLast(t *testing.T) {
+	assert.Equal(t, 0, Slice[int](nil).First())
+	assert.Equal(t, 5, Slice[int](nil).FirstOr(5))
+	assert.Equal(t, 0, Slice[int](nil).Last())
+	assert.Equal(t, 5, Slice[int](nil).LastOr(5))
+	assert.Equal(t, 1, Slice[int]{1, 2, 3}.First())
+	assert.Equal(t, 3, Slice[int]{1, 2, 3}.Last())
+}
+
+func TestSlice_AllAndAny(t *testing.T) {
+	positive := func(value float64) bool {
+		return value > 0
+	}
+
+	assert.True(t, Slice[float64](nil).All(positive))
+	assert.False(t, Slice[float64](nil).Any(positive))
+	assert.False(t, Slice[float64]{1, -2}.All(positive))
+	assert.True(t, Slice[float64]{1, -2}.Any(positive))
+}
+
+func TestSlice_AppendAndExtend(t *testing.T) {
+	assert.Equal(t, Slice[int]{1, 2}, Slice[int]{1}.Append(2))
+	assert.Equal(t, Slice[int]{1, 2, 3}, Slice[int]{1}.Extend(Slice[int]{2}, Slice[int]{3}))
+}
+
+func TestSlice_TopAndBottom(t *testing.T) {
+	assert.Equal(t, Slice[int](nil), Slice[int]{1, 2, 3}.Top(0))
+	assert.Equal(t, Slice[int]{1, 2}, Slice[int]{1, 2, 3}.Top(2))
+	assert.Equal(t, Slice[int]{3, 2}, Slice[int]{1, 2, 3}.Bottom(2))
+}
+
+func TestSlice_Reverse(t *testing.T) {
+	assert.Equal(t, Slice[int](nil), Slice[int](nil).Reverse())
+	assert.Equal(t, Slice[int]{3, 2, 1}, Slice[int]{1, 2, 3}.Reverse())
+}
+
+func TestSlice_Unique(t *testing.T) {
+	assert.Equal(t, Slice[string](nil), Slice[string](nil).Unique())
+	assert.Equal(t, Slice[string]{"b", "a", "c"}, Slice[string]{"b", "a", "b", "c", "a"}.Unique())
+	assert.True(t, Slice[string]{"a", "b"}.AreUnique())
+	assert.False(t, Slice[string]{"a", "b", "a"}.AreUnique())
+}
+
+func TestSlice_JSONString(t *testing.T) {
+	assert.Equal(t, `[]`, Slice[int](nil).JSONString())
+	assert.Equal(t, `[1,2]`, Slice[int]{1, 2}.JSONString())
+}
+
+func TestSlice_ToStrings(t *testing.T) {
+	assert.Equal(t, Strings(nil), Slice[int](nil).ToStrings(func(int) string {
+		return "x"
+	}))
+	assert.Equal(t, Strings{"a!", "b!"}, Slice[string]{"a", "b"}.ToStrings(func(s string) string {
+		return s + "!"
+	}))
+}
+
+func TestSlice_Shuffle(t *testing.T) {
+	ss := Slice[int]{1, 2, 3, 4, 5}
+	shuffled := ss.Shuffle(rand.NewSource(0))
+
+	assert.Equal(t, Slice[int]{1, 2, 3, 4, 5}, ss)
+	assert.Equal(t, ss, Sort(shuffled))
+}
+
+func TestSlice_Sort(t *testing.T) {
+	assert.Equal(t, Slice[int](nil), Sort(Slice[int](nil)))
+	assert.Equal(t, Slice[string]{"a", "b", "c"}, Sort(Slice[string]{"c", "a", "b"}))
+	assert.True(t, AreSorted(Slice[int]{1, 2, 3}))
+	assert.False(t, AreSorted(Slice[int]{2, 1, 3}))
+}
+
+func TestSlice_Stats(t *testing.T) {
+	assert.Equal(t, 0, Min(Slice[int](nil)))
+	assert.Equal(t, 1.5, Min(Slice[float64]{2.5, 1.5, 3.5}))
+	assert.Equal(t, 3.5, Max(Slice[float64]{2.5, 1.5, 3.5}))
+	assert.Equal(t, 7.5, Sum(Slice[float64]{2.5, 1.5, 3.5}))
+	assert.Equal(t, 0.0, Average(Slice[int](nil)))
+	assert.Equal(t, 2.0, Average(Slice[int]{1, 2, 3}))
+}
+
+func TestSlice_ToSetAndToMap(t *testing.T) {
+	assert.Equal(t, map[int]struct{}{}, Slice[int](nil).ToSet())
+	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, Slice[int]{1, 2, 1}.ToSet())
+
+	byName := ToMap(Slice[car]{{"a", "green"}, {"b", "blue"}}, func(c car) string {
+		return c.Name
+	})
+	assert.Equal(t, map[string]car{"a": {"a", "green"}, "b": {"b", "blue"}}, byName)
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, Slice[string]{"a", "a", "a"}, Repeat("a", 3))
+	assert.Equal(t, Slice[int](nil), Repeat(1, 0))
+	assert.Equal(t, Slice[int](nil), Repeat(1, -1))
+}
@@ -1,12 +1,52 @@
 package pie
 
 import (
+	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"github.com/elliotchance/pie/pie/util"
 	"math/rand"
+	"reflect"
+	"regexp"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 )
 
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss Strings) Accumulate(initial string, fn func(acc, value string) string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(Strings, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
+
 // All will return true if all callbacks return true. It follows the same logic
 // as the all() function in Python.
 //
@@ -35,13 +75,88 @@ func (ss Strings) Any(fn func(value string) bool) bool {
 	return false
 }
 
-// Append will return a new slice with the elements appended to the end. It is a
-// wrapper for the internal append(). It is offered as a function so that it can
-// more easily chained.
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
 //
 // It is acceptable to provide zero arguments.
 func (ss Strings) Append(elements ...string) Strings {
-	return append(ss, elements...)
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(Strings, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
+}
+
+// ArgMax returns the index of the largest element. If there are several
+// largest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Max to get the value instead.
+func (ss Strings) ArgMax() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s > ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+
+// ArgMin returns the index of the smallest element. If there are several
+// smallest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Min to get the value instead.
+func (ss Strings) ArgMin() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s < ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
+//
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order. The input slice is not modified
+// and nil is returned if there are no elements.
+func (ss Strings) ArgSort() []int {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ss[indexes[i]] < ss[indexes[j]]
+	})
+
+	return indexes
 }
 
 // AreSorted will return true if the slice is already sorted. It is a wrapper
@@ -74,221 +189,2292 @@ func (ss Strings) Bottom(n int) (top Strings) {
 	return
 }
 
-// Contains returns true if the element exists in the slice.
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
 //
-// When using slices of pointers it will only compare by address, not value.
-func (ss Strings) Contains(lookingFor string) bool {
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesianStrings for more
+// than two slices.
+func (ss Strings) Cartesian(ss2 Strings) StringsPairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
+	}
+
+	pairs := make(StringsPairs, 0, len(ss)*len(ss2))
 	for _, s := range ss {
-		if s == lookingFor {
-			return true
+		for _, s2 := range ss2 {
+			pairs = append(pairs, StringsPair{s, s2})
 		}
 	}
 
-	return false
+	return pairs
 }
 
-// Each is more condensed version of Transform that allows an action to happen
-// on each elements and pass the original slice on.
-//
-//   cars.Each(func (car *Car) {
-//       fmt.Printf("Car color is: %s\n", car.Color)
-//   })
-//
-// Pie will not ensure immutability on items passed in so they can be
-// manipulated, if you choose to do it this way, for example:
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
 //
-//   // Set all car colors to Red.
-//   cars.Each(func (car *Car) {
-//       car.Color = "Red"
-//   })
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
 //
-func (ss Strings) Each(fn func(string)) Strings {
-	for _, s := range ss {
-		fn(s)
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss Strings) Chunk(size int) (chunks []Strings) {
+	if size < 1 {
+		return nil
 	}
 
-	return ss
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		chunks = append(chunks, ss[i:end:end])
+	}
+
+	return
 }
 
-// Extend will return a new slice with the slices of elements appended to the
-// end.
-//
-// It is acceptable to provide zero arguments.
-func (ss Strings) Extend(slices ...Strings) (ss2 Strings) {
-	ss2 = ss
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss Strings) CoalesceOr(defaultValue string) string {
+	var zero string
 
-	for _, slice := range slices {
-		ss2 = ss2.Append(slice...)
+	for _, s := range ss {
+		if s != zero {
+			return s
+		}
 	}
 
-	return ss2
+	return defaultValue
 }
 
-// First returns the first element, or zero. Also see FirstOr().
-func (ss Strings) First() string {
-	return ss.FirstOr("")
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss Strings) Combinations(k int) (combinations []Strings) {
+	ss.EachCombination(k, func(combination Strings) bool {
+		c := make(Strings, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
+
+	return
 }
 
-// FirstOr returns the first element or a default value if there are no
-// elements.
-func (ss Strings) FirstOr(defaultValue string) string {
-	if len(ss) == 0 {
-		return defaultValue
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Strings) Compact() (ss2 Strings) {
+	var zero string
+
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
 	}
 
-	return ss[0]
+	return
 }
 
-// Join returns a string from joining each of the elements.
-func (ss Strings) Join(glue string) (s string) {
-	for i, element := range ss {
-		if i > 0 {
-			s += glue
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Strings) Contains(lookingFor string) bool {
+	for _, s := range ss {
+		if s == lookingFor {
+			return true
 		}
-
-		s += string(element)
 	}
 
-	return s
+	return false
 }
 
-// JSONString returns the JSON encoded array as a string.
-//
-// One important thing to note is that it will treat a nil slice as an empty
-// slice to ensure that the JSON value return is always an array.
-func (ss Strings) JSONString() string {
-	if ss == nil {
-		return "[]"
+// ContainsFold works the same as Contains, except that the comparison ignores
+// case (see strings.EqualFold).
+func (ss Strings) ContainsFold(lookingFor string) bool {
+	for _, s := range ss {
+		if strings.EqualFold(string(s), lookingFor) {
+			return true
+		}
 	}
 
-	// An error should not be possible.
-	data, _ := json.Marshal(ss)
-
-	return string(data)
+	return false
 }
 
-// Last returns the last element, or zero. Also see LastOr().
-func (ss Strings) Last() string {
-	return ss.LastOr("")
+// Containing returns a new slice containing only the elements that contain
+// substr. The returned slice may contain zero elements (nil).
+func (ss Strings) Containing(substr string) (ss2 Strings) {
+	for _, s := range ss {
+		if strings.Contains(string(s), substr) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
 }
 
-// LastOr returns the last element or a default value if there are no elements.
-func (ss Strings) LastOr(defaultValue string) string {
-	if len(ss) == 0 {
-		return defaultValue
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss Strings) CountUsing(condition func(string) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
 	}
 
-	return ss[len(ss)-1]
+	return
 }
 
-// Len returns the number of elements.
-func (ss Strings) Len() int {
-	return len(ss)
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss Strings) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
 }
 
-// Max is the maximum value, or zero.
-func (ss Strings) Max() (max string) {
-	if len(ss) == 0 {
-		return
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Strings) DeleteAt(indexes ...int) (ss2 Strings) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
 	}
 
-	max = ss[0]
-	for _, s := range ss {
-		if s > max {
-			max = s
+	for i, s := range ss {
+		if !deleted[i] {
+			ss2 = append(ss2, s)
 		}
 	}
 
 	return
 }
 
-// Min is the minimum value, or zero.
-func (ss Strings) Min() (min string) {
-	if len(ss) == 0 {
-		return
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+func (ss Strings) Diff(against Strings) (added, removed Strings) {
+	counts := map[string]int{}
+	for _, value := range ss {
+		counts[value]++
 	}
 
-	min = ss[0]
-	for _, s := range ss {
-		if s < min {
-			min = s
+	for _, value := range against {
+		if counts[value] > 0 {
+			counts[value]--
+		} else {
+			added = append(added, value)
+		}
+	}
+
+	// Anything remaining in counts has been removed. We iterate ss again to
+	// keep the original order.
+	for _, value := range ss {
+		if counts[value] > 0 {
+			counts[value]--
+			removed = append(removed, value)
 		}
 	}
 
 	return
 }
 
-// Random returns a random element by your rand.Source, or zero
-func (ss Strings) Random(source rand.Source) string {
-	n := len(ss)
-
-	// Avoid the extra allocation.
-	if n < 1 {
-		return ""
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss Strings) Drop(n int) (ss2 Strings) {
+	if n < 0 {
+		n = 0
 	}
-	if n < 2 {
-		return ss[0]
+
+	if n >= len(ss) {
+		return nil
 	}
-	rnd := rand.New(source)
-	i := rnd.Intn(n)
-	return ss[i]
+
+	ss2 = make(Strings, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
 }
 
-// Reverse returns a new copy of the slice with the elements ordered in reverse.
-// This is useful when combined with Sort to get a descending sort order:
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
 //
-//   ss.Sort().Reverse()
+// The returned slice may contain zero elements (nil).
 //
-func (ss Strings) Reverse() Strings {
-	// Avoid the allocation. If there is one element or less it is already
-	// reversed.
-	if len(ss) < 2 {
-		return ss
-	}
-
-	sorted := make([]string, len(ss))
-	for i := 0; i < len(ss); i++ {
-		sorted[i] = ss[len(ss)-i-1]
+// See TakeWhile().
+func (ss Strings) DropWhile(condition func(string) bool) (ss2 Strings) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
 	}
 
-	return sorted
+	return
 }
 
-// Select will return a new slice containing only the elements that return
-// true from the condition. The returned slice may contain zero elements (nil).
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass the original slice on.
 //
-// Unselect works in the opposite way as Select.
-func (ss Strings) Select(condition func(string) bool) (ss2 Strings) {
+//   cars.Each(func (car *Car) {
+//       fmt.Printf("Car color is: %s\n", car.Color)
+//   })
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//   // Set all car colors to Red.
+//   cars.Each(func (car *Car) {
+//       car.Color = "Red"
+//   })
+//
+func (ss Strings) Each(fn func(string)) Strings {
 	for _, s := range ss {
-		if condition(s) {
-			ss2 = append(ss2, s)
-		}
+		fn(s)
 	}
 
-	return
+	return ss
 }
 
-// Sort works similar to sort.Strings(). However, unlike sort.Strings the
-// slice returned will be reallocated as to not modify the input slice.
+// EachCartesianStrings calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
 //
-// See Reverse() and AreSorted().
-func (ss Strings) Sort() Strings {
-	// Avoid the allocation. If there is one element or less it is already
-	// sorted.
-	if len(ss) < 2 {
-		return ss
+//   EachCartesianStrings(func(values Strings) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesianStrings(fn func(Strings) bool, slices ...Strings) {
+	if len(slices) == 0 {
+		return
+	}
+
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	values := make(Strings, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
+
+	for {
+		if !fn(values) {
+			return
+		}
+
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
+
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
+//
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss Strings) EachCombination(k int, fn func(Strings) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	combination := make(Strings, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
+
+	for {
+		if !fn(combination) {
+			return
+		}
+
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
+}
+
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss Strings) EachErr(fn func(string) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss Strings) EachPermutation(fn func(Strings) bool) {
+	n := len(ss)
+	if n == 0 {
+		return
+	}
+
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(Strings, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
+	}
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+}
+
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
+//
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
+//
+func (ss Strings) EachWithIndex(fn func(int, string)) Strings {
+	for i, s := range ss {
+		fn(i, s)
+	}
+
+	return ss
+}
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Strings) Equals(ss2 Strings) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Strings) EqualsUnordered(ss2 Strings) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[string]int{}
+	for _, s := range ss {
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
+		}
+
+		counts[s]--
+	}
+
+	return true
+}
+
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// Like Append, the result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss Strings) Extend(slices ...Strings) Strings {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
+
+	// Avoid the allocation.
+	if n == 0 {
+		return ss
+	}
+
+	result := make(Strings, len(ss), len(ss)+n)
+	copy(result, ss)
+
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// First returns the first element, or zero. Also see FirstOr().
+func (ss Strings) First() string {
+	return ss.FirstOr("")
+}
+
+// FirstE returns the first element. Unlike First, ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss Strings) FirstE() (string, error) {
+	if len(ss) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss Strings) FirstOr(defaultValue string) string {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+
+// Join returns a string from joining each of the elements.
+func (ss Strings) Join(glue string) (s string) {
+	for i, element := range ss {
+		if i > 0 {
+			s += glue
+		}
+
+		s += string(element)
+	}
+
+	return s
+}
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss Strings) FirstUsing(condition func(string) bool) (string, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return "", false
+}
+
+// FlattenStrings returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func FlattenStrings(slices []Strings) (ss Strings) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Strings) Frequencies() map[string]int {
+	frequencies := make(map[string]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
+
+// StringsFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func StringsFromCSVString(s string) (ss Strings, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
+
+// StringsFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func StringsFromChannel(ch <-chan string) (ss Strings) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// StringsFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func StringsFromJSONString(s string) (ss Strings, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Strings) GroupByString(fn func(string) string) map[string]Strings {
+	group := map[string]Strings{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss Strings) IndexOf(lookingFor string) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss Strings) Insert(index int, values ...string) Strings {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(Strings, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
+
+// InsertSorted returns a new slice with value inserted into a slice that is
+// already sorted in ascending order, so that the result is also sorted. If
+// value already exists it is inserted after the existing elements.
+//
+// The result is undefined if the slice is not sorted.
+func (ss Strings) InsertSorted(value string) Strings {
+	index := sort.Search(len(ss), func(i int) bool {
+		return ss[i] > value
+	})
+
+	result := make(Strings, len(ss)+1)
+	copy(result, ss[:index])
+	result[index] = value
+	copy(result[index+1:], ss[index:])
+
+	return result
+}
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Strings) Interleave(ss2 Strings) (ss3 Strings) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+func (ss Strings) Intersect(ss2 Strings) (intersect Strings) {
+	lookup := map[string]struct{}{}
+	for _, value := range ss2 {
+		lookup[value] = struct{}{}
+	}
+
+	for _, value := range ss {
+		if _, ok := lookup[value]; ok {
+			intersect = append(intersect, value)
+
+			// Make sure the same element is not added again.
+			delete(lookup, value)
+		}
+	}
+
+	return
+}
+
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Strings) JSONBytes() []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return data
+}
+
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+// See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Strings) JSONBytesIndent(prefix, indent string) []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return data
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Strings) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return string(data)
+}
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss Strings) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss Strings) KeyByString(fn func(string) string) map[string]string {
+	m := make(map[string]string, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
+
+// Largest returns the n largest elements in descending order. If the slice has
+// less than n elements then all elements are returned. If n < 1 it will return
+// nil.
+//
+// Unlike Sort().Reverse().Top(n) it does not sort the whole slice. Only the n
+// largest elements seen so far are kept, which is much faster when n is small
+// compared to the number of elements.
+//
+// This is not called Top because Top() already returns the first n elements
+// by position, regardless of their value.
+func (ss Strings) Largest(n int) (largest Strings) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(largest) < n {
+			largest = append(largest, s)
+		} else if !(largest[n-1] < s) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the smallest that will be dropped.
+		i := sort.Search(len(largest)-1, func(i int) bool {
+			return largest[i] < s
+		})
+		copy(largest[i+1:], largest[i:len(largest)-1])
+		largest[i] = s
+	}
+
+	return
+}
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss Strings) Last() string {
+	return ss.LastOr("")
+}
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss Strings) LastIndexOf(lookingFor string) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastE returns the last element. Unlike Last, ErrEmptySlice is returned
+// if there are no elements so that a zero value can be distinguished from an
+// empty slice.
+func (ss Strings) LastE() (string, error) {
+	if len(ss) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	return ss[len(ss)-1], nil
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss Strings) LastOr(defaultValue string) string {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss Strings) LastUsing(condition func(string) bool) (string, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return "", false
+}
+
+// Len returns the number of elements.
+func (ss Strings) Len() int {
+	return len(ss)
+}
+
+// StringsLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type StringsLazy struct {
+	iterate func(fn func(string) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss Strings) Lazy() StringsLazy {
+	return StringsLazy{
+		iterate: func(fn func(string) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l StringsLazy) Select(condition func(string) bool) StringsLazy {
+	return StringsLazy{
+		iterate: func(fn func(string) bool) {
+			l.iterate(func(s string) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l StringsLazy) Unselect(condition func(string) bool) StringsLazy {
+	return StringsLazy{
+		iterate: func(fn func(string) bool) {
+			l.iterate(func(s string) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l StringsLazy) Transform(transform func(string) string) StringsLazy {
+	return StringsLazy{
+		iterate: func(fn func(string) bool) {
+			l.iterate(func(s string) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l StringsLazy) Top(n int) StringsLazy {
+	return StringsLazy{
+		iterate: func(fn func(string) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s string) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l StringsLazy) Collect() (ss Strings) {
+	l.iterate(func(s string) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
+
+// Map works the same as Transform, except that fn always receives and returns a
+// string. This allows functions from the strings package (such as
+// strings.Title) to be used directly with slices of custom string types.
+func (ss Strings) Map(fn func(string) string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	mapped := make(Strings, len(ss))
+	for i, s := range ss {
+		mapped[i] = string(fn(string(s)))
+	}
+
+	return mapped
+}
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss Strings) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]string(ss))
+}
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss Strings) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []string{}, nil
+	}
+
+	return []string(ss), nil
+}
+
+// MatchingRegexp returns a new slice containing only the elements that match
+// the regular expression. The returned slice may contain zero elements (nil).
+//
+// NotMatchingRegexp works in the opposite way as MatchingRegexp.
+func (ss Strings) MatchingRegexp(re *regexp.Regexp) (ss2 Strings) {
+	for _, s := range ss {
+		if re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Max is the maximum value, or zero.
+func (ss Strings) Max() (max string) {
+	if len(ss) == 0 {
+		return
+	}
+
+	max = ss[0]
+	for _, s := range ss {
+		if s > max {
+			max = s
+		}
+	}
+
+	return
+}
+
+// MaxE is the maximum value. Unlike Max, ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss Strings) MaxE() (string, error) {
+	if len(ss) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	return ss.Max(), nil
+}
+
+// MergeSorted merges two slices that are already sorted in ascending order into
+// a new sorted slice in O(n+m), without needing to sort again. Duplicate
+// elements are retained. See MergeSortedUnique.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss Strings) MergeSorted(ss2 Strings) (merged Strings) {
+	if len(ss)+len(ss2) == 0 {
+		return nil
+	}
+
+	merged = make(Strings, 0, len(ss)+len(ss2))
+	i, j := 0, 0
+	for i < len(ss) && j < len(ss2) {
+		if ss2[j] < ss[i] {
+			merged = append(merged, ss2[j])
+			j++
+		} else {
+			merged = append(merged, ss[i])
+			i++
+		}
+	}
+
+	merged = append(merged, ss[i:]...)
+
+	return append(merged, ss2[j:]...)
+}
+
+// MergeSortedUnique works the same as MergeSorted, except that each value will
+// only appear once in the result, even if it appears more than once in either
+// slice.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss Strings) MergeSortedUnique(ss2 Strings) (merged Strings) {
+	add := func(value string) {
+		if len(merged) == 0 || merged[len(merged)-1] != value {
+			merged = append(merged, value)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ss) || j < len(ss2) {
+		if i == len(ss) || (j < len(ss2) && ss2[j] < ss[i]) {
+			add(ss2[j])
+			j++
+		} else {
+			add(ss[i])
+			i++
+		}
+	}
+
+	return
+}
+
+// Min is the minimum value, or zero.
+func (ss Strings) Min() (min string) {
+	if len(ss) == 0 {
+		return
+	}
+
+	min = ss[0]
+	for _, s := range ss {
+		if s < min {
+			min = s
+		}
+	}
+
+	return
+}
+
+// MinE is the minimum value. Unlike Min, ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss Strings) MinE() (string, error) {
+	if len(ss) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	return ss.Min(), nil
+}
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss Strings) Mode() (mode Strings) {
+	counts := map[string]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
+
+// NotMatchingRegexp returns a new slice containing only the elements that do
+// not match the regular expression. The returned slice may contain zero
+// elements (nil).
+func (ss Strings) NotMatchingRegexp(re *regexp.Regexp) (ss2 Strings) {
+	for _, s := range ss {
+		if !re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss Strings) OrderBy(less ...func(a, b string) bool) Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Strings, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+
+// PadLeft returns a new slice where each element shorter than width characters
+// has pad repeated on the left until it is width characters. This is useful for
+// right aligning values:
+//
+//   Strings{"7", "42"}.PadLeft(3, " ") // ["  7", " 42"]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss Strings) PadLeft(width int, pad string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(Strings, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = string(string(padding) + string(s))
+	}
+
+	return padded
+}
+
+// PadRight returns a new slice where each element shorter than width
+// characters has pad repeated on the right until it is width characters. This
+// is useful for left aligning values in columns:
+//
+//   Strings{"a", "abc"}.PadRight(4, ".") // ["a...", "abc."]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss Strings) PadRight(width int, pad string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(Strings, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = string(string(s) + string(padding))
+	}
+
+	return padded
+}
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss Strings) Page(pageNumber, pageSize int) Strings {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss Strings) Pairwise() (pairs [][2]string) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]string{ss[i-1], ss[i]})
+	}
+
+	return
+}
+
+// ParseFloat64s converts each element to a float64 with strconv.ParseFloat. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first. See
+// ParseFloat64sSkipInvalid to keep the elements that can be parsed.
+func (ss Strings) ParseFloat64s() (Float64s, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(Float64s, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ParseFloat64sSkipInvalid converts each element to a float64 with
+// strconv.ParseFloat. Unlike ParseFloat64s, elements that cannot be parsed do
+// not stop the conversion. Instead they are returned separately as invalid, so
+// both slices keep the original order of the elements.
+//
+// Either slice may contain zero elements (nil).
+func (ss Strings) ParseFloat64sSkipInvalid() (valid Float64s, invalid Strings) {
+	for _, s := range ss {
+		f, err := strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			invalid = append(invalid, s)
+			continue
+		}
+
+		valid = append(valid, f)
+	}
+
+	return
+}
+
+// ParseInts converts each element to an int with strconv.Atoi. It will stop at
+// the first element that cannot be parsed and return a nil slice with the
+// error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first.
+func (ss Strings) ParseInts() (Ints, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(Ints, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.Atoi(string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ParseTimes converts each element to a time with time.Parse using layout. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error.
+//
+// See Times.Format for the reverse.
+func (ss Strings) ParseTimes(layout string) (Times, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(Times, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = time.Parse(layout, string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss Strings) Partition(condition func(string) bool) (matching, rest Strings) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss Strings) Permutations() (permutations []Strings) {
+	ss.EachPermutation(func(permutation Strings) bool {
+		p := make(Strings, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss Strings) Pop() (last string, rest Strings) {
+	n := len(ss)
+	if n == 0 {
+		return "", nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
+
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
+func (ss Strings) Random(source rand.Source) string {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 1 {
+		return ""
+	}
+	if n < 2 {
+		return ss[0]
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	i := rnd.Intn(n)
+	return ss[i]
+}
+
+// RandomStrings creates a slice of n random strings that each contain
+// length letters and digits.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one.
+func RandomStrings(n, length int, source rand.Source) Strings {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	rnd := rand.New(source)
+	ss := make(Strings, n)
+	for i := range ss {
+		b := make([]byte, length)
+		for j := range b {
+			b[j] = chars[rnd.Intn(len(chars))]
+		}
+
+		ss[i] = string(b)
+	}
+
+	return ss
+}
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see RankMethod.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss Strings) Rank(method RankMethod) Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) && ss[indexes[end]] == ss[indexes[start]] {
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case RankMin:
+			rank = float64(start + 1)
+
+		case RankMax:
+			rank = float64(end)
+
+		case RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss Strings) Reduce(initial string, fn func(acc, value string) string) string {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//   ss.Sort().Reverse()
+//
+func (ss Strings) Reverse() Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]string, len(ss))
+	for i := 0; i < len(ss); i++ {
+		sorted[i] = ss[len(ss)-i-1]
+	}
+
+	return sorted
+}
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss Strings) Rotate(n int) Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(Strings, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss Strings) RotateInPlace(n int) Strings {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s Strings) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss Strings) Sample(n int, source rand.Source) Strings {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(Strings, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss Strings) ReverseInPlace() Strings {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
+
+// Scan implements sql.Scanner so that an array column can be scanned directly
+// into the slice. It accepts a one-dimensional PostgreSQL array literal, such
+// as {1,2,3}.
+//
+// NULL is scanned as an empty slice. An error is returned if any of the
+// elements are NULL.
+func (ss *Strings) Scan(src interface{}) error {
+	return util.ScanArrayLiteral(src, reflect.ValueOf(ss))
+}
+
+// SearchSorted uses a binary search to find value in a slice that is already
+// sorted in ascending order (see Sort). If value exists, index is the position
+// of the first occurrence and found is true. Otherwise, index is the position
+// where value would need to be inserted to keep the slice sorted.
+//
+// The result is undefined if the slice is not sorted.
+func (ss Strings) SearchSorted(value string) (index int, found bool) {
+	index = sort.Search(len(ss), func(i int) bool {
+		return ss[i] >= value
+	})
+
+	return index, index < len(ss) && ss[index] == value
+}
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss Strings) Select(condition func(string) bool) (ss2 Strings) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Smallest returns the n smallest elements in ascending order. If the slice
+// has less than n elements then all elements are returned. If n < 1 it will
+// return nil.
+//
+// Unlike Sort().Top(n) it does not sort the whole slice. Only the n smallest
+// elements seen so far are kept, which is much faster when n is small compared
+// to the number of elements.
+//
+// This is not called Bottom because Bottom() already returns the last n
+// elements by position, regardless of their value.
+func (ss Strings) Smallest(n int) (smallest Strings) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(smallest) < n {
+			smallest = append(smallest, s)
+		} else if !(s < smallest[n-1]) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the largest that will be dropped.
+		i := sort.Search(len(smallest)-1, func(i int) bool {
+			return s < smallest[i]
+		})
+		copy(smallest[i+1:], smallest[i:len(smallest)-1])
+		smallest[i] = s
+	}
+
+	return
+}
+
+// Sort works similar to sort.Strings(). However, unlike sort.Strings the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// See Reverse() and AreSorted().
+func (ss Strings) Sort() Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
 	}
 
 	sorted := make([]string, len(ss))
 	copy(sorted, ss)
 	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+// SortFold works the same as Sort, except that the comparison ignores case.
+// Elements that only differ by case will retain their original order.
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss Strings) SortFold() Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Strings, len(ss))
+	copy(sorted, ss)
+
+	keys := make(map[string]string, len(ss))
+	for _, s := range ss {
+		keys[s] = strings.ToLower(strings.ToUpper(string(s)))
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keys[sorted[i]] < keys[sorted[j]]
+	})
+
+	return sorted
+}
+
+// SortInPlace works the same as Sort, except that the elements are sorted in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Sort if you need to keep the original.
+func (ss Strings) SortInPlace() Strings {
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+
+// SortNatural works the same as Sort, except that runs of digits are compared
+// by their numeric value. That is, "file2" will be sorted before "file10".
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss Strings) SortNatural() Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Strings, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return util.NaturalLess(string(sorted[i]), string(sorted[j]))
+	})
+
+	return sorted
+}
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss Strings) SortStableUsing(less func(a, b string) bool) Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Strings, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss Strings) SortUsing(less func(a, b string) bool) Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Strings, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
 	})
 
 	return sorted
 }
 
-// Shuffle returns shuffled slice by your rand.Source
+// StringsSorted keeps its elements sorted in ascending order as they are
+// inserted. This allows Contains, Index and RangeBetween to use a binary
+// search rather than scanning every element.
+//
+// The zero value is an empty sorted slice that is ready to use.
+type StringsSorted struct {
+	ss Strings
+}
+
+// NewStringsSorted creates a sorted slice containing each of the values.
+// The values are copied so they are not modified.
+func NewStringsSorted(values ...string) *StringsSorted {
+	ss := make(Strings, len(values))
+	copy(ss, values)
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return &StringsSorted{ss: ss}
+}
+
+// search returns the index of the first element that is not less than value.
+func (s *StringsSorted) search(value string) int {
+	return sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] >= value
+	})
+}
+
+// Insert adds each of the values in their sorted position. Each insert is
+// O(log n) to find the position and O(n) to make room for the value.
+func (s *StringsSorted) Insert(values ...string) *StringsSorted {
+	for _, value := range values {
+		i := s.search(value)
+		s.ss = append(s.ss, "")
+		copy(s.ss[i+1:], s.ss[i:])
+		s.ss[i] = value
+	}
+
+	return s
+}
+
+// Remove deletes the first occurrence of value and returns true. If value does
+// not exist false is returned.
+func (s *StringsSorted) Remove(value string) bool {
+	i := s.Index(value)
+	if i == -1 {
+		return false
+	}
+
+	s.ss = append(s.ss[:i], s.ss[i+1:]...)
+
+	return true
+}
+
+// Contains returns true if the value exists.
+func (s *StringsSorted) Contains(value string) bool {
+	return s.Index(value) != -1
+}
+
+// Index returns the position of the first occurrence of value, or -1 if it
+// does not exist.
+func (s *StringsSorted) Index(value string) int {
+	i := s.search(value)
+	if i < len(s.ss) && s.ss[i] == value {
+		return i
+	}
+
+	return -1
+}
+
+// RangeBetween returns a new slice of the elements that are greater than or
+// equal to min and less than or equal to max, in ascending order. If min is
+// greater than max nil is returned.
+func (s *StringsSorted) RangeBetween(min, max string) Strings {
+	start := s.search(min)
+	end := sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] > max
+	})
+
+	if start >= end {
+		return nil
+	}
+
+	result := make(Strings, end-start)
+	copy(result, s.ss[start:end])
+
+	return result
+}
+
+// Len returns the number of elements.
+func (s *StringsSorted) Len() int {
+	return len(s.ss)
+}
+
+// Slice returns a copy of the elements in ascending order. An empty sorted
+// slice returns nil.
+func (s *StringsSorted) Slice() Strings {
+	if len(s.ss) == 0 {
+		return nil
+	}
+
+	result := make(Strings, len(s.ss))
+	copy(result, s.ss)
+
+	return result
+}
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss Strings) Splice(start, deleteCount int, values ...string) Strings {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(Strings, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss Strings) SplitAt(index int) (left, right Strings) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss Strings) Shift() (first string, rest Strings) {
+	if len(ss) == 0 {
+		return "", nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss Strings) Swap(i, j int) Strings {
+	swapped := make(Strings, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss Strings) SwapInPlace(i, j int) Strings {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
+
+// StringsSet is an unordered collection of unique elements backed by a map.
+// Unlike the slice based Contains, checking membership is O(1), which makes it
+// a better fit for membership-heavy workloads.
+//
+// A nil set can be read from but not added to. Use NewStringsSet or convert
+// the result of ToSet to create one.
+type StringsSet map[string]struct{}
+
+// NewStringsSet creates a set containing each of the values. Duplicate values
+// are only stored once.
+func NewStringsSet(values ...string) StringsSet {
+	s := make(StringsSet, len(values))
+
+	return s.Add(values...)
+}
+
+// Set returns a set of the unique elements in the slice.
+func (ss Strings) Set() StringsSet {
+	return NewStringsSet(ss...)
+}
+
+// Add will insert each of the values into the set. The set is modified in place
+// and returned for chaining.
+func (s StringsSet) Add(values ...string) StringsSet {
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+
+	return s
+}
+
+// Remove will delete each of the values from the set. Values that are not in
+// the set are ignored. The set is modified in place and returned for chaining.
+func (s StringsSet) Remove(values ...string) StringsSet {
+	for _, value := range values {
+		delete(s, value)
+	}
+
+	return s
+}
+
+// Contains returns true if the value is in the set.
+func (s StringsSet) Contains(value string) bool {
+	_, ok := s[value]
+
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s StringsSet) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing the elements that are in either set.
+func (s StringsSet) Union(s2 StringsSet) StringsSet {
+	union := make(StringsSet, len(s)+len(s2))
+	for value := range s {
+		union[value] = struct{}{}
+	}
+
+	for value := range s2 {
+		union[value] = struct{}{}
+	}
+
+	return union
+}
+
+// Intersect returns a new set containing the elements that are in both sets.
+func (s StringsSet) Intersect(s2 StringsSet) StringsSet {
+	// Iterate over the smaller set.
+	if len(s2) < len(s) {
+		s, s2 = s2, s
+	}
+
+	intersect := make(StringsSet)
+	for value := range s {
+		if _, ok := s2[value]; ok {
+			intersect[value] = struct{}{}
+		}
+	}
+
+	return intersect
+}
+
+// Diff returns a new set containing the elements that are in s but not in s2.
+//
+// This is different from the slice Diff, which returns both the added and
+// removed elements.
+func (s StringsSet) Diff(s2 StringsSet) StringsSet {
+	diff := make(StringsSet)
+	for value := range s {
+		if _, ok := s2[value]; !ok {
+			diff[value] = struct{}{}
+		}
+	}
+
+	return diff
+}
+
+// Slice returns the elements of the set as a slice in ascending order. An empty
+// set returns nil.
+func (s StringsSet) Slice() Strings {
+	if len(s) == 0 {
+		return nil
+	}
+
+	ss := make(Strings, 0, len(s))
+	for value := range s {
+		ss = append(ss, value)
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
 func (ss Strings) Shuffle(source rand.Source) Strings {
 	n := len(ss)
 
@@ -303,13 +2489,136 @@ func (ss Strings) Shuffle(source rand.Source) Strings {
 	shuffled := make([]string, n)
 	copy(shuffled, ss)
 
-	rnd := rand.New(source)
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	util.Shuffle(rnd, n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss Strings) ShuffleInPlace(source rand.Source) Strings {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
+
+// StringsSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A StringsSync must
+// not be copied after first use.
+type StringsSync struct {
+	mu sync.RWMutex
+	ss Strings
+}
+
+// Append adds the values to the end of the slice.
+func (s *StringsSync) Append(values ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *StringsSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *StringsSync) Snapshot() Strings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(Strings, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss Strings) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *StringsSync) Read(fn func(ss Strings)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss Strings) Take(n int) (ss2 Strings) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(Strings, n)
+	copy(ss2, ss)
 
-	util.Shuffle(rnd, n, func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+	return
+}
 
-	return shuffled
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss Strings) TakeWhile(condition func(string) bool) (ss2 Strings) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
 }
 
 // Top will return n elements from head of the slice
@@ -324,6 +2633,156 @@ func (ss Strings) Top(n int) (top Strings) {
 	return
 }
 
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss Strings) ToChannel() <-chan string {
+	ch := make(chan string)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss Strings) ToChannelCtx(ctx context.Context) <-chan string {
+	ch := make(chan string)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// ToFloat64s transforms each element to a float64.
+func (ss Strings) ToFloat64s(transform func(string) float64) Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss Strings) ToFloat64sErr(transform func(string) (float64, error)) (Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToInts transforms each element to an int.
+func (ss Strings) ToInts(transform func(string) int) Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+
+// ToIntsErr works the same as ToInts, except that transform may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Strings) ToIntsErr(transform func(string) (int, error)) (Ints, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(Ints, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ToLower returns a new slice with each element converted to lower case.
+func (ss Strings) ToLower() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	lower := make(Strings, len(ss))
+	for i, s := range ss {
+		lower[i] = string(strings.ToLower(string(s)))
+	}
+
+	return lower
+}
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss Strings) ToSet() map[string]struct{} {
+	set := make(map[string]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
+
 // ToStrings transforms each element to a string.
 func (ss Strings) ToStrings(transform func(string) string) Strings {
 	l := len(ss)
@@ -341,6 +2800,36 @@ func (ss Strings) ToStrings(transform func(string) string) Strings {
 	return result
 }
 
+// ToUpper returns a new slice with each element converted to upper case.
+func (ss Strings) ToUpper() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	upper := make(Strings, len(ss))
+	for i, s := range ss {
+		upper[i] = string(strings.ToUpper(string(s)))
+	}
+
+	return upper
+}
+
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss Strings) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
+
 // Transform will return a new slice where each element has been transformed.
 // The number of element returned will always be the same as the input.
 //
@@ -360,15 +2849,167 @@ func (ss Strings) Transform(fn func(string) string) (ss2 Strings) {
 	return
 }
 
+// TrimSpace returns a new slice with the leading and trailing white space
+// removed from each element.
+func (ss Strings) TrimSpace() Strings {
+	if ss == nil {
+		return nil
+	}
+
+	trimmed := make(Strings, len(ss))
+	for i, s := range ss {
+		trimmed[i] = string(strings.TrimSpace(string(s)))
+	}
+
+	return trimmed
+}
+
+// Truncate returns a new slice where each element longer than maxLen
+// characters is shortened to maxLen characters, ending with ellipsis:
+//
+//   Strings{"hello world", "hi"}.Truncate(8, "...") // ["hello...", "hi"]
+//
+// Lengths are counted in runes rather than bytes, so multi-byte characters are
+// never split. If ellipsis is longer than maxLen the elements are cut to maxLen
+// characters without it.
+func (ss Strings) Truncate(maxLen int, ellipsis string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	if maxLen < 0 {
+		maxLen = 0
+	}
+
+	ellipsisLen := utf8.RuneCountInString(ellipsis)
+	if ellipsisLen > maxLen {
+		ellipsis, ellipsisLen = "", 0
+	}
+
+	truncated := make(Strings, len(ss))
+	for i, s := range ss {
+		if utf8.RuneCountInString(string(s)) <= maxLen {
+			truncated[i] = s
+			continue
+		}
+
+		runes := []rune(string(s))
+		truncated[i] = string(string(runes[:maxLen-ellipsisLen]) + ellipsis)
+	}
+
+	return truncated
+}
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+func (ss Strings) Union(ss2 Strings) (union Strings) {
+	seen := map[string]struct{}{}
+
+	for _, slice := range []Strings{ss, ss2} {
+		for _, value := range slice {
+			if _, ok := seen[value]; !ok {
+				seen[value] = struct{}{}
+				union = append(union, value)
+			}
+		}
+	}
+
+	return
+}
+
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss Strings) TransformErr(fn func(string) (string, error)) (Strings, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]string, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss Strings) TransformInPlace(fn func(string) string) Strings {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss Strings) TransformParallel(fn func(string) string, workers int) (ss2 Strings) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]string, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
+
 // Unique returns a new slice with all of the unique values.
 //
-// The items will be returned in a randomized order, even with the same input.
+// The items will be returned in the same order that they first appear in the
+// input slice.
 //
 // The number of items returned may be the same as the input or less. It will
 // never return zero items unless then input slice has zero items.
 //
 // A slice with zero elements is considered to be unique.
 //
+// When using slices of pointers it will only compare by address, not value.
+//
 // See AreUnique().
 func (ss Strings) Unique() Strings {
 	// Avoid the allocation. If there is one element or less it is already
@@ -377,20 +3018,78 @@ func (ss Strings) Unique() Strings {
 		return ss
 	}
 
-	values := map[string]struct{}{}
+	seen := map[string]struct{}{}
+	uniqueValues := Strings{}
 
 	for _, value := range ss {
-		values[value] = struct{}{}
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+
+// UniqueFold works the same as Unique, except that elements that only differ
+// by case are considered to be the same. The first appearance of each element
+// is kept, in its original case.
+func (ss Strings) UniqueFold() Strings {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
 	}
 
-	var uniqueValues Strings
-	for value := range values {
-		uniqueValues = append(uniqueValues, value)
+	seen := map[string]struct{}{}
+	uniqueValues := Strings{}
+
+	for _, value := range ss {
+		key := strings.ToLower(strings.ToUpper(string(value)))
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
 	}
 
 	return uniqueValues
 }
 
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *Strings) UnmarshalJSON(data []byte) error {
+	var elements []string
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []string{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *Strings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []string
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []string{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+
 // Unselect works the same as Select, with a negated condition. That is, it will
 // return a new slice only containing the elements that returned false from the
 // condition. The returned slice may contain zero elements (nil).
@@ -403,3 +3102,103 @@ func (ss Strings) Unselect(condition func(string) bool) (ss2 Strings) {
 
 	return
 }
+
+// Value implements driver.Valuer so that the slice can be used as a query
+// argument for an array column. It is encoded as a PostgreSQL array literal,
+// such as {1,2,3}.
+//
+// A nil slice is encoded as an empty array rather than NULL.
+func (ss Strings) Value() (driver.Value, error) {
+	return util.ArrayLiteral(reflect.ValueOf(ss)), nil
+}
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss Strings) Windows(size int) (windows []Strings) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
+
+// WithPrefix returns a new slice containing only the elements that begin with
+// prefix. The returned slice may contain zero elements (nil).
+func (ss Strings) WithPrefix(prefix string) (ss2 Strings) {
+	for _, s := range ss {
+		if strings.HasPrefix(string(s), prefix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// WithSuffix returns a new slice containing only the elements that end with
+// suffix. The returned slice may contain zero elements (nil).
+func (ss Strings) WithSuffix(suffix string) (ss2 Strings) {
+	for _, s := range ss {
+		if strings.HasSuffix(string(s), suffix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// StringsPair is a pair of elements from the same position of two slices.
+type StringsPair struct {
+	First, Second string
+}
+
+// StringsPairs is created by Zip.
+type StringsPairs []StringsPair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss Strings) Zip(ss2 Strings) (pairs StringsPairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, StringsPair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs StringsPairs) Unzip() (ss, ss2 Strings) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss Strings) ZipWith(ss2 Strings, fn func(a, b string) string) (ss3 Strings) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
@@ -1,8 +1,11 @@
 package pie
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -341,12 +344,12 @@ var stringsUniqueTests = []struct {
 	},
 	{
 		Strings{"foo", "bar", "foo"},
-		Strings{"bar", "foo"},
+		Strings{"foo", "bar"},
 		false,
 	},
 	{
 		Strings{"foo", "bar", "qux", "baz"},
-		Strings{"bar", "baz", "foo", "qux"},
+		Strings{"foo", "bar", "qux", "baz"},
 		true,
 	},
 }
@@ -355,10 +358,7 @@ func TestStrings_Unique(t *testing.T) {
 	for _, test := range stringsUniqueTests {
 		t.Run("", func(t *testing.T) {
 			defer assertImmutableStrings(t, &test.ss)()
-
-			// We have to sort the unique slice because it is always returned in
-			// random order.
-			assert.Equal(t, test.unique, test.ss.Unique().Sort())
+			assert.Equal(t, test.unique, test.ss.Unique())
 		})
 	}
 }
@@ -703,3 +703,984 @@ func TestStrings_Random(t *testing.T) {
 		})
 	}
 }
+
+func TestStrings_Reduce(t *testing.T) {
+	concat := func(acc, value string) string {
+		return acc + value
+	}
+
+	assert.Equal(t, "", Strings(nil).Reduce("", concat))
+	assert.Equal(t, ">abc", Strings{"a", "b", "c"}.Reduce(">", concat))
+}
+
+var stringsSetTests = []struct {
+	ss, ss2          Strings
+	intersect, union Strings
+	added, removed   Strings
+}{
+	{
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	},
+	{
+		Strings{"a", "b"},
+		nil,
+		nil,
+		Strings{"a", "b"},
+		nil,
+		Strings{"a", "b"},
+	},
+	{
+		nil,
+		Strings{"a", "b"},
+		nil,
+		Strings{"a", "b"},
+		Strings{"a", "b"},
+		nil,
+	},
+	{
+		Strings{"a", "b", "c"},
+		Strings{"c", "d", "a"},
+		Strings{"a", "c"},
+		Strings{"a", "b", "c", "d"},
+		Strings{"d"},
+		Strings{"b"},
+	},
+	{
+		Strings{"a", "b", "a"},
+		Strings{"b", "b"},
+		Strings{"b"},
+		Strings{"a", "b"},
+		Strings{"b"},
+		Strings{"a", "a"},
+	},
+}
+
+func TestStrings_Intersect(t *testing.T) {
+	for _, test := range stringsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			defer assertImmutableStrings(t, &test.ss2)()
+			assert.Equal(t, test.intersect, test.ss.Intersect(test.ss2))
+		})
+	}
+}
+
+func TestStrings_Union(t *testing.T) {
+	for _, test := range stringsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			defer assertImmutableStrings(t, &test.ss2)()
+			assert.Equal(t, test.union, test.ss.Union(test.ss2))
+		})
+	}
+}
+
+func TestStrings_Diff(t *testing.T) {
+	for _, test := range stringsSetTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			defer assertImmutableStrings(t, &test.ss2)()
+			added, removed := test.ss.Diff(test.ss2)
+			assert.Equal(t, test.added, added)
+			assert.Equal(t, test.removed, removed)
+		})
+	}
+}
+
+func TestStrings_GroupByString(t *testing.T) {
+	firstLetter := func(value string) string {
+		return value[:1]
+	}
+
+	assert.Equal(t, map[string]Strings{}, Strings(nil).GroupByString(firstLetter))
+	assert.Equal(t, map[string]Strings{
+		"a": {"apple", "avocado"},
+		"b": {"banana"},
+	}, Strings{"apple", "banana", "avocado"}.GroupByString(firstLetter))
+}
+
+var stringsChunkTests = []struct {
+	ss       Strings
+	size     int
+	expected []Strings
+}{
+	{nil, 2, nil},
+	{Strings{}, 2, nil},
+	{Strings{"a", "b", "c"}, 0, nil},
+	{Strings{"a", "b", "c"}, 2, []Strings{{"a", "b"}, {"c"}}},
+	{Strings{"a", "b", "c"}, 3, []Strings{{"a", "b", "c"}}},
+}
+
+func TestStrings_Chunk(t *testing.T) {
+	for _, test := range stringsChunkTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Chunk(test.size))
+		})
+	}
+}
+
+var stringsLargestAndSmallestTests = []struct {
+	ss                Strings
+	n                 int
+	largest, smallest Strings
+}{
+	{nil, 2, nil, nil},
+	{Strings{}, 2, nil, nil},
+	{Strings{"a", "b"}, 0, nil, nil},
+	{Strings{"a", "b"}, 3, Strings{"b", "a"}, Strings{"a", "b"}},
+	{Strings{"foo", "bar", "qux", "baz"}, 2, Strings{"qux", "foo"}, Strings{"bar", "baz"}},
+}
+
+func TestStrings_Largest(t *testing.T) {
+	for _, test := range stringsLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.largest, test.ss.Largest(test.n))
+		})
+	}
+}
+
+func TestStrings_Smallest(t *testing.T) {
+	for _, test := range stringsLargestAndSmallestTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.smallest, test.ss.Smallest(test.n))
+		})
+	}
+}
+
+func TestStrings_ShuffleNilSource(t *testing.T) {
+	ss := Strings{"a", "b", "c", "d"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, ss, ss.Shuffle(nil).Sort())
+	assert.True(t, ss.Contains(ss.Random(nil)))
+}
+
+var stringsSampleTests = []struct {
+	ss       Strings
+	n        int
+	source   rand.Source
+	expected Strings
+}{
+	{nil, 2, rand.NewSource(0), nil},
+	{Strings{}, 2, rand.NewSource(0), nil},
+	{Strings{"a", "b", "c"}, 0, rand.NewSource(0), nil},
+	{Strings{"a", "b", "c", "d", "e"}, 2, rand.NewSource(0), Strings{"e", "d"}},
+}
+
+func TestStrings_Sample(t *testing.T) {
+	for _, test := range stringsSampleTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Sample(test.n, test.source))
+		})
+	}
+}
+
+func TestStrings_ToFloat64s(t *testing.T) {
+	length := func(value string) float64 {
+		return float64(len(value))
+	}
+
+	assert.Equal(t, Float64s(nil), Strings(nil).ToFloat64s(length))
+	assert.Equal(t, Float64s(nil), Strings{}.ToFloat64s(length))
+	assert.Equal(t, Float64s{1, 3}, Strings{"a", "foo"}.ToFloat64s(length))
+}
+
+func TestStrings_ToInts(t *testing.T) {
+	assert.Equal(t, Ints(nil), Strings(nil).ToInts(func(string) int {
+		return 0
+	}))
+	assert.Equal(t, Ints(nil), Strings{}.ToInts(func(string) int {
+		return 0
+	}))
+	assert.Equal(t, Ints{0, 1, 3}, Strings{"", "a", "foo"}.ToInts(func(s string) int {
+		return len(s)
+	}))
+}
+
+func TestStrings_ToFloat64sErr(t *testing.T) {
+	parse := func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	}
+
+	floats, err := Strings(nil).ToFloat64sErr(parse)
+	assert.Equal(t, Float64s(nil), floats)
+	assert.NoError(t, err)
+
+	floats, err = Strings{"1.5", "-2"}.ToFloat64sErr(parse)
+	assert.Equal(t, Float64s{1.5, -2}, floats)
+	assert.NoError(t, err)
+
+	floats, err = Strings{"1.5", "foo", "bar"}.ToFloat64sErr(parse)
+	assert.Equal(t, Float64s(nil), floats)
+	assert.EqualError(t, err, `strconv.ParseFloat: parsing "foo": invalid syntax`)
+}
+
+func TestStrings_ToIntsErr(t *testing.T) {
+	ints, err := Strings(nil).ToIntsErr(strconv.Atoi)
+	assert.Equal(t, Ints(nil), ints)
+	assert.NoError(t, err)
+
+	ints, err = Strings{"1", "-23"}.ToIntsErr(strconv.Atoi)
+	assert.Equal(t, Ints{1, -23}, ints)
+	assert.NoError(t, err)
+
+	ints, err = Strings{"1", "foo", "bar"}.ToIntsErr(strconv.Atoi)
+	assert.Equal(t, Ints(nil), ints)
+	assert.EqualError(t, err, `strconv.Atoi: parsing "foo": invalid syntax`)
+}
+
+func TestStrings_EachWithIndex(t *testing.T) {
+	var values []string
+	collect := func(i int, value string) {
+		values = append(values, fmt.Sprintf("%d:%v", i, value))
+	}
+
+	values = []string{}
+	Strings{}.EachWithIndex(collect)
+	assert.Equal(t, []string{}, values)
+
+	values = []string{}
+	ss := Strings{"a", "b"}
+	assert.Equal(t, ss, ss.EachWithIndex(collect))
+	assert.Equal(t, []string{"0:a", "1:b"}, values)
+}
+
+func TestStrings_FirstUsing(t *testing.T) {
+	condition := func(value string) bool {
+		return strings.HasPrefix(value, "b")
+	}
+
+	value, ok := Strings(nil).FirstUsing(condition)
+	assert.Equal(t, "", value)
+	assert.False(t, ok)
+
+	value, ok = Strings{"a", "bar", "baz", "c"}.FirstUsing(condition)
+	assert.Equal(t, "bar", value)
+	assert.True(t, ok)
+}
+
+func TestStrings_LastUsing(t *testing.T) {
+	condition := func(value string) bool {
+		return strings.HasPrefix(value, "b")
+	}
+
+	value, ok := Strings(nil).LastUsing(condition)
+	assert.Equal(t, "", value)
+	assert.False(t, ok)
+
+	value, ok = Strings{"a", "bar", "baz", "c"}.LastUsing(condition)
+	assert.Equal(t, "baz", value)
+	assert.True(t, ok)
+}
+
+var stringsIndexOfTests = []struct {
+	ss                   Strings
+	lookingFor           string
+	indexOf, lastIndexOf int
+}{
+	{nil, "a", -1, -1},
+	{Strings{}, "a", -1, -1},
+	{Strings{"a", "b", "a"}, "a", 0, 2},
+	{Strings{"a", "b", "a"}, "b", 1, 1},
+	{Strings{"a", "b", "a"}, "z", -1, -1},
+}
+
+func TestStrings_IndexOf(t *testing.T) {
+	for _, test := range stringsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.indexOf, test.ss.IndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestStrings_LastIndexOf(t *testing.T) {
+	for _, test := range stringsIndexOfTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.lastIndexOf, test.ss.LastIndexOf(test.lookingFor))
+		})
+	}
+}
+
+func TestStrings_SortStableUsing(t *testing.T) {
+	byLength := func(a, b string) bool {
+		return len(a) < len(b)
+	}
+
+	ss := Strings{"bbb", "a", "cc", "dd", "e"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings(nil), Strings(nil).SortStableUsing(byLength))
+	assert.Equal(t, Strings{"a", "e", "cc", "dd", "bbb"}, ss.SortStableUsing(byLength))
+	assert.Equal(t, Strings{"dd", "cc", "bbb"}, Strings{"cc", "bbb", "dd"}.SortUsing(func(a, b string) bool {
+		return a > b
+	}))
+}
+
+func TestStrings_InPlace(t *testing.T) {
+	assert.Equal(t, Strings(nil), Strings(nil).SortInPlace())
+	assert.Equal(t, Strings(nil), Strings(nil).ReverseInPlace())
+	assert.Equal(t, Strings(nil), Strings(nil).ShuffleInPlace(rand.NewSource(0)))
+	assert.Equal(t, Strings(nil), Strings(nil).TransformInPlace(strings.ToUpper))
+
+	ss := Strings{"b", "c", "a"}
+
+	assert.Equal(t, Strings{"a", "b", "c"}, ss.SortInPlace())
+	assert.Equal(t, Strings{"a", "b", "c"}, ss)
+
+	assert.Equal(t, Strings{"c", "b", "a"}, ss.ReverseInPlace())
+	assert.Equal(t, Strings{"c", "b", "a"}, ss)
+
+	assert.Equal(t, Strings{"C", "B", "A"}, ss.TransformInPlace(strings.ToUpper))
+	assert.Equal(t, Strings{"C", "B", "A"}, ss)
+
+	shuffled := ss.ShuffleInPlace(nil)
+	assert.Equal(t, ss, shuffled)
+	assert.Equal(t, Strings{"A", "B", "C"}, ss.Sort())
+}
+
+var stringsModeTests = []struct {
+	ss       Strings
+	expected Strings
+}{
+	{nil, nil},
+	{Strings{}, nil},
+	{Strings{"a"}, Strings{"a"}},
+	{Strings{"a", "b", "b", "c"}, Strings{"b"}},
+	{Strings{"c", "a", "b", "a", "c"}, Strings{"c", "a"}},
+	{Strings{"c", "b", "a"}, Strings{"c", "b", "a"}},
+}
+
+func TestStrings_Mode(t *testing.T) {
+	for _, test := range stringsModeTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.Mode())
+		})
+	}
+}
+
+func TestStrings_MinEMaxE(t *testing.T) {
+	min, err := Strings(nil).MinE()
+	assert.Equal(t, "", min)
+	assert.Equal(t, ErrEmptySlice, err)
+
+	max, err := Strings{"b", "c", "a"}.MaxE()
+	assert.Equal(t, "c", max)
+	assert.NoError(t, err)
+}
+
+func TestStrings_FirstELastE(t *testing.T) {
+	first, err := Strings{"b", "c", "a"}.FirstE()
+	assert.Equal(t, "b", first)
+	assert.NoError(t, err)
+
+	last, err := Strings(nil).LastE()
+	assert.Equal(t, "", last)
+	assert.Equal(t, ErrEmptySlice, err)
+}
+
+func TestStrings_Equals(t *testing.T) {
+	assert.True(t, Strings(nil).Equals(Strings{}))
+	assert.True(t, Strings{"a", "b"}.Equals(Strings{"a", "b"}))
+	assert.False(t, Strings{"a", "b"}.Equals(Strings{"b", "a"}))
+	assert.True(t, Strings{"a", "b"}.EqualsUnordered(Strings{"b", "a"}))
+	assert.False(t, Strings{"a", "b"}.EqualsUnordered(Strings{"b", "c"}))
+}
+
+func TestStrings_TransformParallel(t *testing.T) {
+	ss := Strings{"a", "b", "c", "d", "e"}
+	assert.Equal(t, Strings(nil), Strings(nil).TransformParallel(strings.ToUpper, 2))
+	assert.Equal(t, Strings{}, Strings{}.TransformParallel(strings.ToUpper, 2))
+	assert.Equal(t, Strings{"A", "B", "C", "D", "E"}, ss.TransformParallel(strings.ToUpper, 2))
+}
+
+func TestStrings_TransformErr(t *testing.T) {
+	quote := func(s string) (string, error) {
+		if s == "" {
+			return "", errors.New("empty")
+		}
+
+		return strconv.Quote(s), nil
+	}
+
+	transformed, err := Strings{"a", "b"}.TransformErr(quote)
+	assert.Equal(t, Strings{`"a"`, `"b"`}, transformed)
+	assert.NoError(t, err)
+
+	transformed, err = Strings{"a", ""}.TransformErr(quote)
+	assert.Equal(t, Strings(nil), transformed)
+	assert.EqualError(t, err, "empty")
+}
+
+var stringsCaseTests = []struct {
+	ss                          Strings
+	toUpper, toLower, trimSpace Strings
+}{
+	{nil, nil, nil, nil},
+	{Strings{}, Strings{}, Strings{}, Strings{}},
+	{
+		Strings{" Foo", "bAR \n", ""},
+		Strings{" FOO", "BAR \n", ""},
+		Strings{" foo", "bar \n", ""},
+		Strings{"Foo", "bAR", ""},
+	},
+}
+
+func TestStrings_ToUpper(t *testing.T) {
+	for _, test := range stringsCaseTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.toUpper, test.ss.ToUpper())
+		})
+	}
+}
+
+func TestStrings_ToLower(t *testing.T) {
+	for _, test := range stringsCaseTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.toLower, test.ss.ToLower())
+		})
+	}
+}
+
+func TestStrings_TrimSpace(t *testing.T) {
+	for _, test := range stringsCaseTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.trimSpace, test.ss.TrimSpace())
+		})
+	}
+}
+
+func TestStrings_Map(t *testing.T) {
+	for _, test := range stringsCaseTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.toUpper, test.ss.Map(strings.ToUpper))
+		})
+	}
+}
+
+var stringsMatchingRegexpTests = []struct {
+	ss          Strings
+	re          *regexp.Regexp
+	matching    Strings
+	notMatching Strings
+}{
+	{nil, regexp.MustCompile(`a`), nil, nil},
+	{Strings{}, regexp.MustCompile(`a`), nil, nil},
+	{Strings{"ERROR: a", "INFO: b", "ERROR: c"}, regexp.MustCompile(`^ERROR`), Strings{"ERROR: a", "ERROR: c"}, Strings{"INFO: b"}},
+	{Strings{"a1", "b2", "c"}, regexp.MustCompile(`\d`), Strings{"a1", "b2"}, Strings{"c"}},
+	{Strings{"a", "b"}, regexp.MustCompile(`z`), nil, Strings{"a", "b"}},
+}
+
+func TestStrings_MatchingRegexp(t *testing.T) {
+	for _, test := range stringsMatchingRegexpTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.matching, test.ss.MatchingRegexp(test.re))
+		})
+	}
+}
+
+func TestStrings_NotMatchingRegexp(t *testing.T) {
+	for _, test := range stringsMatchingRegexpTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.notMatching, test.ss.NotMatchingRegexp(test.re))
+		})
+	}
+}
+
+var stringsFilterTests = []struct {
+	ss                                 Strings
+	s                                  string
+	withPrefix, withSuffix, containing Strings
+}{
+	{nil, "a", nil, nil, nil},
+	{Strings{}, "a", nil, nil, nil},
+	{Strings{"ab", "ba", "bab", "c"}, "a", Strings{"ab"}, Strings{"ba"}, Strings{"ab", "ba", "bab"}},
+	{Strings{"ab", "c"}, "", Strings{"ab", "c"}, Strings{"ab", "c"}, Strings{"ab", "c"}},
+	{Strings{"ab", "c"}, "z", nil, nil, nil},
+}
+
+func TestStrings_WithPrefix(t *testing.T) {
+	for _, test := range stringsFilterTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.withPrefix, test.ss.WithPrefix(test.s))
+		})
+	}
+}
+
+func TestStrings_WithSuffix(t *testing.T) {
+	for _, test := range stringsFilterTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.withSuffix, test.ss.WithSuffix(test.s))
+		})
+	}
+}
+
+func TestStrings_Containing(t *testing.T) {
+	for _, test := range stringsFilterTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.containing, test.ss.Containing(test.s))
+		})
+	}
+}
+
+var stringsSortNaturalTests = []struct {
+	ss       Strings
+	expected Strings
+}{
+	{nil, nil},
+	{Strings{}, Strings{}},
+	{Strings{"a"}, Strings{"a"}},
+	{Strings{"file10", "file2", "file1"}, Strings{"file1", "file2", "file10"}},
+	{Strings{"v1.10.0", "v1.9.2", "v1.9.10"}, Strings{"v1.9.2", "v1.9.10", "v1.10.0"}},
+	{Strings{"a01", "a1", "a001", "a"}, Strings{"a", "a1", "a01", "a001"}},
+	{Strings{"b", "10", "a", "9"}, Strings{"9", "10", "a", "b"}},
+	{Strings{"host10b", "host10a", "host9z"}, Strings{"host9z", "host10a", "host10b"}},
+	{Strings{"x99999999999999999999999", "x100000000000000000000000"}, Strings{"x99999999999999999999999", "x100000000000000000000000"}},
+}
+
+func TestStrings_SortNatural(t *testing.T) {
+	for _, test := range stringsSortNaturalTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.SortNatural())
+		})
+	}
+}
+
+func TestStrings_ContainsFold(t *testing.T) {
+	assert.False(t, Strings(nil).ContainsFold("a"))
+	assert.True(t, Strings{"Bob", "SALLY"}.ContainsFold("sally"))
+	assert.True(t, Strings{"Bob", "SALLY"}.ContainsFold("BOB"))
+	assert.False(t, Strings{"Bob", "SALLY"}.ContainsFold("sal"))
+}
+
+var stringsUniqueFoldTests = []struct {
+	ss       Strings
+	expected Strings
+}{
+	{nil, nil},
+	{Strings{}, Strings{}},
+	{Strings{"A"}, Strings{"A"}},
+	{Strings{"Bob@example.com", "bob@EXAMPLE.com", "sally@example.com", "BOB@example.com"}, Strings{"Bob@example.com", "sally@example.com"}},
+	{Strings{"Go", "go", "GO", "Rust"}, Strings{"Go", "Rust"}},
+}
+
+func TestStrings_UniqueFold(t *testing.T) {
+	for _, test := range stringsUniqueFoldTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.UniqueFold())
+		})
+	}
+}
+
+var stringsSortFoldTests = []struct {
+	ss       Strings
+	expected Strings
+}{
+	{nil, nil},
+	{Strings{}, Strings{}},
+	{Strings{"b"}, Strings{"b"}},
+	{Strings{"b", "C", "a"}, Strings{"a", "b", "C"}},
+	{Strings{"b", "B", "a", "A"}, Strings{"a", "A", "b", "B"}},
+}
+
+func TestStrings_SortFold(t *testing.T) {
+	for _, test := range stringsSortFoldTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableStrings(t, &test.ss)()
+			assert.Equal(t, test.expected, test.ss.SortFold())
+		})
+	}
+}
+
+func TestStrings_CSVString(t *testing.T) {
+	ss := Strings{"a,b", `say "hi"`, ""}
+	csv := "\"a,b\"\n\"say \"\"hi\"\"\"\n\"\"\n"
+
+	assert.Equal(t, "", Strings(nil).CSVString())
+	assert.Equal(t, csv, ss.CSVString())
+
+	decoded, err := StringsFromCSVString(csv)
+	assert.NoError(t, err)
+	assert.Equal(t, ss, decoded)
+}
+
+func TestStrings_ValueAndScan(t *testing.T) {
+	ss := Strings{"a", `b "c"`, `d\e`, "f,g", "", "NULL"}
+	literal := `{"a","b \"c\"","d\\e","f,g","","NULL"}`
+
+	value, err := ss.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, literal, value)
+
+	var scanned Strings
+	assert.NoError(t, scanned.Scan([]byte(literal)))
+	assert.Equal(t, ss, scanned)
+
+	assert.NoError(t, scanned.Scan("{a, b c ,d}"))
+	assert.Equal(t, Strings{"a", "b c", "d"}, scanned)
+
+	assert.Error(t, scanned.Scan(`{"a}`))
+	assert.Error(t, scanned.Scan("{a,NULL}"))
+}
+
+func TestStrings_TakeAndDrop(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"a", "b"}, ss.Take(2))
+	assert.Equal(t, Strings{"c"}, ss.Drop(2))
+	assert.Equal(t, Strings(nil), Strings(nil).Take(2))
+	assert.Equal(t, Strings(nil), ss.Drop(3))
+}
+
+func TestStrings_TakeWhileAndDropWhile(t *testing.T) {
+	ss := Strings{"a", "b", "", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	notEmpty := func(s string) bool {
+		return s != ""
+	}
+
+	assert.Equal(t, Strings{"a", "b"}, ss.TakeWhile(notEmpty))
+	assert.Equal(t, Strings{"", "c"}, ss.DropWhile(notEmpty))
+	assert.Equal(t, Strings(nil), Strings(nil).TakeWhile(notEmpty))
+	assert.Equal(t, Strings(nil), Strings(nil).DropWhile(notEmpty))
+}
+
+func TestStrings_Partition(t *testing.T) {
+	ss := Strings{"Bob", "Jane", "Sally", "John"}
+	defer assertImmutableStrings(t, &ss)()
+
+	matching, rest := ss.Partition(func(name string) bool {
+		return strings.HasPrefix(name, "J")
+	})
+	assert.Equal(t, Strings{"Jane", "John"}, matching)
+	assert.Equal(t, Strings{"Bob", "Sally"}, rest)
+}
+
+func TestStrings_Zip(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, StringsPairs{{"a", "x"}, {"b", "y"}}, ss.Zip(Strings{"x", "y"}))
+	assert.Equal(t, Strings{"ax", "by"}, ss.ZipWith(Strings{"x", "y"}, func(a, b string) string {
+		return a + b
+	}))
+}
+
+func TestFlattenStrings(t *testing.T) {
+	assert.Equal(t, Strings(nil), FlattenStrings(nil))
+	assert.Equal(t, Strings{"a", "b", "c"}, FlattenStrings([]Strings{{"a"}, {"b", "c"}}))
+}
+
+func TestStrings_Interleave(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"a", "x", "b", "c"}, ss.Interleave(Strings{"x"}))
+}
+
+func TestStrings_InsertDeleteAtAndSplice(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"a", "x", "b", "c"}, ss.Insert(1, "x"))
+	assert.Equal(t, Strings{"b"}, ss.DeleteAt(0, 2))
+	assert.Equal(t, Strings{"a", "x", "y"}, ss.Splice(1, 2, "x", "y"))
+}
+
+func TestStrings_PopShiftAndSplitAt(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	last, rest := ss.Pop()
+	assert.Equal(t, "c", last)
+	assert.Equal(t, Strings{"a", "b"}, rest)
+
+	first, rest := ss.Shift()
+	assert.Equal(t, "a", first)
+	assert.Equal(t, Strings{"b", "c"}, rest)
+
+	left, right := ss.SplitAt(2)
+	assert.Equal(t, Strings{"a", "b"}, left)
+	assert.Equal(t, Strings{"c"}, right)
+
+	last, rest = Strings(nil).Pop()
+	assert.Equal(t, "", last)
+	assert.Equal(t, Strings(nil), rest)
+}
+
+func TestStrings_RotateAndSwap(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"b", "c", "a"}, ss.Rotate(-1))
+	assert.Equal(t, Strings{"b", "a", "c"}, ss.Swap(0, 1))
+}
+
+func TestStrings_CompactAndCoalesceOr(t *testing.T) {
+	ss := Strings{"", "a", "", "b"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"a", "b"}, ss.Compact())
+	assert.Equal(t, "a", ss.CoalesceOr("x"))
+	assert.Equal(t, "x", Strings{""}.CoalesceOr("x"))
+}
+
+func TestStrings_WindowsAndPairwise(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, []Strings{{"a", "b"}, {"b", "c"}}, ss.Windows(2))
+	assert.Equal(t, [][2]string{{"a", "b"}, {"b", "c"}}, ss.Pairwise())
+}
+
+func TestStrings_Accumulate(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{">a", ">ab", ">abc"}, ss.Accumulate(">", func(acc, value string) string {
+		return acc + value
+	}))
+}
+
+func TestStrings_SearchSortedAndInsertSorted(t *testing.T) {
+	ss := Strings{"a", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	index, found := ss.SearchSorted("b")
+	assert.Equal(t, 1, index)
+	assert.False(t, found)
+
+	assert.Equal(t, Strings{"a", "b", "c"}, ss.InsertSorted("b"))
+}
+
+func TestStrings_MergeSorted(t *testing.T) {
+	ss := Strings{"a", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"a", "b", "c", "c"}, ss.MergeSorted(Strings{"b", "c"}))
+	assert.Equal(t, Strings{"a", "b", "c"}, ss.MergeSortedUnique(Strings{"b", "c"}))
+}
+
+func TestStrings_FrequenciesAndCountUsing(t *testing.T) {
+	ss := Strings{"red", "blue", "red"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, map[string]int{"red": 2, "blue": 1}, ss.Frequencies())
+	assert.Equal(t, 1, ss.CountUsing(func(s string) bool {
+		return s == "blue"
+	}))
+}
+
+func TestStrings_ToSetAndKeyByString(t *testing.T) {
+	ss := Strings{"a", "bb", "a"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, map[string]struct{}{"a": {}, "bb": {}}, ss.ToSet())
+	assert.Equal(t, map[string]string{"A": "a", "BB": "bb"}, ss.KeyByString(strings.ToUpper))
+}
+
+func TestStringsSet(t *testing.T) {
+	ss := Strings{"c", "a", "b", "a"}
+	defer assertImmutableStrings(t, &ss)()
+
+	s := ss.Set()
+	assert.Equal(t, Strings{"a", "b", "c"}, s.Slice())
+	assert.True(t, s.Contains("b"))
+	assert.Equal(t, Strings{"a", "c"}, s.Diff(NewStringsSet("b", "d")).Slice())
+	assert.Equal(t, Strings{"b"}, s.Intersect(NewStringsSet("b", "d")).Slice())
+	assert.Equal(t, Strings{"a", "b", "c", "d"}, s.Union(NewStringsSet("d")).Slice())
+}
+
+func TestStrings_ArgSort(t *testing.T) {
+	xs := Strings{"c", "a", "b"}
+	ys := Ints{3, 1, 2}
+
+	var sorted Ints
+	for _, i := range xs.ArgSort() {
+		sorted = append(sorted, ys[i])
+	}
+
+	assert.Equal(t, Ints{1, 2, 3}, sorted)
+	assert.Equal(t, 1, xs.ArgMin())
+	assert.Equal(t, 0, xs.ArgMax())
+}
+
+func TestStrings_Rank(t *testing.T) {
+	assert.Equal(t, Float64s{2, 1, 2}, Strings{"b", "a", "b"}.Rank(RankDense))
+}
+
+func TestRandomStrings(t *testing.T) {
+	ss := RandomStrings(3, 8, rand.NewSource(1))
+	assert.Equal(t, 3, len(ss))
+	for _, s := range ss {
+		assert.Equal(t, 8, len(s))
+	}
+
+	assert.Equal(t, ss, RandomStrings(3, 8, rand.NewSource(1)))
+	assert.Equal(t, Strings{""}, RandomStrings(1, 0, nil))
+	assert.Equal(t, Strings(nil), RandomStrings(0, 8, nil))
+}
+
+func TestStrings_Permutations(t *testing.T) {
+	ss := Strings{"a", "b", "c"}
+	defer assertImmutableStrings(t, &ss)()
+
+	permutations := ss.Permutations()
+	assert.Equal(t, 6, len(permutations))
+
+	var joined Strings
+	for _, p := range permutations {
+		joined = append(joined, p.Join(""))
+	}
+	assert.Equal(t, Strings{"abc", "acb", "bac", "bca", "cab", "cba"}, joined.Sort())
+
+	assert.Equal(t, []Strings(nil), Strings(nil).Permutations())
+	assert.Equal(t, []Strings{{"a"}}, Strings{"a"}.Permutations())
+
+	count := 0
+	ss.EachPermutation(func(Strings) bool {
+		count++
+		return count < 2
+	})
+	assert.Equal(t, 2, count)
+}
+
+func TestStrings_Combinations(t *testing.T) {
+	ss := Strings{"a", "b", "c", "d"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, []Strings{
+		{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"},
+	}, ss.Combinations(2))
+	assert.Equal(t, []Strings{{"a"}, {"b"}, {"c"}, {"d"}}, ss.Combinations(1))
+	assert.Equal(t, []Strings{{"a", "b", "c", "d"}}, ss.Combinations(4))
+	assert.Equal(t, []Strings(nil), ss.Combinations(0))
+	assert.Equal(t, []Strings(nil), ss.Combinations(5))
+
+	var first Strings
+	ss.EachCombination(3, func(c Strings) bool {
+		first = c
+		return false
+	})
+	assert.Equal(t, Strings{"a", "b", "c"}, first)
+}
+
+func TestStrings_Cartesian(t *testing.T) {
+	a, b := Strings{"a", "b"}, Strings{"x", "y"}
+
+	assert.Equal(t, StringsPairs{{"a", "x"}, {"a", "y"}, {"b", "x"}, {"b", "y"}}, a.Cartesian(b))
+	assert.Equal(t, StringsPairs(nil), a.Cartesian(nil))
+	assert.Equal(t, StringsPairs(nil), Strings(nil).Cartesian(b))
+}
+
+func TestEachCartesianStrings(t *testing.T) {
+	var all Strings
+	EachCartesianStrings(func(values Strings) bool {
+		all = append(all, values.Join(""))
+		return true
+	}, Strings{"a", "b"}, Strings{"x"}, Strings{"1", "2", "3"})
+
+	assert.Equal(t, Strings{"ax1", "ax2", "ax3", "bx1", "bx2", "bx3"}, all)
+
+	called := false
+	EachCartesianStrings(func(Strings) bool {
+		called = true
+		return true
+	}, Strings{"a"}, nil)
+	assert.False(t, called)
+
+	EachCartesianStrings(func(Strings) bool {
+		called = true
+		return true
+	})
+	assert.False(t, called)
+
+	count := 0
+	EachCartesianStrings(func(Strings) bool {
+		count++
+		return false
+	}, Strings{"a", "b"}, Strings{"x", "y"})
+	assert.Equal(t, 1, count)
+}
+
+func TestStrings_ParseFloat64sAndParseInts(t *testing.T) {
+	ss := Strings{"1.5", "-2", "1e3"}
+	defer assertImmutableStrings(t, &ss)()
+
+	floats, err := ss.ParseFloat64s()
+	assert.NoError(t, err)
+	assert.Equal(t, Float64s{1.5, -2, 1000}, floats)
+
+	floats, err = Strings{"1", "x"}.ParseFloat64s()
+	assert.Equal(t, Float64s(nil), floats)
+	assert.Equal(t, `strconv.ParseFloat: parsing "x": invalid syntax`, err.Error())
+
+	ints, err := Strings{"3", "-1"}.ParseInts()
+	assert.NoError(t, err)
+	assert.Equal(t, Ints{3, -1}, ints)
+
+	ints, err = ss.ParseInts()
+	assert.Equal(t, Ints(nil), ints)
+	assert.Error(t, err)
+
+	floats, err = Strings(nil).ParseFloat64s()
+	assert.NoError(t, err)
+	assert.Equal(t, Float64s(nil), floats)
+}
+
+func TestStrings_ParseFloat64sSkipInvalid(t *testing.T) {
+	valid, invalid := Strings{"1", "", "2.5", "n/a"}.ParseFloat64sSkipInvalid()
+	assert.Equal(t, Float64s{1, 2.5}, valid)
+	assert.Equal(t, Strings{"", "n/a"}, invalid)
+
+	valid, invalid = Strings(nil).ParseFloat64sSkipInvalid()
+	assert.Equal(t, Float64s(nil), valid)
+	assert.Equal(t, Strings(nil), invalid)
+}
+
+func TestStrings_Truncate(t *testing.T) {
+	ss := Strings{"hello world", "hi", "héllo wörld"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"hello...", "hi", "héllo..."}, ss.Truncate(8, "..."))
+	assert.Equal(t, Strings{"he", "hi", "hé"}, ss.Truncate(2, "..."))
+	assert.Equal(t, Strings{"hello w…", "hi", "héllo w…"}, ss.Truncate(8, "…"))
+	assert.Equal(t, Strings{"", "", ""}, ss.Truncate(-1, ""))
+	assert.Equal(t, Strings(nil), Strings(nil).Truncate(3, "..."))
+}
+
+func TestStrings_PadLeftAndPadRight(t *testing.T) {
+	ss := Strings{"7", "42", "1234", "é"}
+	defer assertImmutableStrings(t, &ss)()
+
+	assert.Equal(t, Strings{"  7", " 42", "1234", "  é"}, ss.PadLeft(3, " "))
+	assert.Equal(t, Strings{"7..", "42.", "1234", "é.."}, ss.PadRight(3, "."))
+	assert.Equal(t, Strings{"-=-7", "-=42", "1234", "-=-é"}, ss.PadLeft(4, "-="))
+	assert.Equal(t, Strings{"7-=-", "42-=", "1234", "é-=-"}, ss.PadRight(4, "-="))
+	assert.Equal(t, ss, ss.PadLeft(10, ""))
+	assert.Equal(t, Strings(nil), Strings(nil).PadRight(3, " "))
+}
@@ -0,0 +1,116 @@
+package pie
+
+import (
+	"sort"
+	"time"
+)
+
+//go:generate pie -compare time.Time.Equal Times.*
+type Times []time.Time
+
+// Sort returns a new slice with the times in chronological order. Unlike
+// sort.Slice the input slice is not modified.
+func (ss Times) Sort() Times {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(Times, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	return sorted
+}
+
+// Min is the earliest time, or the zero time if there are no elements.
+func (ss Times) Min() (min time.Time) {
+	if len(ss) == 0 {
+		return
+	}
+
+	min = ss[0]
+	for _, s := range ss {
+		if s.Before(min) {
+			min = s
+		}
+	}
+
+	return
+}
+
+// Max is the latest time, or the zero time if there are no elements.
+func (ss Times) Max() (max time.Time) {
+	if len(ss) == 0 {
+		return
+	}
+
+	max = ss[0]
+	for _, s := range ss {
+		if s.After(max) {
+			max = s
+		}
+	}
+
+	return
+}
+
+// Between returns a new slice containing only the times that are between start
+// and end, inclusive. The returned slice may contain zero elements (nil).
+func (ss Times) Between(start, end time.Time) (ss2 Times) {
+	for _, s := range ss {
+		if !s.Before(start) && !s.After(end) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Truncate returns a new slice where each time has been rounded down to a
+// multiple of d. See time.Time.Truncate.
+func (ss Times) Truncate(d time.Duration) Times {
+	if ss == nil {
+		return nil
+	}
+
+	truncated := make(Times, len(ss))
+	for i, s := range ss {
+		truncated[i] = s.Truncate(d)
+	}
+
+	return truncated
+}
+
+// Round returns a new slice where each time has been rounded to the nearest
+// multiple of d. See time.Time.Round.
+func (ss Times) Round(d time.Duration) Times {
+	if ss == nil {
+		return nil
+	}
+
+	rounded := make(Times, len(ss))
+	for i, s := range ss {
+		rounded[i] = s.Round(d)
+	}
+
+	return rounded
+}
+
+// Format returns a new slice with each time formatted with layout. See
+// time.Time.Format and Strings.ParseTimes.
+func (ss Times) Format(layout string) Strings {
+	if ss == nil {
+		return nil
+	}
+
+	formatted := make(Strings, len(ss))
+	for i, s := range ss {
+		formatted[i] = s.Format(layout)
+	}
+
+	return formatted
+}
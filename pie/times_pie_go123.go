@@ -0,0 +1,49 @@
+//go:build go1.23
+// +build go1.23
+
+package pie
+
+import (
+	"iter"
+	"time"
+)
+
+// CollectTimes creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// CollectTimes is only available when compiling with Go 1.23 or newer.
+func CollectTimes(seq iter.Seq[time.Time]) (ss Times) {
+	for s := range seq {
+		ss = append(ss, s)
+	}
+
+	return
+}
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss Times) Iter() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss Times) IterIndexed() iter.Seq2[int, time.Time] {
+	return func(yield func(int, time.Time) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package pie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+var (
+	time1 = time.Date(2019, 4, 1, 10, 20, 30, 0, time.UTC)
+	time2 = time.Date(2019, 4, 2, 10, 50, 0, 0, time.UTC)
+	time3 = time.Date(2019, 4, 3, 23, 5, 0, 0, time.UTC)
+)
+
+var timesSortTests = []struct {
+	ss       Times
+	sorted   Times
+	min, max time.Time
+}{
+	{nil, nil, time.Time{}, time.Time{}},
+	{Times{}, Times{}, time.Time{}, time.Time{}},
+	{Times{time2}, Times{time2}, time2, time2},
+	{Times{time2, time3, time1}, Times{time1, time2, time3}, time1, time3},
+}
+
+func TestTimes_Sort(t *testing.T) {
+	for _, test := range timesSortTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableTimes(t, &test.ss)()
+			assert.Equal(t, test.sorted, test.ss.Sort())
+		})
+	}
+}
+
+func TestTimes_Min(t *testing.T) {
+	for _, test := range timesSortTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableTimes(t, &test.ss)()
+			assert.Equal(t, test.min, test.ss.Min())
+		})
+	}
+}
+
+func TestTimes_Max(t *testing.T) {
+	for _, test := range timesSortTests {
+		t.Run("", func(t *testing.T) {
+			defer assertImmutableTimes(t, &test.ss)()
+			assert.Equal(t, test.max, test.ss.Max())
+		})
+	}
+}
+
+func TestTimes_Between(t *testing.T) {
+	ss := Times{time3, time1, time2}
+	defer assertImmutableTimes(t, &ss)()
+
+	assert.Equal(t, Times(nil), Times(nil).Between(time1, time3))
+	assert.Equal(t, Times{time3, time1, time2}, ss.Between(time1, time3))
+	assert.Equal(t, Times{time1, time2}, ss.Between(time1, time2))
+	assert.Equal(t, Times(nil), ss.Between(time3, time1))
+}
+
+func TestTimes_Truncate(t *testing.T) {
+	ss := Times{time1, time2}
+	defer assertImmutableTimes(t, &ss)()
+
+	assert.Equal(t, Times(nil), Times(nil).Truncate(time.Hour))
+	assert.Equal(t, Times{
+		time.Date(2019, 4, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2019, 4, 2, 10, 0, 0, 0, time.UTC),
+	}, ss.Truncate(time.Hour))
+}
+
+func TestTimes_Round(t *testing.T) {
+	ss := Times{time1, time2}
+	defer assertImmutableTimes(t, &ss)()
+
+	assert.Equal(t, Times(nil), Times(nil).Round(time.Hour))
+	assert.Equal(t, Times{
+		time.Date(2019, 4, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2019, 4, 2, 11, 0, 0, 0, time.UTC),
+	}, ss.Round(time.Hour))
+}
+
+func TestTimes_Contains(t *testing.T) {
+	assert.True(t, Times{time1, time2}.Contains(time2))
+	assert.False(t, Times{time1, time2}.Contains(time3))
+}
+
+func TestTimes_EqualityIgnoresLocationAndMonotonicClock(t *testing.T) {
+	now := time.Now()
+	utc := now.UTC()
+	wall := now.Round(0)
+	tokyo := now.In(time.FixedZone("JST", 9*60*60))
+
+	// These are all the same instant, but are not equal with ==.
+	assert.False(t, now == utc)
+	assert.False(t, now == wall)
+
+	ss := Times{time1, now}
+	defer assertImmutableTimes(t, &ss)()
+
+	assert.True(t, ss.Contains(utc))
+	assert.True(t, ss.Contains(wall))
+	assert.True(t, ss.Contains(tokyo))
+	assert.Equal(t, 1, ss.IndexOf(tokyo))
+	assert.Equal(t, 2, Times{now, wall, utc}.LastIndexOf(tokyo))
+
+	assert.Equal(t, 2, len(Times{now, time1, utc, wall, tokyo}.Unique()))
+	assert.True(t, ss.Equals(Times{time1.In(tokyo.Location()), wall}))
+	assert.True(t, ss.EqualsUnordered(Times{utc, time1}))
+	assert.Equal(t, Times{now}, Times{time.Time{}.In(tokyo.Location()), now}.Compact())
+}
+
+func TestTimes_CSVString(t *testing.T) {
+	ss := Times{time1, time2}
+	csv := "2019-04-01T10:20:30Z\n2019-04-02T10:50:00Z\n"
+
+	assert.Equal(t, csv, ss.CSVString())
+
+	decoded, err := TimesFromCSVString(csv)
+	assert.NoError(t, err)
+	assert.Equal(t, ss, decoded)
+}
+
+func TestTimes_FormatAndParseTimes(t *testing.T) {
+	ss := Times{time1, time2}
+	defer assertImmutableTimes(t, &ss)()
+
+	formatted := ss.Format(time.RFC3339)
+	assert.Equal(t, Strings{"2019-04-01T10:20:30Z", "2019-04-02T10:50:00Z"}, formatted)
+	assert.Equal(t, Strings(nil), Times(nil).Format(time.RFC3339))
+
+	parsed, err := formatted.ParseTimes(time.RFC3339)
+	assert.NoError(t, err)
+	assert.Equal(t, ss, parsed)
+
+	parsed, err = Strings{"2019-04-01", "yesterday"}.ParseTimes("2006-01-02")
+	assert.Equal(t, Times(nil), parsed)
+	assert.Error(t, err)
+
+	parsed, err = Strings(nil).ParseTimes(time.RFC3339)
+	assert.NoError(t, err)
+	assert.Equal(t, Times(nil), parsed)
+}
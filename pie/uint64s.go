@@ -0,0 +1,4 @@
+package pie
+
+//go:generate pie Uint64s.*
+type Uint64s []uint64
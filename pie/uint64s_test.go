@@ -0,0 +1,62 @@
+package pie
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// These tests are just to make sure that the functions for Uint64s are
+// generated. The more extensive tests for these functions are in ints_test.go
+
+func TestUint64s_Sum(t *testing.T) {
+	assert.Equal(t, uint64(0), Uint64s(nil).Sum())
+	assert.Equal(t, uint64(math.MaxUint64), Uint64s{math.MaxUint64 - 1, 1}.Sum())
+}
+
+func TestUint64s_MinAndMax(t *testing.T) {
+	assert.Equal(t, uint64(1), Uint64s{3, 1, 2}.Min())
+	assert.Equal(t, uint64(math.MaxUint64), Uint64s{3, math.MaxUint64, 2}.Max())
+}
+
+func TestUint64s_Sort(t *testing.T) {
+	assert.Equal(t, Uint64s{1, 2, 3}, Uint64s{3, 1, 2}.Sort())
+}
+
+func TestUint64s_Median(t *testing.T) {
+	assert.Equal(t, uint64(math.MaxUint64-1), Uint64s{math.MaxUint64, math.MaxUint64 - 1}.Median())
+	assert.Equal(t, uint64(math.MaxUint64/2), Uint64s{0, math.MaxUint64}.Median())
+}
+
+func TestUint64s_Average(t *testing.T) {
+	assert.Equal(t, 2.0, Uint64s{3, 1, 2}.Average())
+}
+
+func TestUint64s_SumCheckedAndAverageBig(t *testing.T) {
+	_, err := Uint64s{math.MaxUint64, 1}.SumChecked()
+	assert.Equal(t, ErrOverflow, err)
+
+	average := Uint64s{math.MaxUint64, math.MaxUint64}.AverageBig()
+	assert.Equal(t, new(big.Int).SetUint64(math.MaxUint64), average.Num())
+	assert.True(t, average.IsInt())
+}
+
+func TestRandomUint64s(t *testing.T) {
+	ss := RandomUint64s(1000, 0, math.MaxUint64, rand.NewSource(1))
+	assert.True(t, ss.Min() < math.MaxUint64/4)
+	assert.True(t, ss.Max() > math.MaxUint64/4*3)
+	assert.Len(t, ss.Unique(), 1000)
+
+	assert.Equal(t, Uint64s{math.MaxUint64 - 2, math.MaxUint64 - 1},
+		RandomUint64s(100, math.MaxUint64-2, math.MaxUint64, rand.NewSource(1)).Unique().Sort())
+}
+
+func TestUint64sRange(t *testing.T) {
+	assert.Equal(t, Uint64s{math.MaxUint64 - 2, math.MaxUint64 - 1},
+		Uint64sRange(math.MaxUint64-2, math.MaxUint64, 1))
+	assert.Equal(t, Uint64s{0, math.MaxUint64/2 + 1}, Uint64sRange(0, math.MaxUint64, math.MaxUint64/2+1))
+	assert.Equal(t, Uint64s(nil), Uint64sRange(5, 0, 1))
+}
@@ -0,0 +1,130 @@
+package util
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// MarshalBinary encodes a slice of numbers with a fixed number of bytes for
+// each element in little-endian order. int, uint and uintptr always use 8
+// bytes so that the encoding does not depend on the platform.
+//
+// The first byte records whether the slice was nil, so that UnmarshalBinary
+// can tell the difference between a nil and an empty slice.
+func MarshalBinary(slice reflect.Value) ([]byte, error) {
+	if slice.IsNil() {
+		return []byte{0}, nil
+	}
+
+	width, err := binaryWidth(slice.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 1+width*slice.Len())
+	data[0] = 1
+
+	buf := make([]byte, 8)
+	for i := 0; i < slice.Len(); i++ {
+		v := slice.Index(i)
+
+		switch v.Kind() {
+		case reflect.Float32:
+			binary.LittleEndian.PutUint32(buf, math.Float32bits(float32(v.Float())))
+
+		case reflect.Float64:
+			binary.LittleEndian.PutUint64(buf, math.Float64bits(v.Float()))
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			binary.LittleEndian.PutUint64(buf, uint64(v.Int()))
+
+		default:
+			binary.LittleEndian.PutUint64(buf, v.Uint())
+		}
+
+		copy(data[1+i*width:], buf[:width])
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes data created with MarshalBinary and sets the slice
+// that slicePtr points to.
+func UnmarshalBinary(data []byte, slicePtr reflect.Value) error {
+	sliceType := slicePtr.Type().Elem()
+
+	width, err := binaryWidth(sliceType.Elem())
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 || data[0] > 1 {
+		return errors.New("invalid binary data")
+	}
+
+	if data[0] == 0 {
+		if len(data) != 1 {
+			return errors.New("invalid binary data")
+		}
+
+		slicePtr.Elem().Set(reflect.Zero(sliceType))
+
+		return nil
+	}
+
+	data = data[1:]
+	if len(data)%width != 0 {
+		return fmt.Errorf("invalid binary data length for %s", sliceType)
+	}
+
+	n := len(data) / width
+	result := reflect.MakeSlice(sliceType, n, n)
+	buf := make([]byte, 8)
+
+	for i := 0; i < n; i++ {
+		copy(buf, data[i*width:(i+1)*width])
+		bits := binary.LittleEndian.Uint64(buf)
+		v := result.Index(i)
+
+		switch v.Kind() {
+		case reflect.Float32:
+			v.SetFloat(float64(math.Float32frombits(uint32(bits))))
+
+		case reflect.Float64:
+			v.SetFloat(math.Float64frombits(bits))
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			// Sign extend values that are less than 8 bytes.
+			shift := uint(64 - 8*width)
+			v.SetInt(int64(bits<<shift) >> shift)
+
+		default:
+			v.SetUint(bits)
+		}
+
+		for j := range buf {
+			buf[j] = 0
+		}
+	}
+
+	slicePtr.Elem().Set(result)
+
+	return nil
+}
+
+func binaryWidth(t reflect.Type) (int, error) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		return 8, nil
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return int(t.Size()), nil
+	}
+
+	return 0, fmt.Errorf("cannot binary encode %s", t)
+}
@@ -0,0 +1,167 @@
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CSVString encodes the slice as CSV.
+//
+// If the elements are structs (or pointers to structs) the first record will
+// be a header containing the names of the exported fields, followed by one
+// record for each element. A nil pointer is encoded as the zero value.
+//
+// Otherwise, each element will be encoded as a record with a single field.
+func CSVString(slice reflect.Value) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if structType, ok := csvStructType(slice.Type().Elem()); ok {
+		fields := csvFields(structType)
+
+		header := make([]string, len(fields))
+		for i, field := range fields {
+			header[i] = structType.Field(field).Name
+		}
+		writeCSVRecord(w, &buf, header)
+
+		for i := 0; i < slice.Len(); i++ {
+			element := reflect.Indirect(slice.Index(i))
+			if !element.IsValid() {
+				element = reflect.Zero(structType)
+			}
+
+			record := make([]string, len(fields))
+			for j, field := range fields {
+				record[j] = formatValue(element.Field(field))
+			}
+			writeCSVRecord(w, &buf, record)
+		}
+	} else {
+		for i := 0; i < slice.Len(); i++ {
+			writeCSVRecord(w, &buf, []string{formatValue(slice.Index(i))})
+		}
+	}
+
+	// Writing to a bytes.Buffer cannot fail.
+	w.Flush()
+
+	return buf.String()
+}
+
+// ParseCSV decodes the CSV in s and sets the slice that slicePtr points to. It
+// is the opposite of CSVString.
+//
+// If the elements are structs (or pointers to structs) the first record must
+// be a header of field names. Otherwise, each field of every record will be
+// decoded as a separate element.
+//
+// The slice will be nil if there are no elements.
+func ParseCSV(s string, slicePtr reflect.Value) error {
+	r := csv.NewReader(strings.NewReader(s))
+	sliceType := slicePtr.Type().Elem()
+	elementType := sliceType.Elem()
+	result := reflect.Zero(sliceType)
+
+	structType, isStruct := csvStructType(elementType)
+	if !isStruct {
+		r.FieldsPerRecord = -1
+	}
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return err
+	}
+
+	if isStruct {
+		if len(records) == 0 {
+			slicePtr.Elem().Set(result)
+			return nil
+		}
+
+		fields := make([]int, len(records[0]))
+		for i, name := range records[0] {
+			field, ok := structType.FieldByName(name)
+			if !ok || field.PkgPath != "" || len(field.Index) != 1 {
+				return fmt.Errorf("unknown CSV column %q", name)
+			}
+
+			fields[i] = field.Index[0]
+		}
+
+		for i, record := range records[1:] {
+			element := reflect.New(structType)
+			for j, value := range record {
+				err := parseValue(element.Elem().Field(fields[j]), value)
+				if err != nil {
+					return fmt.Errorf("record %d: %v", i+2, err)
+				}
+			}
+
+			if elementType.Kind() == reflect.Ptr {
+				result = reflect.Append(result, element)
+			} else {
+				result = reflect.Append(result, element.Elem())
+			}
+		}
+	} else {
+		for i, record := range records {
+			for _, value := range record {
+				element := reflect.New(elementType).Elem()
+				if err := parseValue(element, value); err != nil {
+					return fmt.Errorf("record %d: %v", i+1, err)
+				}
+
+				result = reflect.Append(result, element)
+			}
+		}
+	}
+
+	slicePtr.Elem().Set(result)
+
+	return nil
+}
+
+// writeCSVRecord works like w.Write, except that a record containing a single
+// empty field is quoted. Otherwise it would be written as a blank line, which
+// is skipped when reading.
+func writeCSVRecord(w *csv.Writer, buf *bytes.Buffer, record []string) {
+	if len(record) == 1 && record[0] == "" {
+		w.Flush()
+		buf.WriteString("\"\"\n")
+
+		return
+	}
+
+	w.Write(record)
+}
+
+// csvStructType returns the struct type if elements of t should be encoded as
+// a record of fields, rather than a single value.
+func csvStructType(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct ||
+		t.Implements(textMarshalerType) ||
+		reflect.PtrTo(t).Implements(textMarshalerType) {
+		return nil, false
+	}
+
+	return t, true
+}
+
+// csvFields returns the index of each exported field.
+func csvFields(t reflect.Type) (fields []int) {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			fields = append(fields, i)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package util
+
+// NaturalLess reports whether a sorts before b using natural ordering. Runs of
+// decimal digits are compared by their numeric value, so "file2" sorts before
+// "file10". All other bytes are compared lexically.
+//
+// If two runs of digits have the same value, the run with fewer leading zeros
+// sorts first so that the ordering is consistent.
+func NaturalLess(a, b string) bool {
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				return a[i] < b[j]
+			}
+
+			i++
+			j++
+			continue
+		}
+
+		// Find the end of both runs of digits.
+		endA, endB := i, j
+		for endA < len(a) && isDigit(a[endA]) {
+			endA++
+		}
+		for endB < len(b) && isDigit(b[endB]) {
+			endB++
+		}
+
+		// Ignore the leading zeros so that the length of the remaining digits
+		// can be compared first. This avoids overflowing for large numbers.
+		startA, startB := i, j
+		for startA < endA-1 && a[startA] == '0' {
+			startA++
+		}
+		for startB < endB-1 && b[startB] == '0' {
+			startB++
+		}
+
+		numberA, numberB := a[startA:endA], b[startB:endB]
+		if len(numberA) != len(numberB) {
+			return len(numberA) < len(numberB)
+		}
+
+		if numberA != numberB {
+			return numberA < numberB
+		}
+
+		if endA-i != endB-j {
+			return endA-i < endB-j
+		}
+
+		i, j = endA, endB
+	}
+
+	return len(a)-i < len(b)-j
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
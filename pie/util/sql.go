@@ -0,0 +1,177 @@
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ArrayLiteral encodes the slice as a PostgreSQL array literal, such as
+// {1,2.5} or {"a","b"}. Strings are always quoted.
+//
+// A nil slice is encoded as an empty array ({}) rather than NULL.
+func ArrayLiteral(slice reflect.Value) string {
+	var b strings.Builder
+	b.WriteByte('{')
+
+	for i := 0; i < slice.Len(); i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		v := slice.Index(i)
+		s := formatValue(v)
+
+		switch v.Kind() {
+		case reflect.String:
+			b.WriteByte('"')
+			for _, c := range []byte(s) {
+				if c == '"' || c == '\\' {
+					b.WriteByte('\\')
+				}
+				b.WriteByte(c)
+			}
+			b.WriteByte('"')
+
+		case reflect.Float32, reflect.Float64:
+			switch s {
+			case "+Inf":
+				s = "Infinity"
+			case "-Inf":
+				s = "-Infinity"
+			}
+			b.WriteString(s)
+
+		default:
+			b.WriteString(s)
+		}
+	}
+
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+// ScanArrayLiteral decodes a one-dimensional PostgreSQL array literal from src
+// and sets the slice that slicePtr points to. It is the opposite of
+// ArrayLiteral. src may be a string, []byte or nil.
+//
+// A nil src (NULL) is decoded as an empty slice. An error is returned if any
+// of the elements are NULL.
+func ScanArrayLiteral(src interface{}, slicePtr reflect.Value) error {
+	sliceType := slicePtr.Type().Elem()
+
+	var s string
+	switch src := src.(type) {
+	case nil:
+		slicePtr.Elem().Set(reflect.MakeSlice(sliceType, 0, 0))
+		return nil
+
+	case []byte:
+		s = string(src)
+
+	case string:
+		s = src
+
+	default:
+		return fmt.Errorf("cannot scan %T into %s", src, sliceType)
+	}
+
+	elements, err := splitArrayLiteral(s)
+	if err != nil {
+		return err
+	}
+
+	result := reflect.MakeSlice(sliceType, 0, len(elements))
+	for i, element := range elements {
+		if element.null {
+			return fmt.Errorf("cannot scan NULL element %d into %s", i, sliceType)
+		}
+
+		v := reflect.New(sliceType.Elem()).Elem()
+		if err := parseValue(v, element.value); err != nil {
+			return fmt.Errorf("element %d: %v", i, err)
+		}
+
+		result = reflect.Append(result, v)
+	}
+
+	slicePtr.Elem().Set(result)
+
+	return nil
+}
+
+type arrayElement struct {
+	value string
+	null  bool
+}
+
+func splitArrayLiteral(s string) (elements []arrayElement, err error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid array literal: %q", s)
+	}
+
+	literal := s[1 : len(s)-1]
+	if strings.TrimSpace(literal) == "" {
+		return nil, nil
+	}
+
+	i := 0
+	for {
+		for i < len(literal) && literal[i] == ' ' {
+			i++
+		}
+
+		var element arrayElement
+
+		if i < len(literal) && literal[i] == '"' {
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(literal) {
+					return nil, fmt.Errorf("invalid array literal: %q", s)
+				}
+
+				if literal[i] == '"' {
+					i++
+					break
+				}
+
+				if literal[i] == '\\' && i+1 < len(literal) {
+					i++
+				}
+
+				b.WriteByte(literal[i])
+			}
+
+			element.value = b.String()
+		} else {
+			start := i
+			for i < len(literal) && literal[i] != ',' {
+				if literal[i] == '{' || literal[i] == '"' {
+					return nil, fmt.Errorf("invalid array literal: %q", s)
+				}
+
+				i++
+			}
+
+			element.value = strings.TrimSpace(literal[start:i])
+			element.null = strings.EqualFold(element.value, "NULL")
+		}
+
+		elements = append(elements, element)
+
+		for i < len(literal) && literal[i] == ' ' {
+			i++
+		}
+
+		if i == len(literal) {
+			return elements, nil
+		}
+
+		if literal[i] != ',' {
+			return nil, fmt.Errorf("invalid array literal: %q", s)
+		}
+
+		i++
+	}
+}
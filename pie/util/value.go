@@ -0,0 +1,101 @@
+package util
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// formatValue encodes a single value as text. encoding.TextMarshaler is used
+// if it is implemented.
+func formatValue(v reflect.Value) string {
+	if v.Type().Implements(textMarshalerType) {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return ""
+		}
+
+		text, _ := v.Interface().(encoding.TextMarshaler).MarshalText()
+
+		return string(text)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+
+		return formatValue(v.Elem())
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// parseValue decodes the text in s into v. It is the opposite of formatValue.
+func parseValue(v reflect.Value, s string) (err error) {
+	if reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, v.Type().Bits())
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, v.Type().Bits())
+		v.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, v.Type().Bits())
+		v.SetFloat(f)
+
+	case reflect.Ptr:
+		if s == "" {
+			return nil
+		}
+
+		v.Set(reflect.New(v.Type().Elem()))
+
+		return parseValue(v.Elem(), s)
+
+	default:
+		return fmt.Errorf("cannot decode into %s", v.Type())
+	}
+
+	return
+}
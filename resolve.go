@@ -0,0 +1,188 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elliotchance/pie/functions"
+)
+
+// checkedPackages caches the result of checkPackage because type checking can
+// be slow.
+var checkedPackages = map[string]*types.Package{}
+
+// checkPackage type checks the package so that types can be resolved through
+// other named types, aliases and types declared in other packages.
+//
+// Errors are ignored because the package may use methods that have not been
+// generated yet. The information that could be resolved is still returned.
+//
+// Imports are type checked from source with the "source" importer rather than
+// golang.org/x/tools/go/packages, which keeps pie free of dependencies. It
+// finds packages with go/build, which asks the go command in module mode, so
+// imports from other modules (including replace directives) and vendor
+// directories are resolved the same way as when building the package.
+func checkPackage(fset *token.FileSet, pkgs map[string]*ast.Package, packageName string) *types.Package {
+	if checked, ok := checkedPackages[packageName]; ok {
+		return checked
+	}
+
+	var fileNames []string
+	for fileName := range pkgs[packageName].Files {
+		if !strings.HasSuffix(fileName, "_test.go") {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+
+	sort.Strings(fileNames)
+
+	var files []*ast.File
+	for _, fileName := range fileNames {
+		files = append(files, pkgs[packageName].Files[fileName])
+	}
+
+	config := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	checked, _ := config.Check(packageName, fset, files, nil)
+	checkedPackages[packageName] = checked
+
+	return checked
+}
+
+// resolveType returns the key and element types for a slice or map type that
+// is declared in terms of another named type, such as:
+//
+//	type IDs uuid.UUIDs
+//
+// Types from other packages are qualified by their package name and the import
+// paths are returned.
+func resolveType(checked *types.Package, name string) (keyType, elementType string, imports []string, ok bool) {
+	obj := checked.Scope().Lookup(name)
+	if obj == nil {
+		return
+	}
+
+	qualifier := func(pkg *types.Package) string {
+		if pkg == checked {
+			return ""
+		}
+
+		imports = append(imports, strconv.Quote(pkg.Path()))
+
+		return pkg.Name()
+	}
+
+	switch t := obj.Type().Underlying().(type) {
+	case *types.Slice:
+		return "", types.TypeString(t.Elem(), qualifier), imports, true
+
+	case *types.Map:
+		return types.TypeString(t.Key(), qualifier),
+			types.TypeString(t.Elem(), qualifier), imports, true
+	}
+
+	return
+}
+
+// resolveKind uses the underlying type of the elements of the named slice type
+// to find the kind. For example, "type ID = int" or "type Code string" are
+// not known by name but can be treated as a number or string.
+//
+// Zero is returned if the kind cannot be resolved.
+func resolveKind(checked *types.Package, name string) int {
+	elem := elementTypeOf(checked, name)
+	if elem == nil {
+		return 0
+	}
+
+	basic, ok := elem.Underlying().(*types.Basic)
+	if !ok {
+		return 0
+	}
+
+	switch info := basic.Info(); {
+	case info&types.IsFloat != 0:
+		return functions.ForNumbers | functions.ForFloats
+
+	case info&types.IsInteger != 0:
+		return functions.ForNumbers | functions.ForIntegers
+
+	case info&types.IsNumeric != 0:
+		return functions.ForNumbers
+
+	case info&types.IsString != 0:
+		return functions.ForStrings
+
+	case info&types.IsBoolean != 0:
+		return functions.ForBools
+	}
+
+	return 0
+}
+
+// hasEqualsMethod returns true if the elements of the named slice type have a
+// method with the signature:
+//
+//	func (T) Equals(other T) bool
+func hasEqualsMethod(checked *types.Package, name string) bool {
+	elem := elementTypeOf(checked, name)
+	if elem == nil {
+		return false
+	}
+
+	selection := types.NewMethodSet(elem).Lookup(nil, "Equals")
+	if selection == nil {
+		return false
+	}
+
+	signature := selection.Type().(*types.Signature)
+
+	return signature.Params().Len() == 1 && signature.Results().Len() == 1 &&
+		types.Identical(signature.Params().At(0).Type(), elem) &&
+		types.Identical(signature.Results().At(0).Type(), types.Typ[types.Bool])
+}
+
+// structFieldType returns the type of the field of the named struct type, or
+// nil if it cannot be resolved.
+func structFieldType(checked *types.Package, structName, fieldName string) types.Type {
+	obj := checked.Scope().Lookup(structName)
+	if obj == nil {
+		return nil
+	}
+
+	structType, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+
+	for i := 0; i < structType.NumFields(); i++ {
+		if field := structType.Field(i); field.Name() == fieldName {
+			return field.Type()
+		}
+	}
+
+	return nil
+}
+
+// elementTypeOf returns the type of the elements of the named slice type, or nil
+// if it cannot be resolved.
+func elementTypeOf(checked *types.Package, name string) types.Type {
+	obj := checked.Scope().Lookup(name)
+	if obj == nil {
+		return nil
+	}
+
+	slice, ok := obj.Type().Underlying().(*types.Slice)
+	if !ok {
+		return nil
+	}
+
+	return slice.Elem()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/elliotchance/pie/functions"
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+// writeTestFiles creates each of the files, relative to dir.
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+}
+
+func TestCheckPackage_ResolvesTypesFromAnotherModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pie")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeTestFiles(t, dir, map[string]string{
+		"ids/go.mod": "module example.com/ids\n\ngo 1.12\n",
+		"ids/ids.go": "package ids\n\ntype ID int64\n\ntype IDs []ID\n",
+		"app/go.mod": "module example.com/app\n\ngo 1.12\n\n" +
+			"require example.com/ids v0.0.0\n\n" +
+			"replace example.com/ids => ../ids\n",
+		"app/app.go": "package resolvetest\n\n" +
+			"import \"example.com/ids\"\n\n" +
+			"type UserIDs ids.IDs\n",
+	})
+
+	// The source importer finds packages relative to the current directory,
+	// the same as when pie is run by go generate.
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	defer os.Chdir(wd)
+	assert.NoError(t, os.Chdir(filepath.Join(dir, "app")))
+
+	// The replaced module is only resolved in module mode, and the network
+	// must not be needed.
+	defer os.Setenv("GOFLAGS", os.Getenv("GOFLAGS"))
+	defer os.Setenv("GOPROXY", os.Getenv("GOPROXY"))
+	os.Setenv("GOFLAGS", "-mod=mod")
+	os.Setenv("GOPROXY", "off")
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", nil, parser.ParseComments)
+	assert.NoError(t, err)
+
+	checked := checkPackage(fset, pkgs, "resolvetest")
+
+	keyType, elementType, imports, ok := resolveType(checked, "UserIDs")
+	assert.True(t, ok)
+	assert.Equal(t, "", keyType)
+	assert.Equal(t, "ids.ID", elementType)
+	assert.Equal(t, []string{`"example.com/ids"`}, imports)
+
+	assert.Equal(t, functions.ForNumbers|functions.ForIntegers, resolveKind(checked, "UserIDs"))
+}
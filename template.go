@@ -4,17 +4,100 @@ package main
 var pieTemplates = map[string]string{
 	"Abs": `package functions
 
-import (
-	"math"
-)
-
-// Abs is a function which returns the absolute value of all the
-// elements in the slice.
+// Abs returns a new slice with the absolute value of each element. The input
+// slice is not modified.
+//
+// For signed integers the absolute value of the smallest value cannot be
+// represented, so it is returned unchanged. For example, math.MinInt64 for
+// Int64s.
 func (ss SliceType) Abs() SliceType {
-	for i, val := range ss {
-		ss[i] = ElementType(math.Abs(float64(val)))
+	if ss == nil {
+		return nil
 	}
-	return ss
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		if s < 0 {
+			s = -s
+		}
+
+		result[i] = s
+	}
+
+	return result
+}
+`,
+	"Accumulate": `package functions
+
+// Accumulate works like Reduce, except that it returns a new slice containing
+// the accumulator after each element. It is sometimes called a scan or a
+// running fold. For example, a running maximum:
+//
+//   Ints{1, 3, 2, 5}.Accumulate(0, func(acc, value int) int {
+//       if value > acc {
+//           return value
+//       }
+//
+//       return acc
+//   }) // Ints{1, 3, 3, 5}
+//
+// The result has the same number of elements as the input, and does not
+// include initial. Accumulate with addition and an initial value of zero is
+// the same as CumulativeSum.
+func (ss SliceType) Accumulate(initial ElementType, fn func(acc, value ElementType) ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	accumulated := make(SliceType, len(ss))
+	acc := initial
+	for i, value := range ss {
+		acc = fn(acc, value)
+		accumulated[i] = acc
+	}
+
+	return accumulated
+}
+`,
+	"Add": `package functions
+
+// Add returns a new slice where each element is the sum of the elements at the
+// same position in ss and ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss SliceType) Add(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] + ss2[i]
+	}
+
+	return result
+}
+`,
+	"AddScalar": `package functions
+
+// AddScalar returns a new slice with value added to each element.
+func (ss SliceType) AddScalar(value ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = s + value
+	}
+
+	return result
 }
 `,
 	"All": `package functions
@@ -32,6 +115,21 @@ func (ss SliceType) All(fn func(value ElementType) bool) bool {
 
 	return true
 }
+`,
+	"AllTrue": `package functions
+
+// AllTrue returns true if all of the elements are true.
+//
+// If the list is empty then true is always returned.
+func (ss BoolSliceType) AllTrue() bool {
+	for _, s := range ss {
+		if !s {
+			return false
+		}
+	}
+
+	return true
+}
 `,
 	"Any": `package functions
 
@@ -48,16 +146,40 @@ func (ss SliceType) Any(fn func(value ElementType) bool) bool {
 
 	return false
 }
+`,
+	"AnyTrue": `package functions
+
+// AnyTrue returns true if at least one of the elements is true.
+//
+// If the list is empty then false is always returned.
+func (ss BoolSliceType) AnyTrue() bool {
+	for _, s := range ss {
+		if s {
+			return true
+		}
+	}
+
+	return false
+}
 `,
 	"Append": `package functions
 
-// Append will return a new slice with the elements appended to the end. It is a
-// wrapper for the internal append(). It is offered as a function so that it can
-// more easily chained.
+// Append will return a new slice with the elements appended to the end.
+//
+// Unlike the builtin append(), the result never shares the underlying array of
+// ss so appending to the same slice more than once is safe.
 //
 // It is acceptable to provide zero arguments.
 func (ss SliceType) Append(elements ...ElementType) SliceType {
-	return append(ss, elements...)
+	// Avoid the allocation.
+	if len(elements) == 0 {
+		return ss
+	}
+
+	result := make(SliceType, len(ss), len(ss)+len(elements))
+	copy(result, ss)
+
+	return append(result, elements...)
 }
 `,
 	"AreSorted": `package functions
@@ -81,6 +203,82 @@ func (ss SliceType) AreSorted() bool {
 func (ss SliceType) AreUnique() bool {
 	return ss.Unique().Len() == ss.Len()
 }
+`,
+	"ArgMax": `package functions
+
+// ArgMax returns the index of the largest element. If there are several
+// largest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Max to get the value instead.
+func (ss SliceType) ArgMax() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s > ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+`,
+	"ArgMin": `package functions
+
+// ArgMin returns the index of the smallest element. If there are several
+// smallest elements the index of the first one is returned. -1 is returned if
+// there are no elements.
+//
+// See Min to get the value instead.
+func (ss SliceType) ArgMin() int {
+	if len(ss) == 0 {
+		return -1
+	}
+
+	index := 0
+	for i, s := range ss[1:] {
+		if s < ss[index] {
+			index = i + 1
+		}
+	}
+
+	return index
+}
+`,
+	"ArgSort": `package functions
+
+import (
+	"sort"
+)
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
+//
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order. The input slice is not modified
+// and nil is returned if there are no elements.
+func (ss SliceType) ArgSort() []int {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ss[indexes[i]] < ss[indexes[j]]
+	})
+
+	return indexes
+}
 `,
 	"Average": `package functions
 
@@ -94,498 +292,5856 @@ func (ss SliceType) Average() float64 {
 	return 0
 }
 `,
-	"Bottom": `package functions
+	"AverageAccurate": `package functions
 
-// Bottom will return n elements from bottom
-//
-// that means that elements is taken from the end of the slice
-// for this [1,2,3] slice with n == 2 will be returned [3,2]
-// if the slice has less elements then n that'll return all elements
-// if n < 0 it'll return empty slice.
-func (ss SliceType) Bottom(n int) (top SliceType) {
-	var lastIndex = len(ss) - 1
-	for i := lastIndex; i > -1 && n > 0; i-- {
-		top = append(top, ss[i])
-		n--
+// AverageAccurate is the same as Average except that the elements are added
+// with SumAccurate, which is more accurate for large slices. Zero is returned
+// if there are no elements.
+func (ss SliceType) AverageAccurate() float64 {
+	if l := len(ss); l > 0 {
+		return float64(ss.SumAccurate()) / float64(l)
 	}
 
-	return
+	return 0
 }
 `,
-	"Contains": `package functions
+	"AverageBig": `package functions
 
-// Contains returns true if the element exists in the slice.
+import (
+	"math/big"
+)
+
+// AverageBig is the exact average of all of the elements. The sum is
+// calculated with a big.Int so it cannot overflow, unlike Average which sums
+// the elements with the element type.
 //
-// When using slices of pointers it will only compare by address, not value.
-func (ss SliceType) Contains(lookingFor ElementType) bool {
+// Zero is returned if there are no elements.
+func (ss SliceType) AverageBig() *big.Rat {
+	if len(ss) == 0 {
+		return new(big.Rat)
+	}
+
+	sum, value := new(big.Int), new(big.Int)
 	for _, s := range ss {
-		if s == lookingFor {
-			return true
+		if s < 0 {
+			value.SetInt64(int64(s))
+		} else {
+			value.SetUint64(uint64(s))
 		}
+
+		sum.Add(sum, value)
 	}
 
-	return false
+	return new(big.Rat).SetFrac(sum, big.NewInt(int64(len(ss))))
 }
 `,
-	"Each": `package functions
+	"AverageSkipNaN": `package functions
 
-// Each is more condensed version of Transform that allows an action to happen
-// on each elements and pass the original slice on.
-//
-//   cars.Each(func (car *Car) {
-//       fmt.Printf("Car color is: %s\n", car.Color)
-//   })
-//
-// Pie will not ensure immutability on items passed in so they can be
-// manipulated, if you choose to do it this way, for example:
-//
-//   // Set all car colors to Red.
-//   cars.Each(func (car *Car) {
-//       car.Color = "Red"
-//   })
+import (
+	"math"
+)
+
+// AverageSkipNaN is the average of all of the elements that are not NaN (not a
+// number). Unlike Average, a NaN element does not cause the result to be NaN.
 //
-func (ss SliceType) Each(fn func(ElementType)) SliceType {
+// Zero is returned if there are no elements that are not NaN.
+func (ss SliceType) AverageSkipNaN() float64 {
+	var sum float64
+	var count int
 	for _, s := range ss {
-		fn(s)
+		if v := float64(s); !math.IsNaN(v) {
+			sum += v
+			count++
+		}
 	}
 
-	return ss
+	if count == 0 {
+		return 0
+	}
+
+	return sum / float64(count)
 }
 `,
-	"Extend": `package functions
+	"Bottom": `package functions
 
-// Extend will return a new slice with the slices of elements appended to the
-// end.
+// Bottom will return n elements from bottom
 //
-// It is acceptable to provide zero arguments.
-func (ss SliceType) Extend(slices ...SliceType) (ss2 SliceType) {
-	ss2 = ss
-
-	for _, slice := range slices {
-		ss2 = ss2.Append(slice...)
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss SliceType) Bottom(n int) (top SliceType) {
+	var lastIndex = len(ss) - 1
+	for i := lastIndex; i > -1 && n > 0; i-- {
+		top = append(top, ss[i])
+		n--
 	}
 
-	return ss2
+	return
 }
 `,
-	"First": `package functions
+	"CSVString": `package functions
 
-// First returns the first element, or zero. Also see FirstOr().
-func (ss SliceType) First() ElementType {
-	return ss.FirstOr(ElementZeroValue)
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// CSVString returns the elements encoded as CSV.
+//
+// Numbers and strings will be encoded with one element per record. For
+// structs, the first record will contain the names of the exported fields and
+// each element will be encoded as a record after that. This makes the output
+// suitable for opening in a spreadsheet.
+func (ss SliceType) CSVString() string {
+	return util.CSVString(reflect.ValueOf(ss))
 }
 `,
-	"FirstOr": `package functions
+	"Cartesian": `package functions
 
-// FirstOr returns the first element or a default value if there are no
-// elements.
-func (ss SliceType) FirstOr(defaultValue ElementType) ElementType {
-	if len(ss) == 0 {
-		return defaultValue
+// Cartesian returns every pair of an element from ss with an element from ss2.
+// The pairs are ordered by the element from ss first:
+//
+//   {a, b}.Cartesian({x, y}) // {{a, x}, {a, y}, {b, x}, {b, y}}
+//
+// nil is returned if either slice is empty. See EachCartesianSliceType for more
+// than two slices.
+func (ss SliceType) Cartesian(ss2 SliceType) SliceTypePairs {
+	if len(ss) == 0 || len(ss2) == 0 {
+		return nil
 	}
 
-	return ss[0]
+	pairs := make(SliceTypePairs, 0, len(ss)*len(ss2))
+	for _, s := range ss {
+		for _, s2 := range ss2 {
+			pairs = append(pairs, SliceTypePair{s, s2})
+		}
+	}
+
+	return pairs
 }
 `,
-	"JSONString": `package functions
+	"Ceil": `package functions
 
 import (
-	"encoding/json"
+	"math"
 )
 
-// JSONString returns the JSON encoded array as a string.
-//
-// One important thing to note is that it will treat a nil slice as an empty
-// slice to ensure that the JSON value return is always an array.
-func (ss SliceType) JSONString() string {
+// Ceil returns a new slice with the least integer value greater than or equal
+// to each element.
+func (ss SliceType) Ceil() SliceType {
 	if ss == nil {
-		return "[]"
+		return nil
 	}
 
-	// An error should not be possible.
-	data, _ := json.Marshal(ss)
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Ceil(float64(s)))
+	}
 
-	return string(data)
+	return result
 }
 `,
-	"Join": `package functions
+	"Chunk": `package functions
 
-// Join returns a string from joining each of the elements.
-func (ss StringSliceType) Join(glue string) (s string) {
-	for i, element := range ss {
-		if i > 0 {
-			s += glue
+// Chunk splits the slice into batches of size elements. The last batch may
+// contain fewer elements than size.
+//
+// The batches share the same underlying array as the input slice to avoid
+// copying. However, their capacity is limited so that appending to a batch will
+// never overwrite the elements of the next batch.
+//
+// If the slice is empty, or size is less than one, nil is returned.
+func (ss SliceType) Chunk(size int) (chunks []SliceType) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i < len(ss); i += size {
+		end := i + size
+		if end > len(ss) {
+			end = len(ss)
 		}
 
-		s += string(element)
+		chunks = append(chunks, ss[i:end:end])
 	}
 
-	return s
+	return
 }
 `,
-	"Keys": `package functions
+	"Clamp": `package functions
 
-// Keys returns the keys in the map. All of the items will be unique.
+// Clamp returns a new slice where each element less than min is replaced with
+// min, and each element greater than max is replaced with max.
 //
-// Due to Go's randomization of iterating maps the order is not deterministic.
-func (m MapType) Keys() KeySliceType {
-	// Avoid allocation
-	l := len(m)
-	if l == 0 {
+// If min is greater than max every element will be max.
+func (ss SliceType) Clamp(min, max ElementType) SliceType {
+	if ss == nil {
 		return nil
 	}
 
-	i := 0
-	keys := make(KeySliceType, len(m))
-	for key := range m {
-		keys[i] = key
-		i++
-	}
-
-	return keys
-}
-`,
-	"Last": `package functions
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		if s < min {
+			s = min
+		}
 
-// Last returns the last element, or zero. Also see LastOr().
-func (ss SliceType) Last() ElementType {
-	return ss.LastOr(ElementZeroValue)
-}
-`,
-	"LastOr": `package functions
+		if s > max {
+			s = max
+		}
 
-// LastOr returns the last element or a default value if there are no elements.
-func (ss SliceType) LastOr(defaultValue ElementType) ElementType {
-	if len(ss) == 0 {
-		return defaultValue
+		result[i] = s
 	}
 
-	return ss[len(ss)-1]
-}
-`,
-	"Len": `package functions
-
-// Len returns the number of elements.
-func (ss SliceType) Len() int {
-	return len(ss)
+	return result
 }
 `,
-	"Max": `package functions
+	"CoalesceOr": `package functions
 
-// Max is the maximum value, or zero.
-func (ss SliceType) Max() (max ElementType) {
-	if len(ss) == 0 {
-		return
-	}
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+func (ss SliceType) CoalesceOr(defaultValue ElementType) ElementType {
+	var zero ElementType
 
-	max = ss[0]
 	for _, s := range ss {
-		if s > max {
-			max = s
+		if s != zero {
+			return s
 		}
 	}
 
-	return
+	return defaultValue
 }
 `,
-	"Median": `package functions
+	"Collect": `//go:build go1.23
+// +build go1.23
 
-// Median returns the value separating the higher half from the lower half of a
-// data sample.
-//
-// Zero is returned if there are no elements in the slice.
-func (ss SliceType) Median() ElementType {
-	l := len(ss)
+package functions
 
-	switch {
-	case l == 0:
-		return ElementZeroValue
+import (
+	"iter"
+)
 
-	case l == 1:
-		return ss[0]
+// CollectSliceType creates a slice from all of the values produced by seq. If
+// there are no values then nil is returned.
+//
+// CollectSliceType is only available when compiling with Go 1.23 or newer.
+func CollectSliceType(seq iter.Seq[ElementType]) (ss SliceType) {
+	for s := range seq {
+		ss = append(ss, s)
 	}
 
-	sorted := ss.Sort()
+	return
+}
+`,
+	"Combinations": `package functions
 
-	if l%2 != 0 {
-		return sorted[l/2]
-	}
+// Combinations returns each way of choosing k of the elements. The elements in
+// each combination keep their original order. Consider EachCombination to
+// avoid holding them all in memory.
+//
+// The input slice is not modified. nil is returned if k is less than one or
+// greater than the number of elements.
+func (ss SliceType) Combinations(k int) (combinations []SliceType) {
+	ss.EachCombination(k, func(combination SliceType) bool {
+		c := make(SliceType, len(combination))
+		copy(c, combination)
+		combinations = append(combinations, c)
+
+		return true
+	})
 
-	return (sorted[l/2-1] + sorted[l/2]) / 2
+	return
 }
 `,
-	"Min": `package functions
+	"Compact": `package functions
 
-// Min is the minimum value, or zero.
-func (ss SliceType) Min() (min ElementType) {
-	if len(ss) == 0 {
-		return
-	}
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Compact() (ss2 SliceType) {
+	var zero ElementType
 
-	min = ss[0]
 	for _, s := range ss {
-		if s < min {
-			min = s
+		if s != zero {
+			ss2 = append(ss2, s)
 		}
 	}
 
 	return
 }
 `,
-	"Random": `package functions
+	"Containing": `package functions
 
 import (
-	"math/rand"
+	"strings"
 )
 
-// Random returns a random element by your rand.Source, or zero
-func (ss SliceType) Random(source rand.Source) ElementType {
-	n := len(ss)
-
-	// Avoid the extra allocation.
-	if n < 1 {
-		return ElementZeroValue
-	}
-	if n < 2 {
-		return ss[0]
+// Containing returns a new slice containing only the elements that contain
+// substr. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) Containing(substr string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.Contains(string(s), substr) {
+			ss2 = append(ss2, s)
+		}
 	}
-	rnd := rand.New(source)
-	i := rnd.Intn(n)
-	return ss[i]
+
+	return
 }
 `,
-	"Reverse": `package functions
+	"Contains": `package functions
 
-// Reverse returns a new copy of the slice with the elements ordered in reverse.
-// This is useful when combined with Sort to get a descending sort order:
-//
-//   ss.Sort().Reverse()
+// Contains returns true if the element exists in the slice.
 //
-func (ss SliceType) Reverse() SliceType {
-	// Avoid the allocation. If there is one element or less it is already
-	// reversed.
-	if len(ss) < 2 {
-		return ss
-	}
-
-	sorted := make([]ElementType, len(ss))
-	for i := 0; i < len(ss); i++ {
-		sorted[i] = ss[len(ss)-i-1]
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) Contains(lookingFor ElementType) bool {
+	for _, s := range ss {
+		if s == lookingFor {
+			return true
+		}
 	}
 
-	return sorted
+	return false
 }
 `,
-	"Select": `package functions
+	"ContainsApprox": `package functions
 
-// Select will return a new slice containing only the elements that return
-// true from the condition. The returned slice may contain zero elements (nil).
+import (
+	"math"
+)
+
+// ContainsApprox returns true if any element is within epsilon of value. This
+// is more useful than Contains after doing arithmetic, which can introduce
+// small rounding errors:
 //
-// Unselect works in the opposite way as Select.
-func (ss SliceType) Select(condition func(ElementType) bool) (ss2 SliceType) {
+//   ss.ContainsApprox(0.3, 1e-9)
+//
+// NaN is never approximately equal to any value.
+func (ss SliceType) ContainsApprox(value ElementType, epsilon float64) bool {
 	for _, s := range ss {
-		if condition(s) {
-			ss2 = append(ss2, s)
+		if math.Abs(float64(s)-float64(value)) <= epsilon {
+			return true
 		}
 	}
 
-	return
+	return false
 }
 `,
-	"Shuffle": `package functions
+	"ContainsFold": `package functions
 
 import (
-	"github.com/elliotchance/pie/pie/util"
-	"math/rand"
+	"strings"
 )
 
-// Shuffle returns shuffled slice by your rand.Source
-func (ss SliceType) Shuffle(source rand.Source) SliceType {
-	n := len(ss)
-
-	// Avoid the extra allocation.
-	if n < 2 {
-		return ss
+// ContainsFold works the same as Contains, except that the comparison ignores
+// case (see strings.EqualFold).
+func (ss StringSliceType) ContainsFold(lookingFor string) bool {
+	for _, s := range ss {
+		if strings.EqualFold(string(s), lookingFor) {
+			return true
+		}
 	}
 
-	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
-	// the algorithm directly from the go source: src/math/rand/rand.go below,
-	// with some adjustments:
-	shuffled := make([]ElementType, n)
-	copy(shuffled, ss)
-
-	rnd := rand.New(source)
-
-	util.Shuffle(rnd, n, func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	return shuffled
+	return false
 }
 `,
-	"Sort": `package functions
+	"Correlation": `package functions
 
 import (
-	"sort"
+	"math"
+
+	"github.com/elliotchance/pie/pie"
 )
 
-// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
-// slice returned will be reallocated as to not modify the input slice.
+// Correlation is the Pearson correlation coefficient between the elements at
+// the same position in each slice. The result is between -1 and 1, where 1 is
+// a perfect positive linear relationship, -1 is a perfect negative linear
+// relationship and 0 means there is no linear relationship.
 //
-// See Reverse() and AreSorted().
-func (ss SliceType) Sort() SliceType {
-	// Avoid the allocation. If there is one element or less it is already
-	// sorted.
-	if len(ss) < 2 {
-		return ss
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and pie.ErrEmptySlice if there are no elements. The correlation
+// is undefined when either slice has all equal elements, in which case
+// pie.ErrZeroVariance is returned.
+func (ss SliceType) Correlation(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
 	}
 
-	sorted := make([]ElementType, len(ss))
-	copy(sorted, ss)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i] < sorted[j]
-	})
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
+	}
 
-	return sorted
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var covariance, variance, variance2 float64
+	for i, s := range ss {
+		diff, diff2 := float64(s)-mean, float64(ss2[i])-mean2
+		covariance += diff * diff2
+		variance += diff * diff
+		variance2 += diff2 * diff2
+	}
+
+	if variance == 0 || variance2 == 0 {
+		return 0, pie.ErrZeroVariance
+	}
+
+	return covariance / math.Sqrt(variance*variance2), nil
 }
 `,
-	"Sum": `package functions
+	"CountTrue": `package functions
 
-// Sum is the sum of all of the elements.
-func (ss SliceType) Sum() (sum ElementType) {
+// CountTrue returns the number of elements that are true.
+func (ss BoolSliceType) CountTrue() (count int) {
 	for _, s := range ss {
-		sum += s
+		if s {
+			count++
+		}
 	}
 
 	return
 }
 `,
-	"ToStrings": `package functions
+	"CountUsing": `package functions
+
+// CountUsing returns the number of elements that return true from the
+// condition.
+func (ss SliceType) CountUsing(condition func(ElementType) bool) (count int) {
+	for _, s := range ss {
+		if condition(s) {
+			count++
+		}
+	}
+
+	return
+}
+`,
+	"Covariance": `package functions
 
 import (
 	"github.com/elliotchance/pie/pie"
 )
 
-// ToStrings transforms each element to a string.
-func (ss SliceType) ToStrings(transform func(ElementType) string) pie.Strings {
-	l := len(ss)
+// Covariance is the population covariance between the elements at the same
+// position in each slice. A positive result means the values tend to increase
+// together, and a negative result means one tends to decrease as the other
+// increases.
+//
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements, and pie.ErrEmptySlice if there are no elements.
+func (ss SliceType) Covariance(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
+	}
 
-	// Avoid the allocation.
-	if l == 0 {
-		return nil
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
 	}
 
-	result := make(pie.Strings, l)
-	for i := 0; i < l; i++ {
-		result[i] = transform(ss[i])
+	mean, mean2 := float64(ss.Average()), float64(ss2.Average())
+
+	var sum float64
+	for i, s := range ss {
+		sum += (float64(s) - mean) * (float64(ss2[i]) - mean2)
 	}
 
-	return result
+	return sum / float64(len(ss)), nil
 }
 `,
-	"Top": `package functions
+	"CumulativeSum": `package functions
 
-// Top will return n elements from head of the slice
-// if the slice has less elements then n that'll return all elements
-// if n < 0 it'll return empty slice.
-func (ss SliceType) Top(n int) (top SliceType) {
-	for i := 0; i < len(ss) && n > 0; i++ {
-		top = append(top, ss[i])
-		n--
+// CumulativeSum returns a new slice where each element is the running total of
+// all of the elements up to and including that position:
+//
+//   Ints{1, 2, 3, 4}.CumulativeSum() // Ints{1, 3, 6, 10}
+//
+func (ss SliceType) CumulativeSum() SliceType {
+	if ss == nil {
+		return nil
 	}
 
-	return
+	sums := make(SliceType, len(ss))
+	var sum ElementType
+	for i, s := range ss {
+		sum += s
+		sums[i] = sum
+	}
+
+	return sums
 }
 `,
-	"Transform": `package functions
+	"DeleteAt": `package functions
 
-// Transform will return a new slice where each element has been transformed.
-// The number of element returned will always be the same as the input.
+// DeleteAt returns a new slice with the elements at each of the indexes
+// removed. Indexes that are out of range are ignored and the same index may be
+// provided more than once.
 //
-// Be careful when using this with slices of pointers. If you modify the input
-// value it will affect the original slice. Be sure to return a new allocated
-// object or deep copy the existing one.
-func (ss SliceType) Transform(fn func(ElementType) ElementType) (ss2 SliceType) {
-	if ss == nil {
-		return nil
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) DeleteAt(indexes ...int) (ss2 SliceType) {
+	deleted := make([]bool, len(ss))
+	for _, index := range indexes {
+		if index >= 0 && index < len(ss) {
+			deleted[index] = true
+		}
 	}
 
-	ss2 = make([]ElementType, len(ss))
 	for i, s := range ss {
-		ss2[i] = fn(s)
+		if !deleted[i] {
+			ss2 = append(ss2, s)
+		}
 	}
 
 	return
 }
 `,
-	"Unique": `package functions
+	"Deltas": `package functions
 
-// Unique returns a new slice with all of the unique values.
+// Deltas returns a new slice containing the difference between each element and
+// the element before it. It is the inverse of CumulativeSum (without the first
+// element):
 //
-// The items will be returned in a randomized order, even with the same input.
-//
-// The number of items returned may be the same as the input or less. It will
-// never return zero items unless then input slice has zero items.
+//   Ints{1, 3, 6, 10}.Deltas() // Ints{2, 3, 4}
 //
-// A slice with zero elements is considered to be unique.
+// The result has one less element than the input. If there are less than two
+// elements nil is returned.
 //
-// See AreUnique().
-func (ss SliceType) Unique() SliceType {
+// For unsigned types a decreasing value will wrap around.
+func (ss SliceType) Deltas() SliceType {
+	if len(ss) < 2 {
+		return nil
+	}
+
+	deltas := make(SliceType, len(ss)-1)
+	for i := 1; i < len(ss); i++ {
+		deltas[i-1] = ss[i] - ss[i-1]
+	}
+
+	return deltas
+}
+`,
+	"Diff": `package functions
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+func (ss SliceType) Diff(against SliceType) (added, removed SliceType) {
+	counts := map[ElementType]int{}
+	for _, value := range ss {
+		counts[value]++
+	}
+
+	for _, value := range against {
+		if counts[value] > 0 {
+			counts[value]--
+		} else {
+			added = append(added, value)
+		}
+	}
+
+	// Anything remaining in counts has been removed. We iterate ss again to
+	// keep the original order.
+	for _, value := range ss {
+		if counts[value] > 0 {
+			counts[value]--
+			removed = append(removed, value)
+		}
+	}
+
+	return
+}
+`,
+	"Divide": `package functions
+
+// Divide returns a new slice where each element of ss has been divided by the
+// element at the same position in ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+//
+// Dividing integers by zero will panic, in the same way as the / operator.
+func (ss SliceType) Divide(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] / ss2[i]
+	}
+
+	return result
+}
+`,
+	"DotProduct": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// DotProduct returns the sum of the products of the elements at the same
+// position in each slice.
+//
+// pie.ErrLengthMismatch is returned if the slices do not have the same number
+// of elements. The dot product of two empty slices is zero.
+func (ss SliceType) DotProduct(ss2 SliceType) (float64, error) {
+	if len(ss) != len(ss2) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	var product float64
+	for i, s := range ss {
+		product += float64(s) * float64(ss2[i])
+	}
+
+	return product, nil
+}
+`,
+	"Drop": `package functions
+
+// Drop returns a new slice with the first n elements removed. If the slice has
+// less than n elements then an empty slice (nil) is returned. If n <= 0 all
+// elements are returned.
+//
+// See Take() and DropWhile().
+func (ss SliceType) Drop(n int) (ss2 SliceType) {
+	if n < 0 {
+		n = 0
+	}
+
+	if n >= len(ss) {
+		return nil
+	}
+
+	ss2 = make(SliceType, len(ss)-n)
+	copy(ss2, ss[n:])
+
+	return
+}
+`,
+	"DropInf": `package functions
+
+import (
+	"math"
+)
+
+// DropInf returns a new slice without any of the positive or negative infinity
+// elements. The remaining elements keep their original order.
+func (ss SliceType) DropInf() SliceType {
+	return ss.Select(func(s ElementType) bool {
+		return !math.IsInf(float64(s), 0)
+	})
+}
+`,
+	"DropNaN": `package functions
+
+import (
+	"math"
+)
+
+// DropNaN returns a new slice without any of the NaN (not a number) elements.
+// The remaining elements keep their original order.
+func (ss SliceType) DropNaN() SliceType {
+	return ss.Select(func(s ElementType) bool {
+		return !math.IsNaN(float64(s))
+	})
+}
+`,
+	"DropNil": `package functions
+
+// DropNil returns a new slice with all of the nil elements removed. The order
+// of the remaining elements is retained. The returned slice may contain zero
+// elements (nil).
+//
+// DropNil is only available for slices of pointers.
+func (ss PointerSliceType) DropNil() (ss2 PointerSliceType) {
+	for _, s := range ss {
+		if s != nil {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"DropWhile": `package functions
+
+// DropWhile returns a new slice with the elements at the start of the slice
+// removed for as long as the condition returns true. All elements from the
+// first element that returns false are included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See TakeWhile().
+func (ss SliceType) DropWhile(condition func(ElementType) bool) (ss2 SliceType) {
+	for i, s := range ss {
+		if !condition(s) {
+			return append(ss2, ss[i:]...)
+		}
+	}
+
+	return
+}
+`,
+	"Each": `package functions
+
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass the original slice on.
+//
+//   cars.Each(func (car *Car) {
+//       fmt.Printf("Car color is: %s\n", car.Color)
+//   })
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//   // Set all car colors to Red.
+//   cars.Each(func (car *Car) {
+//       car.Color = "Red"
+//   })
+//
+func (ss SliceType) Each(fn func(ElementType)) SliceType {
+	for _, s := range ss {
+		fn(s)
+	}
+
+	return ss
+}
+`,
+	"EachCartesian": `package functions
+
+// EachCartesianSliceType calls fn with every combination of one element from
+// each of the slices, stopping early if fn returns false. This is useful for
+// enumerating test matrices without holding every combination in memory:
+//
+//   EachCartesianSliceType(func(values SliceType) bool {
+//     // values[0] is from os, values[1] is from arch, ...
+//     return true
+//   }, os, arch, mode)
+//
+// The combinations are ordered with the last slice changing the fastest. The
+// slice passed to fn is reused for each combination to avoid allocating, so it
+// must be copied if it is to be kept. fn is not called if there are no slices
+// or any of the slices are empty.
+func EachCartesianSliceType(fn func(SliceType) bool, slices ...SliceType) {
+	if len(slices) == 0 {
+		return
+	}
+
+	for _, ss := range slices {
+		if len(ss) == 0 {
+			return
+		}
+	}
+
+	indexes := make([]int, len(slices))
+	values := make(SliceType, len(slices))
+	for i, ss := range slices {
+		values[i] = ss[0]
+	}
+
+	for {
+		if !fn(values) {
+			return
+		}
+
+		// Advance the indexes like an odometer, starting from the last slice.
+		i := len(slices) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(slices[i]) {
+				values[i] = slices[i][indexes[i]]
+				break
+			}
+
+			indexes[i] = 0
+			values[i] = slices[i][0]
+		}
+
+		if i < 0 {
+			return
+		}
+	}
+}
+`,
+	"EachCombination": `package functions
+
+// EachCombination calls fn with each way of choosing k of the elements,
+// stopping early if fn returns false. The elements in each combination keep
+// their original order, and the combinations are produced in lexicographic
+// order of their positions.
+//
+// The slice passed to fn is reused for each combination to avoid allocating,
+// so it must be copied if it is to be kept. The input slice is not modified.
+// If k is less than one or greater than the number of elements fn is not
+// called.
+func (ss SliceType) EachCombination(k int, fn func(SliceType) bool) {
+	n := len(ss)
+	if k < 1 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	combination := make(SliceType, k)
+	for i := range indexes {
+		indexes[i] = i
+		combination[i] = ss[i]
+	}
+
+	for {
+		if !fn(combination) {
+			return
+		}
+
+		// Find the rightmost index that can still be moved to the right.
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i; j < k; j++ {
+			if j > i {
+				indexes[j] = indexes[j-1] + 1
+			}
+
+			combination[j] = ss[indexes[j]]
+		}
+	}
+}
+`,
+	"EachErr": `package functions
+
+// EachErr works the same as Each, except that fn may return an error. It will
+// stop at the first error and return it. Otherwise nil is returned.
+func (ss SliceType) EachErr(fn func(ElementType) error) error {
+	for _, s := range ss {
+		if err := fn(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+`,
+	"EachPermutation": `package functions
+
+// EachPermutation calls fn with each ordering of the elements, stopping early
+// if fn returns false. There are n! permutations of n elements so this should
+// be used instead of Permutations when there are more than a few elements.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The slice passed to fn is reused for each
+// permutation to avoid allocating, so it must be copied if it is to be kept.
+// The input slice is not modified. If there are no elements fn is not called.
+func (ss SliceType) EachPermutation(fn func(SliceType) bool) {
+	n := len(ss)
+	if n == 0 {
+		return
+	}
+
+	// This is the iterative form of Heap's algorithm, which produces each
+	// permutation by swapping a single pair of elements.
+	permutation := make(SliceType, n)
+	copy(permutation, ss)
+
+	if !fn(permutation) {
+		return
+	}
+
+	counters := make([]int, n)
+	for i := 1; i < n; {
+		if counters[i] < i {
+			if i%2 == 0 {
+				permutation[0], permutation[i] = permutation[i], permutation[0]
+			} else {
+				permutation[counters[i]], permutation[i] = permutation[i], permutation[counters[i]]
+			}
+
+			if !fn(permutation) {
+				return
+			}
+
+			counters[i]++
+			i = 1
+		} else {
+			counters[i] = 0
+			i++
+		}
+	}
+}
+`,
+	"EachWithIndex": `package functions
+
+// EachWithIndex works the same as Each, but the callback also receives the
+// index of each element.
+//
+//   cars.EachWithIndex(func (i int, car *Car) {
+//       fmt.Printf("Car %d color is: %s\n", i, car.Color)
+//   })
+//
+func (ss SliceType) EachWithIndex(fn func(int, ElementType)) SliceType {
+	for i, s := range ss {
+		fn(i, s)
+	}
+
+	return ss
+}
+`,
+	"Entropy": `package functions
+
+import (
+	"math"
+)
+
+// Entropy is the Shannon entropy, in nats, of the elements treated as a
+// probability distribution. That is, the negative sum of each element
+// multiplied by its natural logarithm. Elements that are zero are ignored.
+//
+// The elements should be between 0 and 1 and add up to 1, such as the result
+// of Proportions or Softmax. Divide the result by math.Ln2 to get the entropy
+// in bits. Zero is returned if there are no elements.
+func (ss SliceType) Entropy() float64 {
+	var entropy float64
+	for _, s := range ss {
+		if p := float64(s); p != 0 {
+			entropy -= p * math.Log(p)
+		}
+	}
+
+	return entropy
+}
+`,
+	"Equals": `package functions
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) Equals(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if s != ss2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+`,
+	"EqualsApprox": `package functions
+
+import (
+	"math"
+)
+
+// EqualsApprox returns true if both slices have the same number of elements
+// and each element is within epsilon of the element at the same position in
+// the other slice. A nil slice and an empty slice are considered equal.
+//
+// NaN is never approximately equal to any value.
+func (ss SliceType) EqualsApprox(ss2 SliceType, epsilon float64) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if !(math.Abs(float64(s)-float64(ss2[i])) <= epsilon) {
+			return false
+		}
+	}
+
+	return true
+}
+`,
+	"EqualsUnordered": `package functions
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) EqualsUnordered(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	counts := map[ElementType]int{}
+	for _, s := range ss {
+		counts[s]++
+	}
+
+	for _, s := range ss2 {
+		if counts[s] == 0 {
+			return false
+		}
+
+		counts[s]--
+	}
+
+	return true
+}
+`,
+	"Exp": `package functions
+
+import (
+	"math"
+)
+
+// Exp returns a new slice with e raised to the power of each element.
+func (ss SliceType) Exp() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Exp(float64(s)))
+	}
+
+	return result
+}
+`,
+	"Extend": `package functions
+
+// Extend will return a new slice with the slices of elements appended to the
+// end.
+//
+// Like Append, the result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero arguments.
+func (ss SliceType) Extend(slices ...SliceType) SliceType {
+	n := 0
+	for _, slice := range slices {
+		n += len(slice)
+	}
+
+	// Avoid the allocation.
+	if n == 0 {
+		return ss
+	}
+
+	result := make(SliceType, len(ss), len(ss)+n)
+	copy(result, ss)
+
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+`,
+	"First": `package functions
+
+// First returns the first element, or zero. Also see FirstOr().
+func (ss SliceType) First() ElementType {
+	return ss.FirstOr(ElementZeroValue)
+}
+`,
+	"FirstE": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// FirstE returns the first element. Unlike First, pie.ErrEmptySlice is
+// returned if there are no elements so that a zero value can be distinguished
+// from an empty slice.
+func (ss SliceType) FirstE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss[0], nil
+}
+`,
+	"FirstOr": `package functions
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss SliceType) FirstOr(defaultValue ElementType) ElementType {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[0]
+}
+`,
+	"FirstUsing": `package functions
+
+// FirstUsing returns the first element that returns true from the condition,
+// and true. If no element matches then a zeroed value and false are returned.
+//
+// Unlike Select(condition).First() it will stop as soon as a match is found.
+//
+// See LastUsing().
+func (ss SliceType) FirstUsing(condition func(ElementType) bool) (ElementType, bool) {
+	for _, s := range ss {
+		if condition(s) {
+			return s, true
+		}
+	}
+
+	return ElementZeroValue, false
+}
+`,
+	"Flatten": `package functions
+
+// FlattenSliceType returns a single slice containing all of the elements of
+// each slice, in order. The result may contain zero elements (nil).
+//
+// Slice types with elements that are themselves slices (such as
+// "type Batches []Float64s") also have a Flatten method generated.
+func FlattenSliceType(slices []SliceType) (ss SliceType) {
+	for _, slice := range slices {
+		ss = append(ss, slice...)
+	}
+
+	return
+}
+`,
+	"Floor": `package functions
+
+import (
+	"math"
+)
+
+// Floor returns a new slice with the greatest integer value less than or equal
+// to each element.
+func (ss SliceType) Floor() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Floor(float64(s)))
+	}
+
+	return result
+}
+`,
+	"Format": `package functions
+
+import (
+	"fmt"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Format returns a new slice with each element formatted with fmt.Sprintf
+// using verb, which may include flags, width and precision:
+//
+//   prices.Format("%.2f")  // ["1.50", "20.00"]
+//   ids.Format("%05d")     // ["00042", "01337"]
+//
+// See Strings for the default format.
+func (ss SliceType) Format(verb string) pie.Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(pie.Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprintf(verb, s)
+	}
+
+	return result
+}
+`,
+	"Frequencies": `package functions
+
+// Frequencies returns the number of times that each element appears in the
+// slice. If the slice is empty an empty map is returned.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss SliceType) Frequencies() map[ElementType]int {
+	frequencies := make(map[ElementType]int, len(ss))
+	for _, s := range ss {
+		frequencies[s]++
+	}
+
+	return frequencies
+}
+`,
+	"FromCSVString": `package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// SliceTypeFromCSVString decodes CSV into a new slice. It is the opposite of
+// CSVString.
+//
+// For numbers and strings, each field of every record will be a separate
+// element, so the values may be provided as a single row or column. For
+// structs, the first record must contain the names of the fields.
+//
+// If there are no elements then nil is returned.
+func SliceTypeFromCSVString(s string) (ss SliceType, err error) {
+	err = util.ParseCSV(s, reflect.ValueOf(&ss))
+
+	return
+}
+`,
+	"FromChannel": `package functions
+
+// SliceTypeFromChannel creates a slice from all of the elements received from
+// ch, in the order they were received. It blocks until ch is closed.
+//
+// If no elements are received then nil is returned.
+func SliceTypeFromChannel(ch <-chan ElementType) (ss SliceType) {
+	for s := range ch {
+		ss = append(ss, s)
+	}
+
+	return
+}
+`,
+	"FromJSONString": `package functions
+
+// SliceTypeFromJSONString decodes a JSON array into a new slice. It is the
+// opposite of JSONString. A JSON null will be decoded as an empty slice.
+func SliceTypeFromJSONString(s string) (ss SliceType, err error) {
+	err = ss.UnmarshalJSON([]byte(s))
+
+	return
+}
+`,
+	"GeometricMean": `package functions
+
+import (
+	"math"
+)
+
+// GeometricMean is the nth root of the product of the n elements. It is the
+// appropriate average for rates of change and ratios, such as growth rates.
+//
+// The logarithms of the elements are summed rather than multiplying them so
+// that large slices do not overflow. If any element is zero the result is zero,
+// and if any element is negative the result is NaN. Zero is returned if there
+// are no elements.
+func (ss SliceType) GeometricMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		switch v := float64(s); {
+		case v < 0:
+			return math.NaN()
+
+		case v == 0:
+			return 0
+
+		default:
+			sum += math.Log(v)
+		}
+	}
+
+	return math.Exp(sum / float64(len(ss)))
+}
+`,
+	"GroupByString": `package functions
+
+// GroupByString groups the elements into a map by the key returned from fn.
+// The elements in each group keep the same order as the input slice.
+//
+//   byColor := cars.GroupByString(func (car Car) string {
+//       return car.Color
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss SliceType) GroupByString(fn func(ElementType) string) map[string]SliceType {
+	group := map[string]SliceType{}
+
+	for _, s := range ss {
+		key := fn(s)
+		group[key] = append(group[key], s)
+	}
+
+	return group
+}
+`,
+	"HarmonicMean": `package functions
+
+// HarmonicMean is the number of elements divided by the sum of their
+// reciprocals. It is the appropriate average for rates, such as speeds over the
+// same distance.
+//
+// If any element is zero the result is zero. Zero is returned if there are no
+// elements.
+func (ss SliceType) HarmonicMean() float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		if s == 0 {
+			return 0
+		}
+
+		sum += 1 / float64(s)
+	}
+
+	return float64(len(ss)) / sum
+}
+`,
+	"HasNaN": `package functions
+
+import (
+	"math"
+)
+
+// HasNaN returns true if any of the elements are NaN (not a number). A single
+// NaN causes functions like Sum and Average to also return NaN.
+func (ss SliceType) HasNaN() bool {
+	for _, s := range ss {
+		if math.IsNaN(float64(s)) {
+			return true
+		}
+	}
+
+	return false
+}
+`,
+	"Histogram": `package functions
+
+import (
+	"fmt"
+	"math"
+)
+
+// Histogram divides the range between the smallest and largest element into
+// bucketCount buckets of equal width and counts the elements in each.
+//
+// Each key describes the bucket, for example "[0, 2.5)". Every bucket is
+// half-open except for the last one, which also includes the largest element.
+// Buckets with no elements are included with a count of zero.
+//
+// NaN and infinite elements are excluded because they do not fall into any
+// bucket.
+//
+// If all of the elements are equal there is a single bucket, such as "[3, 3]".
+// An empty map is returned if there are no elements or bucketCount is less
+// than one.
+func (ss SliceType) Histogram(bucketCount int) map[string]int {
+	histogram := map[string]int{}
+	if bucketCount < 1 {
+		return histogram
+	}
+
+	var values []float64
+	for _, s := range ss {
+		if v := float64(s); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return histogram
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+
+	if min == max {
+		histogram[fmt.Sprintf("[%v, %v]", min, max)] = len(values)
+		return histogram
+	}
+
+	width := (max - min) / float64(bucketCount)
+	counts := make([]int, bucketCount)
+	for _, v := range values {
+		i := int((v - min) / width)
+		if i < 0 {
+			i = 0
+		} else if i >= bucketCount {
+			i = bucketCount - 1
+		}
+
+		counts[i]++
+	}
+
+	for i, count := range counts {
+		lower := min + float64(i)*width
+		if i == bucketCount-1 {
+			histogram[fmt.Sprintf("[%v, %v]", lower, max)] = count
+		} else {
+			histogram[fmt.Sprintf("[%v, %v)", lower, min+float64(i+1)*width)] = count
+		}
+	}
+
+	return histogram
+}
+`,
+	"HistogramWithBounds": `package functions
+
+import (
+	"sort"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// HistogramWithBounds counts the elements that fall between each of the bounds,
+// which must be sorted in ascending order. There is one more count than there
+// are bounds:
+//
+//   counts[0] is the number of elements less than bounds[0]
+//   counts[i] is the number of elements >= bounds[i-1] and < bounds[i]
+//   counts[len(bounds)] is the number of elements >= the last bound
+//
+// The result is undefined if the bounds are not sorted.
+func (ss SliceType) HistogramWithBounds(bounds pie.Float64s) []int {
+	counts := make([]int, len(bounds)+1)
+	for _, s := range ss {
+		v := float64(s)
+		i := sort.Search(len(bounds), func(i int) bool {
+			return bounds[i] > v
+		})
+		counts[i]++
+	}
+
+	return counts
+}
+`,
+	"IndexOf": `package functions
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See LastIndexOf() and Contains().
+func (ss SliceType) IndexOf(lookingFor ElementType) int {
+	for i, s := range ss {
+		if s == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+`,
+	"Insert": `package functions
+
+// Insert returns a new slice with the values inserted before the element at
+// index. An index less than zero inserts at the start and an index greater
+// than the length of the slice appends to the end.
+//
+// The result never shares the underlying array of ss.
+//
+// It is acceptable to provide zero values.
+func (ss SliceType) Insert(index int, values ...ElementType) SliceType {
+	// Avoid the allocation.
+	if len(values) == 0 {
+		return ss
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	result := make(SliceType, 0, len(ss)+len(values))
+	result = append(result, ss[:index]...)
+	result = append(result, values...)
+
+	return append(result, ss[index:]...)
+}
+`,
+	"InsertSorted": `package functions
+
+import (
+	"sort"
+)
+
+// InsertSorted returns a new slice with value inserted into a slice that is
+// already sorted in ascending order, so that the result is also sorted. If
+// value already exists it is inserted after the existing elements.
+//
+// The result is undefined if the slice is not sorted.
+func (ss SliceType) InsertSorted(value ElementType) SliceType {
+	index := sort.Search(len(ss), func(i int) bool {
+		return ss[i] > value
+	})
+
+	result := make(SliceType, len(ss)+1)
+	copy(result, ss[:index])
+	result[index] = value
+	copy(result[index+1:], ss[index:])
+
+	return result
+}
+`,
+	"Interleave": `package functions
+
+// Interleave returns a new slice that alternates between the elements of ss and
+// ss2, starting with ss. If one slice is longer than the other the remaining
+// elements are added to the end.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Interleave(ss2 SliceType) (ss3 SliceType) {
+	for i := 0; i < len(ss) || i < len(ss2); i++ {
+		if i < len(ss) {
+			ss3 = append(ss3, ss[i])
+		}
+
+		if i < len(ss2) {
+			ss3 = append(ss3, ss2[i])
+		}
+	}
+
+	return
+}
+`,
+	"Intersect": `package functions
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+func (ss SliceType) Intersect(ss2 SliceType) (intersect SliceType) {
+	lookup := map[ElementType]struct{}{}
+	for _, value := range ss2 {
+		lookup[value] = struct{}{}
+	}
+
+	for _, value := range ss {
+		if _, ok := lookup[value]; ok {
+			intersect = append(intersect, value)
+
+			// Make sure the same element is not added again.
+			delete(lookup, value)
+		}
+	}
+
+	return
+}
+`,
+	"Iter": `//go:build go1.23
+// +build go1.23
+
+package functions
+
+import (
+	"iter"
+)
+
+// Iter returns an iterator over the elements, in order. It can be used with
+// range-over-func loops and the iterator functions in the standard library.
+//
+// Iter is only available when compiling with Go 1.23 or newer.
+func (ss SliceType) Iter() iter.Seq[ElementType] {
+	return func(yield func(ElementType) bool) {
+		for _, s := range ss {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+`,
+	"IterIndexed": `//go:build go1.23
+// +build go1.23
+
+package functions
+
+import (
+	"iter"
+)
+
+// IterIndexed returns an iterator over the index and value of each element, in
+// order.
+//
+// IterIndexed is only available when compiling with Go 1.23 or newer.
+func (ss SliceType) IterIndexed() iter.Seq2[int, ElementType] {
+	return func(yield func(int, ElementType) bool) {
+		for i, s := range ss {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+}
+`,
+	"JSONBytes": `package functions
+
+import (
+	"encoding/json"
+)
+
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONBytes() []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return data
+}
+`,
+	"JSONBytesIndent": `package functions
+
+import (
+	"encoding/json"
+)
+
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+// See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONBytesIndent(prefix, indent string) []byte {
+	if ss == nil {
+		return []byte("[]")
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return data
+}
+`,
+	"JSONString": `package functions
+
+import (
+	"encoding/json"
+)
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONString() string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.Marshal(ss)
+
+	return string(data)
+}
+`,
+	"JSONStringIndent": `package functions
+
+import (
+	"encoding/json"
+)
+
+// JSONStringIndent returns the JSON encoded array as a string with indent
+// applied. See json.MarshalIndent for the meaning of prefix and indent.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONStringIndent(prefix, indent string) string {
+	if ss == nil {
+		return "[]"
+	}
+
+	// An error should not be possible.
+	data, _ := json.MarshalIndent(ss, prefix, indent)
+
+	return string(data)
+}
+`,
+	"Join": `package functions
+
+// Join returns a string from joining each of the elements.
+func (ss StringSliceType) Join(glue string) (s string) {
+	for i, element := range ss {
+		if i > 0 {
+			s += glue
+		}
+
+		s += string(element)
+	}
+
+	return s
+}
+`,
+	"JoinFormatted": `package functions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// JoinFormatted returns a string from joining each of the elements after they
+// have been formatted with the fmt verb provided:
+//
+//   Float64s{1.5, 2.25}.JoinFormatted(", ", "%.1f") // "1.5, 2.2"
+//
+func (ss SliceType) JoinFormatted(glue, verb string) string {
+	var sb strings.Builder
+	for i, element := range ss {
+		if i > 0 {
+			sb.WriteString(glue)
+		}
+
+		fmt.Fprintf(&sb, verb, element)
+	}
+
+	return sb.String()
+}
+`,
+	"KeyByString": `package functions
+
+// KeyByString returns a map of the elements by the key returned from fn. If
+// more than one element has the same key the last one is used. See
+// GroupByString to keep all of the elements.
+//
+//   byName := cars.KeyByString(func (car Car) string {
+//       return car.Name
+//   })
+//
+// An empty slice returns an empty (not nil) map.
+func (ss SliceType) KeyByString(fn func(ElementType) string) map[string]ElementType {
+	m := make(map[string]ElementType, len(ss))
+	for _, s := range ss {
+		m[fn(s)] = s
+	}
+
+	return m
+}
+`,
+	"Keys": `package functions
+
+// Keys returns the keys in the map. All of the items will be unique.
+//
+// Due to Go's randomization of iterating maps the order is not deterministic.
+func (m MapType) Keys() KeySliceType {
+	// Avoid allocation
+	l := len(m)
+	if l == 0 {
+		return nil
+	}
+
+	i := 0
+	keys := make(KeySliceType, len(m))
+	for key := range m {
+		keys[i] = key
+		i++
+	}
+
+	return keys
+}
+`,
+	"Largest": `package functions
+
+import (
+	"sort"
+)
+
+// Largest returns the n largest elements in descending order. If the slice has
+// less than n elements then all elements are returned. If n < 1 it will return
+// nil.
+//
+// Unlike Sort().Reverse().Top(n) it does not sort the whole slice. Only the n
+// largest elements seen so far are kept, which is much faster when n is small
+// compared to the number of elements.
+//
+// This is not called Top because Top() already returns the first n elements
+// by position, regardless of their value.
+func (ss SliceType) Largest(n int) (largest SliceType) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(largest) < n {
+			largest = append(largest, s)
+		} else if !(largest[n-1] < s) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the smallest that will be dropped.
+		i := sort.Search(len(largest)-1, func(i int) bool {
+			return largest[i] < s
+		})
+		copy(largest[i+1:], largest[i:len(largest)-1])
+		largest[i] = s
+	}
+
+	return
+}
+`,
+	"Last": `package functions
+
+// Last returns the last element, or zero. Also see LastOr().
+func (ss SliceType) Last() ElementType {
+	return ss.LastOr(ElementZeroValue)
+}
+`,
+	"LastE": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// LastE returns the last element. Unlike Last, pie.ErrEmptySlice is returned
+// if there are no elements so that a zero value can be distinguished from an
+// empty slice.
+func (ss SliceType) LastE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss[len(ss)-1], nil
+}
+`,
+	"LastIndexOf": `package functions
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See IndexOf() and Contains().
+func (ss SliceType) LastIndexOf(lookingFor ElementType) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ss[i] == lookingFor {
+			return i
+		}
+	}
+
+	return -1
+}
+`,
+	"LastOr": `package functions
+
+// LastOr returns the last element or a default value if there are no elements.
+func (ss SliceType) LastOr(defaultValue ElementType) ElementType {
+	if len(ss) == 0 {
+		return defaultValue
+	}
+
+	return ss[len(ss)-1]
+}
+`,
+	"LastUsing": `package functions
+
+// LastUsing returns the last element that returns true from the condition, and
+// true. If no element matches then a zeroed value and false are returned.
+//
+// The elements are checked in reverse order so it will stop as soon as a match
+// is found.
+//
+// See FirstUsing().
+func (ss SliceType) LastUsing(condition func(ElementType) bool) (ElementType, bool) {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if condition(ss[i]) {
+			return ss[i], true
+		}
+	}
+
+	return ElementZeroValue, false
+}
+`,
+	"Lazy": `package functions
+
+// SliceTypeLazy is a lazily evaluated pipeline created with Lazy. Each
+// operation returns a new pipeline without doing any work. When Collect is
+// called all of the operations are applied to each element in a single pass,
+// without allocating a slice for each step.
+type SliceTypeLazy struct {
+	iterate func(fn func(ElementType) bool)
+}
+
+// Lazy returns a lazily evaluated pipeline over the elements. This is useful
+// when chaining several operations on a large slice, or when only the first few
+// results are needed:
+//
+//   ss.Lazy().Select(isValid).Transform(normalize).Top(10).Collect()
+//
+// The slice must not be modified until Collect has been called.
+func (ss SliceType) Lazy() SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			for _, s := range ss {
+				if !fn(s) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Select will only pass on the elements that return true from the condition.
+func (l SliceTypeLazy) Select(condition func(ElementType) bool) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return !condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Unselect works the same as Select, with a negated condition.
+func (l SliceTypeLazy) Unselect(condition func(ElementType) bool) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return condition(s) || fn(s)
+			})
+		},
+	}
+}
+
+// Transform will pass on each element after it has been transformed.
+func (l SliceTypeLazy) Transform(transform func(ElementType) ElementType) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			l.iterate(func(s ElementType) bool {
+				return fn(transform(s))
+			})
+		},
+	}
+}
+
+// Top will only pass on the first n elements. No more elements will be
+// evaluated by the earlier operations once n elements have been reached.
+func (l SliceTypeLazy) Top(n int) SliceTypeLazy {
+	return SliceTypeLazy{
+		iterate: func(fn func(ElementType) bool) {
+			if n < 1 {
+				return
+			}
+
+			taken := 0
+			l.iterate(func(s ElementType) bool {
+				taken++
+
+				return fn(s) && taken < n
+			})
+		},
+	}
+}
+
+// Collect evaluates the pipeline and returns the resulting elements. The
+// returned slice may contain zero elements (nil).
+func (l SliceTypeLazy) Collect() (ss SliceType) {
+	l.iterate(func(s ElementType) bool {
+		ss = append(ss, s)
+
+		return true
+	})
+
+	return
+}
+`,
+	"Len": `package functions
+
+// Len returns the number of elements.
+func (ss SliceType) Len() int {
+	return len(ss)
+}
+`,
+	"Linspace": `package functions
+
+// SliceTypeLinspace creates a slice of n evenly spaced values from start to
+// stop. Unlike SliceTypeRange, both start and stop are included:
+//
+//   SliceTypeLinspace(0, 1, 5)  // [0 0.25 0.5 0.75 1]
+//
+// If n is one the only value is start. nil is returned if n is less than one.
+func SliceTypeLinspace(start, stop ElementType, n int) SliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if n == 1 {
+		return SliceType{start}
+	}
+
+	step := (stop - start) / ElementType(n-1)
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = start + ElementType(i)*step
+	}
+
+	// Avoid a rounding error in the last value.
+	ss[n-1] = stop
+
+	return ss
+}
+`,
+	"Log": `package functions
+
+import (
+	"math"
+)
+
+// Log returns a new slice with the natural logarithm of each element. Zero
+// becomes negative infinity and negative elements become NaN.
+func (ss SliceType) Log() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Log(float64(s)))
+	}
+
+	return result
+}
+`,
+	"Map": `package functions
+
+// Map works the same as Transform, except that fn always receives and returns a
+// string. This allows functions from the strings package (such as
+// strings.Title) to be used directly with slices of custom string types.
+func (ss StringSliceType) Map(fn func(string) string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	mapped := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		mapped[i] = StringElementType(fn(string(s)))
+	}
+
+	return mapped
+}
+`,
+	"MarshalBinary": `package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. Each element is encoded
+// with a fixed number of bytes, which is much more compact than JSON. This is
+// also used by encoding/gob.
+//
+// Unlike JSONString, a nil slice and an empty slice are encoded differently so
+// that UnmarshalBinary can restore either one.
+func (ss SliceType) MarshalBinary() ([]byte, error) {
+	return util.MarshalBinary(reflect.ValueOf(ss))
+}
+`,
+	"MarshalJSON": `package functions
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler. Like JSONString, a nil slice will be
+// encoded as an empty array rather than null. This is also the case when the
+// slice is a field of a struct being encoded.
+func (ss SliceType) MarshalJSON() ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]ElementType(ss))
+}
+`,
+	"MarshalYAML": `package functions
+
+// MarshalYAML implements yaml.Marshaler from gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3. Like JSONString, a nil slice will be encoded as an empty
+// sequence rather than null.
+func (ss SliceType) MarshalYAML() (interface{}, error) {
+	if ss == nil {
+		return []ElementType{}, nil
+	}
+
+	return []ElementType(ss), nil
+}
+`,
+	"MatchingRegexp": `package functions
+
+import (
+	"regexp"
+)
+
+// MatchingRegexp returns a new slice containing only the elements that match
+// the regular expression. The returned slice may contain zero elements (nil).
+//
+// NotMatchingRegexp works in the opposite way as MatchingRegexp.
+func (ss StringSliceType) MatchingRegexp(re *regexp.Regexp) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"Max": `package functions
+
+// Max is the maximum value, or zero.
+func (ss SliceType) Max() (max ElementType) {
+	if len(ss) == 0 {
+		return
+	}
+
+	max = ss[0]
+	for _, s := range ss {
+		if s > max {
+			max = s
+		}
+	}
+
+	return
+}
+`,
+	"MaxE": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MaxE is the maximum value. Unlike Max, pie.ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss SliceType) MaxE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss.Max(), nil
+}
+`,
+	"Median": `package functions
+
+// Median returns the value separating the higher half from the lower half of a
+// data sample. When there is an even number of elements the mean of the two
+// middle values is used. The input slice is not modified.
+//
+// For integer types the mean of the two middle values is rounded toward zero,
+// so Ints{1, 2}.Median() is 1. It is calculated in a way that cannot overflow.
+//
+// Zero is returned if there are no elements in the slice.
+func (ss SliceType) Median() ElementType {
+	l := len(ss)
+
+	switch {
+	case l == 0:
+		return ElementZeroValue
+
+	case l == 1:
+		return ss[0]
+	}
+
+	sorted := ss.Sort()
+
+	if l%2 != 0 {
+		return sorted[l/2]
+	}
+
+	// Each case avoids an overflow and rounds integers toward zero, the same
+	// as integer division.
+	a, b := sorted[l/2-1], sorted[l/2]
+	switch {
+	case a >= 0:
+		return a + (b-a)/2
+
+	case b < 0:
+		return b - (b-a)/2
+	}
+
+	return (a + b) / 2
+}
+`,
+	"MergeSorted": `package functions
+
+// MergeSorted merges two slices that are already sorted in ascending order into
+// a new sorted slice in O(n+m), without needing to sort again. Duplicate
+// elements are retained. See MergeSortedUnique.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss SliceType) MergeSorted(ss2 SliceType) (merged SliceType) {
+	if len(ss)+len(ss2) == 0 {
+		return nil
+	}
+
+	merged = make(SliceType, 0, len(ss)+len(ss2))
+	i, j := 0, 0
+	for i < len(ss) && j < len(ss2) {
+		if ss2[j] < ss[i] {
+			merged = append(merged, ss2[j])
+			j++
+		} else {
+			merged = append(merged, ss[i])
+			i++
+		}
+	}
+
+	merged = append(merged, ss[i:]...)
+
+	return append(merged, ss2[j:]...)
+}
+`,
+	"MergeSortedUnique": `package functions
+
+// MergeSortedUnique works the same as MergeSorted, except that each value will
+// only appear once in the result, even if it appears more than once in either
+// slice.
+//
+// The result is undefined if either slice is not sorted. The returned slice may
+// contain zero elements (nil).
+func (ss SliceType) MergeSortedUnique(ss2 SliceType) (merged SliceType) {
+	add := func(value ElementType) {
+		if len(merged) == 0 || merged[len(merged)-1] != value {
+			merged = append(merged, value)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ss) || j < len(ss2) {
+		if i == len(ss) || (j < len(ss2) && ss2[j] < ss[i]) {
+			add(ss2[j])
+			j++
+		} else {
+			add(ss[i])
+			i++
+		}
+	}
+
+	return
+}
+`,
+	"Min": `package functions
+
+// Min is the minimum value, or zero.
+func (ss SliceType) Min() (min ElementType) {
+	if len(ss) == 0 {
+		return
+	}
+
+	min = ss[0]
+	for _, s := range ss {
+		if s < min {
+			min = s
+		}
+	}
+
+	return
+}
+`,
+	"MinE": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MinE is the minimum value. Unlike Min, pie.ErrEmptySlice is returned if there
+// are no elements so that a zero value can be distinguished from an empty
+// slice.
+func (ss SliceType) MinE() (ElementType, error) {
+	if len(ss) == 0 {
+		return ElementZeroValue, pie.ErrEmptySlice
+	}
+
+	return ss.Min(), nil
+}
+`,
+	"Mode": `package functions
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+func (ss SliceType) Mode() (mode SliceType) {
+	counts := map[ElementType]int{}
+	highest := 0
+
+	for _, s := range ss {
+		counts[s]++
+
+		if counts[s] > highest {
+			highest = counts[s]
+		}
+	}
+
+	for _, s := range ss {
+		if counts[s] == highest {
+			mode = append(mode, s)
+
+			// Make sure the same value is not added again.
+			counts[s] = 0
+		}
+	}
+
+	return
+}
+`,
+	"MovingAverage": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// MovingAverage returns the simple moving average of each window of size
+// consecutive elements. The result will have len(ss)-window+1 elements.
+//
+// It keeps a running total so each element is only visited twice, regardless
+// of the window size.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss SliceType) MovingAverage(window int) pie.Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	var sum float64
+	for _, s := range ss[:window] {
+		sum += float64(s)
+	}
+
+	averages := make(pie.Float64s, len(ss)-window+1)
+	averages[0] = sum / float64(window)
+
+	for i := window; i < len(ss); i++ {
+		sum += float64(ss[i]) - float64(ss[i-window])
+		averages[i-window+1] = sum / float64(window)
+	}
+
+	return averages
+}
+`,
+	"Multiply": `package functions
+
+// Multiply returns a new slice where each element is the product of the
+// elements at the same position in ss and ss2.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss SliceType) Multiply(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] * ss2[i]
+	}
+
+	return result
+}
+`,
+	"MultiplyScalar": `package functions
+
+// MultiplyScalar returns a new slice with each element multiplied by value. It
+// can be used to scale all of the elements.
+func (ss SliceType) MultiplyScalar(value ElementType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = s * value
+	}
+
+	return result
+}
+`,
+	"Norm": `package functions
+
+import (
+	"math"
+)
+
+// Norm returns the Euclidean norm (also known as the magnitude or L2 norm) of
+// the elements, treating the slice as a vector. Zero is returned if there are
+// no elements.
+func (ss SliceType) Norm() float64 {
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s) * float64(s)
+	}
+
+	return math.Sqrt(sum)
+}
+`,
+	"Normalize": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Normalize returns a new slice where each element has been rescaled to be
+// between 0 and 1 (min-max scaling). The smallest element becomes 0 and the
+// largest element becomes 1.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss SliceType) Normalize() pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	min, max := float64(ss.Min()), float64(ss.Max())
+	spread := max - min
+
+	normalized := make(pie.Float64s, len(ss))
+	if spread == 0 {
+		return normalized
+	}
+
+	for i, s := range ss {
+		normalized[i] = (float64(s) - min) / spread
+	}
+
+	return normalized
+}
+`,
+	"NotMatchingRegexp": `package functions
+
+import (
+	"regexp"
+)
+
+// NotMatchingRegexp returns a new slice containing only the elements that do
+// not match the regular expression. The returned slice may contain zero
+// elements (nil).
+func (ss StringSliceType) NotMatchingRegexp(re *regexp.Regexp) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if !re.MatchString(string(s)) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"OrderBy": `package functions
+
+import (
+	"sort"
+)
+
+// OrderBy returns a new slice sorted by multiple keys. The first less function
+// is used to compare elements. If two elements are equal (neither is less than
+// the other) then the next less function is used, and so on:
+//
+//   cars.OrderBy(
+//       func(a, b Car) bool { return a.Color < b.Color },
+//       func(a, b Car) bool { return a.Name < b.Name },
+//   )
+//
+// Elements that are equal for all of the less functions will keep their
+// original order.
+func (ss SliceType) OrderBy(less ...func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, fn := range less {
+			if fn(sorted[i], sorted[j]) {
+				return true
+			}
+
+			if fn(sorted[j], sorted[i]) {
+				return false
+			}
+		}
+
+		return false
+	})
+
+	return sorted
+}
+`,
+	"Outliers": `package functions
+
+// Outliers returns the elements that are more than multiplier times the
+// interquartile range (IQR) below the first quartile or above the third
+// quartile. A multiplier of 1.5 is the conventional choice, and 3 only finds
+// extreme outliers.
+//
+// The elements are returned in their original order. See RemoveOutliers.
+func (ss SliceType) Outliers(multiplier float64) SliceType {
+	q1, q3 := float64(ss.Percentile(25)), float64(ss.Percentile(75))
+	lower, upper := q1-multiplier*(q3-q1), q3+multiplier*(q3-q1)
+
+	return ss.Select(func(s ElementType) bool {
+		return float64(s) < lower || float64(s) > upper
+	})
+}
+`,
+	"PadLeft": `package functions
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// PadLeft returns a new slice where each element shorter than width characters
+// has pad repeated on the left until it is width characters. This is useful for
+// right aligning values:
+//
+//   Strings{"7", "42"}.PadLeft(3, " ") // ["  7", " 42"]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss StringSliceType) PadLeft(width int, pad string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = StringElementType(string(padding) + string(s))
+	}
+
+	return padded
+}
+`,
+	"PadRight": `package functions
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// PadRight returns a new slice where each element shorter than width
+// characters has pad repeated on the right until it is width characters. This
+// is useful for left aligning values in columns:
+//
+//   Strings{"a", "abc"}.PadRight(4, ".") // ["a...", "abc."]
+//
+// Lengths are counted in runes rather than bytes. If pad has more than one
+// character the last repetition is cut short to fit. Elements that are already
+// long enough, or all elements if pad is empty, are not changed.
+func (ss StringSliceType) PadRight(width int, pad string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	padded := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		n := width - utf8.RuneCountInString(string(s))
+		if n <= 0 || pad == "" {
+			padded[i] = s
+			continue
+		}
+
+		padding := []rune(strings.Repeat(pad, n))[:n]
+		padded[i] = StringElementType(string(s) + string(padding))
+	}
+
+	return padded
+}
+`,
+	"Page": `package functions
+
+// Page returns the elements on a page when the slice is split into pages of
+// pageSize elements. Pages are numbered from 1, and the last page may have
+// fewer than pageSize elements:
+//
+//   ss.Page(1, 20) // elements 0-19
+//   ss.Page(2, 20) // elements 20-39
+//
+// Like Chunk, the page shares the same underlying array as the input slice but
+// its capacity is limited so that appending to it will never overwrite other
+// elements.
+//
+// nil is returned if pageNumber or pageSize is less than one, or the page is
+// past the end of the slice. See TotalPages.
+func (ss SliceType) Page(pageNumber, pageSize int) SliceType {
+	if pageNumber < 1 || pageSize < 1 {
+		return nil
+	}
+
+	// Comparing with the number of full pages before multiplying avoids
+	// overflowing the offset for very large page numbers.
+	if len(ss) == 0 || pageNumber-1 > (len(ss)-1)/pageSize {
+		return nil
+	}
+
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if end > len(ss) {
+		end = len(ss)
+	}
+
+	return ss[start:end:end]
+}
+`,
+	"Pairwise": `package functions
+
+// Pairwise returns each pair of consecutive elements. For example,
+// {1, 2, 3}.Pairwise() is {{1, 2}, {2, 3}}.
+//
+// If the slice has less than two elements, nil is returned.
+func (ss SliceType) Pairwise() (pairs [][2]ElementType) {
+	for i := 1; i < len(ss); i++ {
+		pairs = append(pairs, [2]ElementType{ss[i-1], ss[i]})
+	}
+
+	return
+}
+`,
+	"ParseFloat64s": `package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseFloat64s converts each element to a float64 with strconv.ParseFloat. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first. See
+// ParseFloat64sSkipInvalid to keep the elements that can be parsed.
+func (ss StringSliceType) ParseFloat64s() (pie.Float64s, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Float64s, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+`,
+	"ParseFloat64sSkipInvalid": `package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseFloat64sSkipInvalid converts each element to a float64 with
+// strconv.ParseFloat. Unlike ParseFloat64s, elements that cannot be parsed do
+// not stop the conversion. Instead they are returned separately as invalid, so
+// both slices keep the original order of the elements.
+//
+// Either slice may contain zero elements (nil).
+func (ss StringSliceType) ParseFloat64sSkipInvalid() (valid pie.Float64s, invalid StringSliceType) {
+	for _, s := range ss {
+		f, err := strconv.ParseFloat(string(s), 64)
+		if err != nil {
+			invalid = append(invalid, s)
+			continue
+		}
+
+		valid = append(valid, f)
+	}
+
+	return
+}
+`,
+	"ParseInts": `package functions
+
+import (
+	"strconv"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseInts converts each element to an int with strconv.Atoi. It will stop at
+// the first element that cannot be parsed and return a nil slice with the
+// error, which is a *strconv.NumError that includes the element.
+//
+// Elements are not trimmed, so you may want to call TrimSpace first.
+func (ss StringSliceType) ParseInts() (pie.Ints, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Ints, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = strconv.Atoi(string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+`,
+	"ParseTimes": `package functions
+
+import (
+	"time"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// ParseTimes converts each element to a time with time.Parse using layout. It
+// will stop at the first element that cannot be parsed and return a nil slice
+// with the error.
+//
+// See pie.Times.Format for the reverse.
+func (ss StringSliceType) ParseTimes(layout string) (pie.Times, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	result := make(pie.Times, len(ss))
+	for i, s := range ss {
+		var err error
+		result[i], err = time.Parse(layout, string(s))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+`,
+	"Partition": `package functions
+
+// Partition splits the slice into the elements that return true from the
+// condition (matching) and those that return false (rest) in a single pass.
+// It is the same as calling Select and Unselect with the same condition.
+//
+// The order of elements is retained. Either slice may contain zero elements
+// (nil).
+func (ss SliceType) Partition(condition func(ElementType) bool) (matching, rest SliceType) {
+	for _, s := range ss {
+		if condition(s) {
+			matching = append(matching, s)
+		} else {
+			rest = append(rest, s)
+		}
+	}
+
+	return
+}
+`,
+	"Percentile": `package functions
+
+import (
+	"math"
+	"sort"
+)
+
+// Percentile returns the value below which p percent of the elements fall. p
+// must be between 0 and 100, values outside of this range will be clamped.
+//
+// When the percentile lies between two elements the result is linearly
+// interpolated between them. This is the same method used by Excel's
+// PERCENTILE.INC and NumPy's default percentile.
+//
+//   latencies.Percentile(95)
+//
+// Zero is returned if there are no elements and NaN is returned if p is NaN.
+// The input slice is not modified.
+func (ss SliceType) Percentile(p float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(l-1)
+	lower := int(rank)
+	if lower == l-1 {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+`,
+	"Permutations": `package functions
+
+// Permutations returns every ordering of the elements. There are n!
+// permutations of n elements, so consider EachPermutation to avoid holding
+// them all in memory.
+//
+// Elements are treated by their position, so duplicate elements produce
+// duplicate permutations. The input slice is not modified and nil is returned
+// if there are no elements.
+func (ss SliceType) Permutations() (permutations []SliceType) {
+	ss.EachPermutation(func(permutation SliceType) bool {
+		p := make(SliceType, len(permutation))
+		copy(p, permutation)
+		permutations = append(permutations, p)
+
+		return true
+	})
+
+	return
+}
+`,
+	"Pop": `package functions
+
+// Pop returns the last element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss but it is limited in capacity so
+// that appending to it will not modify ss.
+//
+// See Shift().
+func (ss SliceType) Pop() (last ElementType, rest SliceType) {
+	n := len(ss)
+	if n == 0 {
+		return ElementZeroValue, nil
+	}
+
+	if n == 1 {
+		return ss[0], nil
+	}
+
+	return ss[n-1], ss[: n-1 : n-1]
+}
+`,
+	"Proportions": `package functions
+
+// Proportions returns a new slice where each element is divided by the sum of
+// all of the elements. If the elements are all positive the result is a
+// probability distribution that adds up to 1.
+//
+// If the elements add up to zero every element in the result will be NaN or
+// infinity. nil is returned if there are no elements.
+func (ss SliceType) Proportions() SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s)
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(float64(s) / sum)
+	}
+
+	return result
+}
+`,
+	"Quantiles": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+	"sort"
+)
+
+// Quantiles returns the n-1 cut points that divide the elements into n groups
+// of equal size. For example, Quantiles(4) returns the quartiles and
+// Quantiles(100) returns the percentiles.
+//
+// Each cut point is linearly interpolated in the same way as Percentile(), but
+// the elements are only sorted once. If there are no elements, or n is less
+// than 2, nil is returned.
+func (ss SliceType) Quantiles(n int) pie.Float64s {
+	l := len(ss)
+	if l == 0 || n < 2 {
+		return nil
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	quantiles := make(pie.Float64s, n-1)
+	for i := range quantiles {
+		rank := float64(i+1) / float64(n) * float64(l-1)
+		lower := int(rank)
+
+		if lower == l-1 {
+			quantiles[i] = sorted[lower]
+		} else {
+			quantiles[i] = sorted[lower] + (rank-float64(lower))*(sorted[lower+1]-sorted[lower])
+		}
+	}
+
+	return quantiles
+}
+`,
+	"Random": `package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Random returns a random element by your rand.Source, or zero.
+//
+// If source is nil then a source seeded with the current time is used.
+func (ss SliceType) Random(source rand.Source) ElementType {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 1 {
+		return ElementZeroValue
+	}
+	if n < 2 {
+		return ss[0]
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	i := rnd.Intn(n)
+	return ss[i]
+}
+`,
+	"RandomIntegers": `package functions
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RandomIntegerSliceType creates a slice of n random values that are greater
+// than or equal to min and less than max. The values are evenly distributed
+// across the whole numbers in that range, which may be as wide as the element
+// type allows.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one, and every value will be min if
+// max is not greater than min.
+func RandomIntegerSliceType(n int, min, max IntegerElementType, source rand.Source) IntegerSliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	ss := make(IntegerSliceType, n)
+	for i := range ss {
+		ss[i] = min
+	}
+
+	if max <= min {
+		return ss
+	}
+
+	// The width is calculated with uint64 so that it cannot overflow, even for
+	// the full range of an int64. Values at or above limit are rejected so
+	// that the modulo does not favour the smaller values.
+	width := uint64(max) - uint64(min)
+	limit := math.MaxUint64 - math.MaxUint64%width
+
+	rnd := rand.New(source)
+	for i := range ss {
+		v := rnd.Uint64()
+		for v >= limit {
+			v = rnd.Uint64()
+		}
+
+		ss[i] += IntegerElementType(v % width)
+	}
+
+	return ss
+}
+`,
+	"RandomNumbers": `package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RandomSliceType creates a slice of n random values that are greater than or
+// equal to min and less than max.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one, and every value will be min if
+// max is not greater than min.
+func RandomSliceType(n int, min, max ElementType, source rand.Source) SliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+	width := float64(max) - float64(min)
+	if width < 0 {
+		width = 0
+	}
+
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = min + ElementType(rnd.Float64()*width)
+	}
+
+	return ss
+}
+`,
+	"RandomStrings": `package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// RandomStringSliceType creates a slice of n random strings that each contain
+// length letters and digits.
+//
+// Passing in a seeded source makes the values deterministic, which is useful
+// for tests. If source is nil then a source seeded with the current time is
+// used. nil is returned if n is less than one.
+func RandomStringSliceType(n, length int, source rand.Source) StringSliceType {
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	rnd := rand.New(source)
+	ss := make(StringSliceType, n)
+	for i := range ss {
+		b := make([]byte, length)
+		for j := range b {
+			b[j] = chars[rnd.Intn(len(chars))]
+		}
+
+		ss[i] = StringElementType(b)
+	}
+
+	return ss
+}
+`,
+	"Range": `package functions
+
+import (
+	"math"
+)
+
+// SliceTypeRange creates a slice of the values from start up to, but not
+// including, stop, increasing by step each time. A negative step counts down
+// from start to stop instead:
+//
+//   SliceTypeRange(0, 10, 3)  // [0 3 6 9]
+//   SliceTypeRange(5, 0, -2)  // [5 3 1]
+//
+// Each value is calculated from start rather than adding step repeatedly, so
+// rounding errors do not accumulate for floating point types. nil is returned
+// if step is zero or moves away from stop.
+func SliceTypeRange(start, stop, step ElementType) SliceType {
+	if step == 0 {
+		return nil
+	}
+
+	n := int(math.Ceil((float64(stop) - float64(start)) / float64(step)))
+	if n <= 0 {
+		return nil
+	}
+
+	ss := make(SliceType, n)
+	for i := range ss {
+		ss[i] = start + ElementType(i)*step
+	}
+
+	return ss
+}
+`,
+	"RangeIntegers": `package functions
+
+// IntegerSliceTypeRange creates a slice of the values from start up to, but not
+// including, stop, increasing by step each time. A negative step counts down
+// from start to stop instead:
+//
+//   IntegerSliceTypeRange(0, 10, 3)  // [0 3 6 9]
+//   IntegerSliceTypeRange(5, 0, -2)  // [5 3 1]
+//
+// The number of values is calculated with uint64 so that it is exact, even
+// for the full range of an int64. nil is returned if step is zero or moves away
+// from stop.
+func IntegerSliceTypeRange(start, stop, step IntegerElementType) IntegerSliceType {
+	var width, size uint64
+	switch {
+	case step > 0 && stop > start:
+		width, size = uint64(stop)-uint64(start), uint64(step)
+
+	case step < 0 && stop < start:
+		width, size = uint64(start)-uint64(stop), -uint64(step)
+
+	default:
+		return nil
+	}
+
+	n := width / size
+	if width%size != 0 {
+		n++
+	}
+
+	ss := make(IntegerSliceType, n)
+	for i := range ss {
+		ss[i] = start + IntegerElementType(i)*step
+	}
+
+	return ss
+}
+`,
+	"Rank": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see pie.RankMethod.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss SliceType) Rank(method pie.RankMethod) pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(pie.Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) && ss[indexes[end]] == ss[indexes[start]] {
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case pie.RankMin:
+			rank = float64(start + 1)
+
+		case pie.RankMax:
+			rank = float64(end)
+
+		case pie.RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
+`,
+	"Reduce": `package functions
+
+// Reduce continually applies the provided function over the slice, starting
+// with initial. The return value of each call becomes the accumulator passed
+// to the next call. It follows the same logic as reduce() in Python.
+//
+//   product := Float64s{2, 3, 4}.Reduce(1, func(acc, value float64) float64 {
+//       return acc * value
+//   })
+//
+// If the slice is empty then initial is returned.
+func (ss SliceType) Reduce(initial ElementType, fn func(acc, value ElementType) ElementType) ElementType {
+	acc := initial
+	for _, value := range ss {
+		acc = fn(acc, value)
+	}
+
+	return acc
+}
+`,
+	"RemoveOutliers": `package functions
+
+// RemoveOutliers returns a new slice without the elements that are more than
+// multiplier times the interquartile range (IQR) below the first quartile or
+// above the third quartile. A multiplier of 1.5 is the conventional choice.
+//
+// The remaining elements keep their original order. See Outliers.
+func (ss SliceType) RemoveOutliers(multiplier float64) SliceType {
+	q1, q3 := float64(ss.Percentile(25)), float64(ss.Percentile(75))
+	lower, upper := q1-multiplier*(q3-q1), q3+multiplier*(q3-q1)
+
+	return ss.Select(func(s ElementType) bool {
+		return float64(s) >= lower && float64(s) <= upper
+	})
+}
+`,
+	"Reverse": `package functions
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//   ss.Sort().Reverse()
+//
+func (ss SliceType) Reverse() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// reversed.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]ElementType, len(ss))
+	for i := 0; i < len(ss); i++ {
+		sorted[i] = ss[len(ss)-i-1]
+	}
+
+	return sorted
+}
+`,
+	"ReverseInPlace": `package functions
+
+// ReverseInPlace works the same as Reverse, except that the elements are
+// reversed in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Reverse if you need to keep the original.
+func (ss SliceType) ReverseInPlace() SliceType {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss
+}
+`,
+	"Rolling": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rolling calls fn for every window of size consecutive elements and returns
+// the results. The window slides one element at a time, so the result will
+// have len(ss)-window+1 elements:
+//
+//   prices.Rolling(3, func (window Float64s) float64 {
+//       return window.Max()
+//   })
+//
+// The window passed to fn shares memory with the input slice. It must not be
+// modified or retained after fn returns.
+//
+// If window is less than one, or greater than the number of elements, nil is
+// returned.
+func (ss SliceType) Rolling(window int, fn func(SliceType) float64) pie.Float64s {
+	if window < 1 || window > len(ss) {
+		return nil
+	}
+
+	results := make(pie.Float64s, len(ss)-window+1)
+	for i := range results {
+		results[i] = fn(ss[i : i+window : i+window])
+	}
+
+	return results
+}
+`,
+	"Rotate": `package functions
+
+// Rotate returns a new slice with the elements cyclically shifted n places to
+// the right, so {1, 2, 3}.Rotate(1) is {3, 1, 2}. A negative n shifts to the
+// left. n may be larger than the length of the slice.
+func (ss SliceType) Rotate(n int) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// rotated.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	rotated := make(SliceType, len(ss))
+	copy(rotated, ss[len(ss)-n:])
+	copy(rotated[n:], ss[:len(ss)-n])
+
+	return rotated
+}
+`,
+	"RotateInPlace": `package functions
+
+// RotateInPlace works the same as Rotate, except that the elements are rotated
+// in the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Rotate if you need to keep the original.
+func (ss SliceType) RotateInPlace(n int) SliceType {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n %= len(ss)
+	if n < 0 {
+		n += len(ss)
+	}
+
+	// Rotating right by n is the same as reversing the whole slice and then
+	// reversing each side of n.
+	reverse := func(s SliceType) {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	}
+
+	reverse(ss)
+	reverse(ss[:n])
+	reverse(ss[n:])
+
+	return ss
+}
+`,
+	"Round": `package functions
+
+import (
+	"math"
+)
+
+// Round returns a new slice with each element rounded to the number of
+// decimal places. Halfway values are rounded away from zero, like math.Round.
+// A negative number of decimals rounds to the left of the decimal point, so -2
+// rounds to the nearest hundred.
+func (ss SliceType) Round(decimals int) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	scale := math.Pow10(decimals)
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Round(float64(s)*scale) / scale)
+	}
+
+	return result
+}
+`,
+	"Sample": `package functions
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Sample returns n elements picked at random by your rand.Source. Each element
+// can only be picked once (sampling without replacement), although the slice
+// may contain duplicate values.
+//
+// It performs a partial Fisher-Yates shuffle that only keeps track of the
+// swapped positions, so the input slice is never copied or modified.
+//
+// If n is greater than the number of elements then all of the elements will be
+// returned in a random order. If n < 1 then nil is returned. If source is nil
+// then a source seeded with the current time is used.
+func (ss SliceType) Sample(n int, source rand.Source) SliceType {
+	l := len(ss)
+	if n > l {
+		n = l
+	}
+
+	if n < 1 {
+		return nil
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	// swapped maps a position to the index of the element that would be there
+	// if the whole slice was being shuffled.
+	swapped := map[int]int{}
+	indexAt := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	sample := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		j := i + rnd.Intn(l-i)
+		sample[i] = ss[indexAt(j)]
+		swapped[j] = indexAt(i)
+	}
+
+	return sample
+}
+`,
+	"Scan": `package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// Scan implements sql.Scanner so that an array column can be scanned directly
+// into the slice. It accepts a one-dimensional PostgreSQL array literal, such
+// as {1,2,3}.
+//
+// NULL is scanned as an empty slice. An error is returned if any of the
+// elements are NULL.
+func (ss *SliceType) Scan(src interface{}) error {
+	return util.ScanArrayLiteral(src, reflect.ValueOf(ss))
+}
+`,
+	"SearchSorted": `package functions
+
+import (
+	"sort"
+)
+
+// SearchSorted uses a binary search to find value in a slice that is already
+// sorted in ascending order (see Sort). If value exists, index is the position
+// of the first occurrence and found is true. Otherwise, index is the position
+// where value would need to be inserted to keep the slice sorted.
+//
+// The result is undefined if the slice is not sorted.
+func (ss SliceType) SearchSorted(value ElementType) (index int, found bool) {
+	index = sort.Search(len(ss), func(i int) bool {
+		return ss[i] >= value
+	})
+
+	return index, index < len(ss) && ss[index] == value
+}
+`,
+	"Select": `package functions
+
+// Select will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// Unselect works in the opposite way as Select.
+func (ss SliceType) Select(condition func(ElementType) bool) (ss2 SliceType) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"Set": `package functions
+
+import (
+	"sort"
+)
+
+// SliceTypeSet is an unordered collection of unique elements backed by a map.
+// Unlike the slice based Contains, checking membership is O(1), which makes it
+// a better fit for membership-heavy workloads.
+//
+// A nil set can be read from but not added to. Use NewSliceTypeSet or convert
+// the result of ToSet to create one.
+type SliceTypeSet map[ElementType]struct{}
+
+// NewSliceTypeSet creates a set containing each of the values. Duplicate values
+// are only stored once.
+func NewSliceTypeSet(values ...ElementType) SliceTypeSet {
+	s := make(SliceTypeSet, len(values))
+
+	return s.Add(values...)
+}
+
+// Set returns a set of the unique elements in the slice.
+func (ss SliceType) Set() SliceTypeSet {
+	return NewSliceTypeSet(ss...)
+}
+
+// Add will insert each of the values into the set. The set is modified in place
+// and returned for chaining.
+func (s SliceTypeSet) Add(values ...ElementType) SliceTypeSet {
+	for _, value := range values {
+		s[value] = struct{}{}
+	}
+
+	return s
+}
+
+// Remove will delete each of the values from the set. Values that are not in
+// the set are ignored. The set is modified in place and returned for chaining.
+func (s SliceTypeSet) Remove(values ...ElementType) SliceTypeSet {
+	for _, value := range values {
+		delete(s, value)
+	}
+
+	return s
+}
+
+// Contains returns true if the value is in the set.
+func (s SliceTypeSet) Contains(value ElementType) bool {
+	_, ok := s[value]
+
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s SliceTypeSet) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing the elements that are in either set.
+func (s SliceTypeSet) Union(s2 SliceTypeSet) SliceTypeSet {
+	union := make(SliceTypeSet, len(s)+len(s2))
+	for value := range s {
+		union[value] = struct{}{}
+	}
+
+	for value := range s2 {
+		union[value] = struct{}{}
+	}
+
+	return union
+}
+
+// Intersect returns a new set containing the elements that are in both sets.
+func (s SliceTypeSet) Intersect(s2 SliceTypeSet) SliceTypeSet {
+	// Iterate over the smaller set.
+	if len(s2) < len(s) {
+		s, s2 = s2, s
+	}
+
+	intersect := make(SliceTypeSet)
+	for value := range s {
+		if _, ok := s2[value]; ok {
+			intersect[value] = struct{}{}
+		}
+	}
+
+	return intersect
+}
+
+// Diff returns a new set containing the elements that are in s but not in s2.
+//
+// This is different from the slice Diff, which returns both the added and
+// removed elements.
+func (s SliceTypeSet) Diff(s2 SliceTypeSet) SliceTypeSet {
+	diff := make(SliceTypeSet)
+	for value := range s {
+		if _, ok := s2[value]; !ok {
+			diff[value] = struct{}{}
+		}
+	}
+
+	return diff
+}
+
+// Slice returns the elements of the set as a slice in ascending order. An empty
+// set returns nil.
+func (s SliceTypeSet) Slice() SliceType {
+	if len(s) == 0 {
+		return nil
+	}
+
+	ss := make(SliceType, 0, len(s))
+	for value := range s {
+		ss = append(ss, value)
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+`,
+	"Shift": `package functions
+
+// Shift returns the first element and the remaining elements. If the slice is
+// empty then the zero value and nil are returned.
+//
+// rest uses the same underlying array as ss.
+//
+// See Pop().
+func (ss SliceType) Shift() (first ElementType, rest SliceType) {
+	if len(ss) == 0 {
+		return ElementZeroValue, nil
+	}
+
+	if len(ss) == 1 {
+		return ss[0], nil
+	}
+
+	return ss[0], ss[1:]
+}
+`,
+	"Shuffle": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie/util"
+	"math/rand"
+	"time"
+)
+
+// Shuffle returns shuffled slice by your rand.Source. The input slice is not
+// modified.
+//
+// Passing in a seeded source makes the order deterministic, which is useful for
+// tests. If source is nil then a source seeded with the current time is used.
+func (ss SliceType) Shuffle(source rand.Source) SliceType {
+	n := len(ss)
+
+	// Avoid the extra allocation.
+	if n < 2 {
+		return ss
+	}
+
+	// go 1.10+ provides rnd.Shuffle. However, to support older versions we copy
+	// the algorithm directly from the go source: src/math/rand/rand.go below,
+	// with some adjustments:
+	shuffled := make([]ElementType, n)
+	copy(shuffled, ss)
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	rnd := rand.New(source)
+
+	util.Shuffle(rnd, n, func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+`,
+	"ShuffleInPlace": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie/util"
+	"math/rand"
+	"time"
+)
+
+// ShuffleInPlace works the same as Shuffle, except that the elements are
+// shuffled in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Shuffle if you need to keep the original.
+func (ss SliceType) ShuffleInPlace(source rand.Source) SliceType {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	if source == nil {
+		source = rand.NewSource(time.Now().UnixNano())
+	}
+
+	util.Shuffle(rand.New(source), len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+
+	return ss
+}
+`,
+	"Smallest": `package functions
+
+import (
+	"sort"
+)
+
+// Smallest returns the n smallest elements in ascending order. If the slice
+// has less than n elements then all elements are returned. If n < 1 it will
+// return nil.
+//
+// Unlike Sort().Top(n) it does not sort the whole slice. Only the n smallest
+// elements seen so far are kept, which is much faster when n is small compared
+// to the number of elements.
+//
+// This is not called Bottom because Bottom() already returns the last n
+// elements by position, regardless of their value.
+func (ss SliceType) Smallest(n int) (smallest SliceType) {
+	if n < 1 {
+		return nil
+	}
+
+	for _, s := range ss {
+		if len(smallest) < n {
+			smallest = append(smallest, s)
+		} else if !(s < smallest[n-1]) {
+			continue
+		}
+
+		// The last element is free to be overwritten, either because it was
+		// just appended or because it is the largest that will be dropped.
+		i := sort.Search(len(smallest)-1, func(i int) bool {
+			return s < smallest[i]
+		})
+		copy(smallest[i+1:], smallest[i:len(smallest)-1])
+		smallest[i] = s
+	}
+
+	return
+}
+`,
+	"Softmax": `package functions
+
+import (
+	"math"
+)
+
+// Softmax returns a new slice where each element is e raised to the power of
+// the element, divided by the sum of those values. The result is a probability
+// distribution where each element is between 0 and 1 and the elements add up
+// to 1. Larger elements receive exponentially larger probabilities.
+//
+// The largest element is subtracted before exponentiating so that large
+// elements do not overflow. nil is returned if there are no elements.
+func (ss SliceType) Softmax() SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	max := float64(ss[0])
+	for _, s := range ss[1:] {
+		if float64(s) > max {
+			max = float64(s)
+		}
+	}
+
+	exps := make([]float64, len(ss))
+	var sum float64
+	for i, s := range ss {
+		exps[i] = math.Exp(float64(s) - max)
+		sum += exps[i]
+	}
+
+	result := make(SliceType, len(ss))
+	for i, e := range exps {
+		result[i] = ElementType(e / sum)
+	}
+
+	return result
+}
+`,
+	"Sort": `package functions
+
+import (
+	"sort"
+)
+
+// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// See Reverse() and AreSorted().
+func (ss SliceType) Sort() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]ElementType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+`,
+	"SortFold": `package functions
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortFold works the same as Sort, except that the comparison ignores case.
+// Elements that only differ by case will retain their original order.
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss StringSliceType) SortFold() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(StringSliceType, len(ss))
+	copy(sorted, ss)
+
+	keys := make(map[StringElementType]string, len(ss))
+	for _, s := range ss {
+		keys[s] = strings.ToLower(strings.ToUpper(string(s)))
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keys[sorted[i]] < keys[sorted[j]]
+	})
+
+	return sorted
+}
+`,
+	"SortInPlace": `package functions
+
+import (
+	"sort"
+)
+
+// SortInPlace works the same as Sort, except that the elements are sorted in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Sort if you need to keep the original.
+func (ss SliceType) SortInPlace() SliceType {
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return ss
+}
+`,
+	"SortNatural": `package functions
+
+import (
+	"sort"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// SortNatural works the same as Sort, except that runs of digits are compared
+// by their numeric value. That is, "file2" will be sorted before "file10".
+//
+// The slice returned will be reallocated as to not modify the input slice.
+func (ss StringSliceType) SortNatural() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(StringSliceType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return util.NaturalLess(string(sorted[i]), string(sorted[j]))
+	})
+
+	return sorted
+}
+`,
+	"SortStableUsing": `package functions
+
+import (
+	"sort"
+)
+
+// SortStableUsing works the same as SortUsing, except that elements that are
+// equal will keep their original order. It is a wrapper for sort.SliceStable.
+func (ss SliceType) SortStableUsing(less func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+`,
+	"SortUsing": `package functions
+
+import (
+	"sort"
+)
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// The less function is given the elements themselves rather than indexes:
+//
+//   cars.SortUsing(func (a, b Car) bool {
+//       return a.Name < b.Name
+//   })
+//
+// See SortStableUsing() if you need equal elements to retain their order.
+func (ss SliceType) SortUsing(less func(a, b ElementType) bool) SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make(SliceType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	return sorted
+}
+`,
+	"Sorted": `package functions
+
+import (
+	"sort"
+)
+
+// SliceTypeSorted keeps its elements sorted in ascending order as they are
+// inserted. This allows Contains, Index and RangeBetween to use a binary
+// search rather than scanning every element.
+//
+// The zero value is an empty sorted slice that is ready to use.
+type SliceTypeSorted struct {
+	ss SliceType
+}
+
+// NewSliceTypeSorted creates a sorted slice containing each of the values.
+// The values are copied so they are not modified.
+func NewSliceTypeSorted(values ...ElementType) *SliceTypeSorted {
+	ss := make(SliceType, len(values))
+	copy(ss, values)
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j]
+	})
+
+	return &SliceTypeSorted{ss: ss}
+}
+
+// search returns the index of the first element that is not less than value.
+func (s *SliceTypeSorted) search(value ElementType) int {
+	return sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] >= value
+	})
+}
+
+// Insert adds each of the values in their sorted position. Each insert is
+// O(log n) to find the position and O(n) to make room for the value.
+func (s *SliceTypeSorted) Insert(values ...ElementType) *SliceTypeSorted {
+	for _, value := range values {
+		i := s.search(value)
+		s.ss = append(s.ss, ElementZeroValue)
+		copy(s.ss[i+1:], s.ss[i:])
+		s.ss[i] = value
+	}
+
+	return s
+}
+
+// Remove deletes the first occurrence of value and returns true. If value does
+// not exist false is returned.
+func (s *SliceTypeSorted) Remove(value ElementType) bool {
+	i := s.Index(value)
+	if i == -1 {
+		return false
+	}
+
+	s.ss = append(s.ss[:i], s.ss[i+1:]...)
+
+	return true
+}
+
+// Contains returns true if the value exists.
+func (s *SliceTypeSorted) Contains(value ElementType) bool {
+	return s.Index(value) != -1
+}
+
+// Index returns the position of the first occurrence of value, or -1 if it
+// does not exist.
+func (s *SliceTypeSorted) Index(value ElementType) int {
+	i := s.search(value)
+	if i < len(s.ss) && s.ss[i] == value {
+		return i
+	}
+
+	return -1
+}
+
+// RangeBetween returns a new slice of the elements that are greater than or
+// equal to min and less than or equal to max, in ascending order. If min is
+// greater than max nil is returned.
+func (s *SliceTypeSorted) RangeBetween(min, max ElementType) SliceType {
+	start := s.search(min)
+	end := sort.Search(len(s.ss), func(i int) bool {
+		return s.ss[i] > max
+	})
+
+	if start >= end {
+		return nil
+	}
+
+	result := make(SliceType, end-start)
+	copy(result, s.ss[start:end])
+
+	return result
+}
+
+// Len returns the number of elements.
+func (s *SliceTypeSorted) Len() int {
+	return len(s.ss)
+}
+
+// Slice returns a copy of the elements in ascending order. An empty sorted
+// slice returns nil.
+func (s *SliceTypeSorted) Slice() SliceType {
+	if len(s.ss) == 0 {
+		return nil
+	}
+
+	result := make(SliceType, len(s.ss))
+	copy(result, s.ss)
+
+	return result
+}
+`,
+	"Splice": `package functions
+
+// Splice returns a new slice with deleteCount elements removed from start and
+// the values inserted in their place. It works the same way as
+// Array.prototype.splice() in JavaScript, except that ss is not modified.
+//
+// start is limited to the bounds of the slice and deleteCount is limited to
+// the number of elements after start.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Splice(start, deleteCount int, values ...ElementType) SliceType {
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(ss) {
+		start = len(ss)
+	}
+
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+
+	if deleteCount > len(ss)-start {
+		deleteCount = len(ss) - start
+	}
+
+	n := len(ss) - deleteCount + len(values)
+	if n == 0 {
+		return nil
+	}
+
+	result := make(SliceType, 0, n)
+	result = append(result, ss[:start]...)
+	result = append(result, values...)
+
+	return append(result, ss[start+deleteCount:]...)
+}
+`,
+	"SplitAt": `package functions
+
+// SplitAt returns the elements before index (left) and the elements from index
+// onwards (right). index is limited to the bounds of the slice. Either slice
+// may contain zero elements (nil).
+//
+// Both slices use the same underlying array as ss, but left is limited in
+// capacity so that appending to it will not modify right.
+func (ss SliceType) SplitAt(index int) (left, right SliceType) {
+	if index < 0 {
+		index = 0
+	}
+
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	if index > 0 {
+		left = ss[:index:index]
+	}
+
+	if index < len(ss) {
+		right = ss[index:]
+	}
+
+	return
+}
+`,
+	"Sqrt": `package functions
+
+import (
+	"math"
+)
+
+// Sqrt returns a new slice with the square root of each element. Negative
+// elements become NaN.
+func (ss SliceType) Sqrt() SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Sqrt(float64(s)))
+	}
+
+	return result
+}
+`,
+	"StandardDeviation": `package functions
+
+import (
+	"math"
+)
+
+// StandardDeviation is the population standard deviation of the elements. It
+// is the square root of Variance(). Zero is returned if there are no elements.
+func (ss SliceType) StandardDeviation() float64 {
+	return math.Sqrt(ss.Variance())
+}
+`,
+	"Strings": `package functions
+
+import (
+	"fmt"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Strings returns a new slice with each element formatted in the default
+// format, the same as fmt.Sprint. Element types that have a String method,
+// such as time.Duration, use that method. See Format to control the format.
+func (ss SliceType) Strings() pie.Strings {
+	if ss == nil {
+		return nil
+	}
+
+	result := make(pie.Strings, len(ss))
+	for i, s := range ss {
+		result[i] = fmt.Sprint(s)
+	}
+
+	return result
+}
+`,
+	"Subtract": `package functions
+
+// Subtract returns a new slice where each element of ss2 has been subtracted
+// from the element at the same position in ss.
+//
+// If the slices are different lengths then the result will only be as long as
+// the shorter slice. If ss is nil then nil is returned.
+func (ss SliceType) Subtract(ss2 SliceType) SliceType {
+	if ss == nil {
+		return nil
+	}
+
+	n := len(ss)
+	if len(ss2) < n {
+		n = len(ss2)
+	}
+
+	result := make(SliceType, n)
+	for i := 0; i < n; i++ {
+		result[i] = ss[i] - ss2[i]
+	}
+
+	return result
+}
+`,
+	"Sum": `package functions
+
+// Sum is the sum of all of the elements.
+func (ss SliceType) Sum() (sum ElementType) {
+	for _, s := range ss {
+		sum += s
+	}
+
+	return
+}
+`,
+	"SumAccurate": `package functions
+
+import (
+	"math"
+)
+
+// SumAccurate is the sum of all of the elements using compensated
+// (Kahan-Babuska) summation. It tracks the rounding error lost from each
+// addition and adds it back at the end, so it is much more accurate than Sum
+// when adding many values or values with very different magnitudes. It is a
+// little slower.
+func (ss SliceType) SumAccurate() ElementType {
+	var sum, compensation float64
+	for _, s := range ss {
+		v := float64(s)
+		t := sum + v
+		if math.Abs(sum) >= math.Abs(v) {
+			compensation += (sum - t) + v
+		} else {
+			compensation += (v - t) + sum
+		}
+		sum = t
+	}
+
+	return ElementType(sum + compensation)
+}
+`,
+	"SumChecked": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// SumChecked is the sum of all of the elements. Unlike Sum, which silently
+// wraps around, pie.ErrOverflow is returned if the sum cannot be represented by
+// the element type.
+//
+// Overflow is checked after each addition, so an error may be returned even if
+// later elements would have brought the sum back into range.
+func (ss SliceType) SumChecked() (ElementType, error) {
+	var sum ElementType
+	for _, s := range ss {
+		next := sum + s
+		if (s > 0 && next < sum) || (s < 0 && next > sum) {
+			return 0, pie.ErrOverflow
+		}
+
+		sum = next
+	}
+
+	return sum, nil
+}
+`,
+	"SumSkipNaN": `package functions
+
+import (
+	"math"
+)
+
+// SumSkipNaN is the sum of all of the elements that are not NaN (not a
+// number). Unlike Sum, a NaN element does not cause the result to be NaN.
+func (ss SliceType) SumSkipNaN() (sum ElementType) {
+	for _, s := range ss {
+		if !math.IsNaN(float64(s)) {
+			sum += s
+		}
+	}
+
+	return
+}
+`,
+	"Swap": `package functions
+
+// Swap returns a new slice with the elements at i and j swapped. It will panic
+// if either index is out of range, in the same way as indexing the slice.
+func (ss SliceType) Swap(i, j int) SliceType {
+	swapped := make(SliceType, len(ss))
+	copy(swapped, ss)
+	swapped[i], swapped[j] = swapped[j], swapped[i]
+
+	return swapped
+}
+`,
+	"SwapInPlace": `package functions
+
+// SwapInPlace works the same as Swap, except that the elements are swapped in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained.
+//
+// This modifies the input slice. Use Swap if you need to keep the original.
+func (ss SliceType) SwapInPlace(i, j int) SliceType {
+	ss[i], ss[j] = ss[j], ss[i]
+
+	return ss
+}
+`,
+	"Sync": `package functions
+
+import (
+	"sync"
+)
+
+// SliceTypeSync guards a slice with a read/write mutex so that it can be safely
+// appended to and read from many goroutines.
+//
+// The zero value is an empty slice that is ready to use. A SliceTypeSync must
+// not be copied after first use.
+type SliceTypeSync struct {
+	mu sync.RWMutex
+	ss SliceType
+}
+
+// Append adds the values to the end of the slice.
+func (s *SliceTypeSync) Append(values ...ElementType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ss = append(s.ss, values...)
+}
+
+// Len returns the number of elements.
+func (s *SliceTypeSync) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ss)
+}
+
+// Snapshot returns a copy of the elements at the time it was called. Any of the
+// usual functions can be used on the result without holding the lock:
+//
+//   valid := s.Snapshot().Select(isValid)
+func (s *SliceTypeSync) Snapshot() SliceType {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ss == nil {
+		return nil
+	}
+
+	ss := make(SliceType, len(s.ss))
+	copy(ss, s.ss)
+
+	return ss
+}
+
+// Read calls fn with the elements while holding the read lock. This avoids the
+// copy made by Snapshot for read-only queries:
+//
+//   s.Read(func(ss SliceType) {
+//     found = ss.Contains(value)
+//   })
+//
+// The slice must not be modified or retained after fn returns.
+func (s *SliceTypeSync) Read(fn func(ss SliceType)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn(s.ss)
+}
+`,
+	"Take": `package functions
+
+// Take returns a new slice containing the first n elements. If the slice has
+// less than n elements then all elements are returned. If n <= 0 an empty
+// slice (nil) is returned.
+//
+// See Drop(), TakeWhile() and Top().
+func (ss SliceType) Take(n int) (ss2 SliceType) {
+	if n > len(ss) {
+		n = len(ss)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	ss2 = make(SliceType, n)
+	copy(ss2, ss)
+
+	return
+}
+`,
+	"TakeWhile": `package functions
+
+// TakeWhile returns a new slice containing the elements from the start of the
+// slice for as long as the condition returns true. The first element that
+// returns false, and all elements after it, are not included.
+//
+// The returned slice may contain zero elements (nil).
+//
+// See DropWhile().
+func (ss SliceType) TakeWhile(condition func(ElementType) bool) (ss2 SliceType) {
+	for _, s := range ss {
+		if !condition(s) {
+			break
+		}
+
+		ss2 = append(ss2, s)
+	}
+
+	return
+}
+`,
+	"ToChannel": `package functions
+
+// ToChannel returns a channel that receives each of the elements in order. The
+// channel is closed after the last element has been sent.
+//
+// The elements are sent from a new goroutine, so the channel must be drained
+// or the goroutine will never finish. Use ToChannelCtx if you may need to stop
+// receiving early.
+func (ss SliceType) ToChannel() <-chan ElementType {
+	ch := make(chan ElementType)
+
+	go func() {
+		for _, s := range ss {
+			ch <- s
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+`,
+	"ToChannelCtx": `package functions
+
+import (
+	"context"
+)
+
+// ToChannelCtx works the same as ToChannel, except that it will stop sending
+// elements and close the channel when ctx is cancelled.
+func (ss SliceType) ToChannelCtx(ctx context.Context) <-chan ElementType {
+	ch := make(chan ElementType)
+
+	go func() {
+		defer close(ch)
+
+		for _, s := range ss {
+			select {
+			case ch <- s:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+`,
+	"ToFloat64s": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToFloat64s transforms each element to a float64.
+func (ss SliceType) ToFloat64s(transform func(ElementType) float64) pie.Float64s {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Float64s, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+`,
+	"ToFloat64sErr": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToFloat64sErr works the same as ToFloat64s, except that transform may
+// return an error. It will stop at the first error and return a nil slice with
+// the error.
+func (ss SliceType) ToFloat64sErr(transform func(ElementType) (float64, error)) (pie.Float64s, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(pie.Float64s, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+`,
+	"ToInts": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToInts transforms each element to an int.
+func (ss SliceType) ToInts(transform func(ElementType) int) pie.Ints {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Ints, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+`,
+	"ToIntsErr": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToIntsErr works the same as ToInts, except that transform may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss SliceType) ToIntsErr(transform func(ElementType) (int, error)) (pie.Ints, error) {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil, nil
+	}
+
+	result := make(pie.Ints, l)
+	for i := 0; i < l; i++ {
+		var err error
+		result[i], err = transform(ss[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+`,
+	"ToLower": `package functions
+
+import (
+	"strings"
+)
+
+// ToLower returns a new slice with each element converted to lower case.
+func (ss StringSliceType) ToLower() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	lower := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		lower[i] = StringElementType(strings.ToLower(string(s)))
+	}
+
+	return lower
+}
+`,
+	"ToSet": `package functions
+
+// ToSet returns a map where each element is a key. This provides O(1) lookups
+// when checking the same slice many times.
+//
+// An empty slice returns an empty (not nil) map.
+//
+// Since the elements are used as map keys this is not available for types
+// that use an Equals method or the -compare flag.
+func (ss SliceType) ToSet() map[ElementType]struct{} {
+	set := make(map[ElementType]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+
+	return set
+}
+`,
+	"ToStrings": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ToStrings transforms each element to a string.
+func (ss SliceType) ToStrings(transform func(ElementType) string) pie.Strings {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Strings, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
+`,
+	"ToUpper": `package functions
+
+import (
+	"strings"
+)
+
+// ToUpper returns a new slice with each element converted to upper case.
+func (ss StringSliceType) ToUpper() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	upper := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		upper[i] = StringElementType(strings.ToUpper(string(s)))
+	}
+
+	return upper
+}
+`,
+	"Top": `package functions
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss SliceType) Top(n int) (top SliceType) {
+	for i := 0; i < len(ss) && n > 0; i++ {
+		top = append(top, ss[i])
+		n--
+	}
+
+	return
+}
+`,
+	"TotalPages": `package functions
+
+// TotalPages returns the number of pages needed to hold all of the elements
+// when each page has pageSize elements. Zero is returned if there are no
+// elements or pageSize is less than one. See Page.
+func (ss SliceType) TotalPages(pageSize int) int {
+	if pageSize < 1 {
+		return 0
+	}
+
+	pages := len(ss) / pageSize
+	if len(ss)%pageSize != 0 {
+		pages++
+	}
+
+	return pages
+}
+`,
+	"Transform": `package functions
+
+// Transform will return a new slice where each element has been transformed.
+// The number of element returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+func (ss SliceType) Transform(fn func(ElementType) ElementType) (ss2 SliceType) {
+	if ss == nil {
+		return nil
+	}
+
+	ss2 = make([]ElementType, len(ss))
+	for i, s := range ss {
+		ss2[i] = fn(s)
+	}
+
+	return
+}
+`,
+	"TransformErr": `package functions
+
+// TransformErr works the same as Transform, except that fn may return an
+// error. It will stop at the first error and return a nil slice with the
+// error.
+func (ss SliceType) TransformErr(fn func(ElementType) (ElementType, error)) (SliceType, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	ss2 := make([]ElementType, len(ss))
+	for i, s := range ss {
+		var err error
+		ss2[i], err = fn(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ss2, nil
+}
+`,
+	"TransformInPlace": `package functions
+
+// TransformInPlace works the same as Transform, except that each element is
+// replaced in the existing slice instead of allocating a new one. The same
+// slice is returned so that it can be chained.
+//
+// This modifies the input slice. Use Transform if you need to keep the
+// original.
+func (ss SliceType) TransformInPlace(fn func(ElementType) ElementType) SliceType {
+	for i, s := range ss {
+		ss[i] = fn(s)
+	}
+
+	return ss
+}
+`,
+	"TransformParallel": `package functions
+
+import (
+	"runtime"
+	"sync"
+)
+
+// TransformParallel works the same as Transform, except that fn is called
+// concurrently from up to workers goroutines. This is useful when fn is slow
+// or blocking, such as when it makes a network request. The order of the
+// elements is always retained.
+//
+// If workers is less than one then runtime.GOMAXPROCS(0) workers will be used.
+// fn must be safe to call concurrently.
+func (ss SliceType) TransformParallel(fn func(ElementType) ElementType, workers int) (ss2 SliceType) {
+	if ss == nil {
+		return nil
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(ss) {
+		workers = len(ss)
+	}
+
+	ss2 = make([]ElementType, len(ss))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				ss2[i] = fn(ss[i])
+			}
+		}()
+	}
+
+	for i := range ss {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return
+}
+`,
+	"TrimSpace": `package functions
+
+import (
+	"strings"
+)
+
+// TrimSpace returns a new slice with the leading and trailing white space
+// removed from each element.
+func (ss StringSliceType) TrimSpace() StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	trimmed := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		trimmed[i] = StringElementType(strings.TrimSpace(string(s)))
+	}
+
+	return trimmed
+}
+`,
+	"TrimmedMean": `package functions
+
+import (
+	"sort"
+)
+
+// TrimmedMean is the average of the elements after discarding the smallest and
+// largest fraction of them. For example, a fraction of 0.1 ignores the lowest
+// 10% and highest 10% of the elements. This is more robust against outliers
+// than Average.
+//
+// The number of elements discarded from each end is rounded down. If the
+// fraction would discard every element only the middle one or two elements are
+// used, which is the same as the Median. A fraction of zero or less is the same
+// as Average. Zero is returned if there are no elements.
+func (ss SliceType) TrimmedMean(fraction float64) float64 {
+	l := len(ss)
+	if l == 0 {
+		return 0
+	}
+
+	trim := 0
+	if fraction > 0 {
+		trim = int(fraction * float64(l))
+	}
+
+	if 2*trim >= l {
+		trim = (l - 1) / 2
+	}
+
+	sorted := make([]float64, l)
+	for i, s := range ss {
+		sorted[i] = float64(s)
+	}
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, s := range sorted[trim : l-trim] {
+		sum += s
+	}
+
+	return sum / float64(l-2*trim)
+}
+`,
+	"Truncate": `package functions
+
+import (
+	"unicode/utf8"
+)
+
+// Truncate returns a new slice where each element longer than maxLen
+// characters is shortened to maxLen characters, ending with ellipsis:
+//
+//   Strings{"hello world", "hi"}.Truncate(8, "...") // ["hello...", "hi"]
+//
+// Lengths are counted in runes rather than bytes, so multi-byte characters are
+// never split. If ellipsis is longer than maxLen the elements are cut to maxLen
+// characters without it.
+func (ss StringSliceType) Truncate(maxLen int, ellipsis string) StringSliceType {
+	if ss == nil {
+		return nil
+	}
+
+	if maxLen < 0 {
+		maxLen = 0
+	}
+
+	ellipsisLen := utf8.RuneCountInString(ellipsis)
+	if ellipsisLen > maxLen {
+		ellipsis, ellipsisLen = "", 0
+	}
+
+	truncated := make(StringSliceType, len(ss))
+	for i, s := range ss {
+		if utf8.RuneCountInString(string(s)) <= maxLen {
+			truncated[i] = s
+			continue
+		}
+
+		runes := []rune(string(s))
+		truncated[i] = StringElementType(string(runes[:maxLen-ellipsisLen]) + ellipsis)
+	}
+
+	return truncated
+}
+`,
+	"Union": `package functions
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+func (ss SliceType) Union(ss2 SliceType) (union SliceType) {
+	seen := map[ElementType]struct{}{}
+
+	for _, slice := range []SliceType{ss, ss2} {
+		for _, value := range slice {
+			if _, ok := seen[value]; !ok {
+				seen[value] = struct{}{}
+				union = append(union, value)
+			}
+		}
+	}
+
+	return
+}
+`,
+	"Unique": `package functions
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// When using slices of pointers it will only compare by address, not value.
+//
+// See AreUnique().
+func (ss SliceType) Unique() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[ElementType]struct{}{}
+	uniqueValues := SliceType{}
+
+	for _, value := range ss {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+`,
+	"UniqueApprox": `package functions
+
+// UniqueApprox returns a new slice with the elements that are not within
+// epsilon of an element before them. The first of each group of approximately
+// equal elements is kept and the order is maintained.
+//
+// Each element is compared to all of the elements kept so far, so the
+// complexity is O(n*k) where k is the number of elements returned. NaN
+// elements are always kept since they are not approximately equal to
+// anything.
+func (ss SliceType) UniqueApprox(epsilon float64) SliceType {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	unique := make(SliceType, 0, len(ss))
+	for _, s := range ss {
+		if !unique.ContainsApprox(s, epsilon) {
+			unique = append(unique, s)
+		}
+	}
+
+	return unique
+}
+`,
+	"UniqueFold": `package functions
+
+import (
+	"strings"
+)
+
+// UniqueFold works the same as Unique, except that elements that only differ
+// by case are considered to be the same. The first appearance of each element
+// is kept, in its original case.
+func (ss StringSliceType) UniqueFold() StringSliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	seen := map[string]struct{}{}
+	uniqueValues := StringSliceType{}
+
+	for _, value := range ss {
+		key := strings.ToLower(strings.ToUpper(string(value)))
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+
+	return uniqueValues
+}
+`,
+	"UnmarshalBinary": `package functions
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes data that
+// was created with MarshalBinary.
+func (ss *SliceType) UnmarshalBinary(data []byte) error {
+	return util.UnmarshalBinary(data, reflect.ValueOf(ss))
+}
+`,
+	"UnmarshalJSON": `package functions
+
+import (
+	"encoding/json"
+)
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null will be decoded as an
+// empty slice, so the result can be encoded again as an array.
+func (ss *SliceType) UnmarshalJSON(data []byte) error {
+	var elements []ElementType
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []ElementType{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+`,
+	"UnmarshalYAML": `package functions
+
+// UnmarshalYAML implements yaml.Unmarshaler from gopkg.in/yaml.v2 (which is
+// also supported by gopkg.in/yaml.v3). An empty sequence is decoded as an
+// empty slice rather than nil.
+func (ss *SliceType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var elements []ElementType
+	if err := unmarshal(&elements); err != nil {
+		return err
+	}
+
+	if elements == nil {
+		elements = []ElementType{}
+	}
+
+	*ss = elements
+
+	return nil
+}
+`,
+	"Unselect": `package functions
+
+// Unselect works the same as Select, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss SliceType) Unselect(condition func(ElementType) bool) (ss2 SliceType) {
+	for _, s := range ss {
+		if !condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"Value": `package functions
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/elliotchance/pie/pie/util"
+)
+
+// Value implements driver.Valuer so that the slice can be used as a query
+// argument for an array column. It is encoded as a PostgreSQL array literal,
+// such as {1,2,3}.
+//
+// A nil slice is encoded as an empty array rather than NULL.
+func (ss SliceType) Value() (driver.Value, error) {
+	return util.ArrayLiteral(reflect.ValueOf(ss)), nil
+}
+`,
+	"Values": `package functions
+
+// Values returns the values in the map.
+//
+// Due to Go's randomization of iterating maps the order is not deterministic.
+func (m MapType) Values() []ElementType {
+	// Avoid allocation
+	l := len(m)
+	if l == 0 {
+		return nil
+	}
+
+	i := 0
+	keys := make([]ElementType, len(m))
+	for _, value := range m {
+		keys[i] = value
+		i++
+	}
+
+	return keys
+}
+`,
+	"Variance": `package functions
+
+// Variance is the population variance of the elements, that is, the average of
+// the squared differences from the mean. Zero is returned if there are no
+// elements.
+//
+// If the slice is only a sample of a larger population you may want to
+// multiply the result by n/(n-1) to get the unbiased sample variance.
+func (ss SliceType) Variance() float64 {
+	l := float64(len(ss))
+	if l == 0 {
+		return 0
+	}
+
+	mean := float64(ss.Average())
+
+	var sum float64
+	for _, s := range ss {
+		diff := float64(s) - mean
+		sum += diff * diff
+	}
+
+	return sum / l
+}
+`,
+	"WeightedAverage": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// WeightedAverage is the sum of each element multiplied by the weight at the
+// same position, divided by the sum of the weights.
+//
+// pie.ErrLengthMismatch is returned if there is not exactly one weight for each
+// element, pie.ErrEmptySlice if there are no elements and pie.ErrZeroWeights if
+// the weights add up to zero.
+func (ss SliceType) WeightedAverage(weights pie.Float64s) (float64, error) {
+	if len(ss) != len(weights) {
+		return 0, pie.ErrLengthMismatch
+	}
+
+	if len(ss) == 0 {
+		return 0, pie.ErrEmptySlice
+	}
+
+	var sum, totalWeight float64
+	for i, s := range ss {
+		sum += float64(s) * weights[i]
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return 0, pie.ErrZeroWeights
+	}
+
+	return sum / totalWeight, nil
+}
+`,
+	"Windows": `package functions
+
+// Windows returns each overlapping window of size consecutive elements. For
+// example, {1, 2, 3, 4}.Windows(3) is {{1, 2, 3}, {2, 3, 4}}.
+//
+// Like Chunk, the windows share the same underlying array as the input slice
+// but their capacity is limited so that appending to a window will never
+// overwrite other elements.
+//
+// If the slice has less than size elements, or size is less than one, nil is
+// returned.
+func (ss SliceType) Windows(size int) (windows []SliceType) {
+	if size < 1 {
+		return nil
+	}
+
+	for i := 0; i+size <= len(ss); i++ {
+		windows = append(windows, ss[i:i+size:i+size])
+	}
+
+	return
+}
+`,
+	"WithPrefix": `package functions
+
+import (
+	"strings"
+)
+
+// WithPrefix returns a new slice containing only the elements that begin with
+// prefix. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) WithPrefix(prefix string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.HasPrefix(string(s), prefix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"WithSuffix": `package functions
+
+import (
+	"strings"
+)
+
+// WithSuffix returns a new slice containing only the elements that end with
+// suffix. The returned slice may contain zero elements (nil).
+func (ss StringSliceType) WithSuffix(suffix string) (ss2 StringSliceType) {
+	for _, s := range ss {
+		if strings.HasSuffix(string(s), suffix) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"ZScore": `package functions
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// ZScore returns a new slice containing the standard score of each element.
+// That is, the number of standard deviations (see StandardDeviation) that the
+// element is above or below the mean.
+//
+// If all of the elements are the same every value will be 0. If there are no
+// elements then nil is returned.
+func (ss SliceType) ZScore() pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	mean := float64(ss.Average())
+	stddev := ss.StandardDeviation()
+
+	scores := make(pie.Float64s, len(ss))
+	if stddev == 0 {
+		return scores
+	}
+
+	for i, s := range ss {
+		scores[i] = (float64(s) - mean) / stddev
+	}
+
+	return scores
+}
+`,
+	"Zip": `package functions
+
+// SliceTypePair is a pair of elements from the same position of two slices.
+type SliceTypePair struct {
+	First, Second ElementType
+}
+
+// SliceTypePairs is created by Zip.
+type SliceTypePairs []SliceTypePair
+
+// Zip pairs each element with the element at the same position of ss2. If the
+// slices are different lengths the extra elements of the longer slice are
+// ignored. The result may contain zero pairs (nil).
+//
+// See Unzip() and ZipWith().
+func (ss SliceType) Zip(ss2 SliceType) (pairs SliceTypePairs) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		pairs = append(pairs, SliceTypePair{ss[i], ss2[i]})
+	}
+
+	return
+}
+
+// Unzip is the inverse of Zip. It returns the first and second elements of each
+// pair as separate slices.
+func (pairs SliceTypePairs) Unzip() (ss, ss2 SliceType) {
+	for _, pair := range pairs {
+		ss = append(ss, pair.First)
+		ss2 = append(ss2, pair.Second)
+	}
+
+	return
+}
+`,
+	"ZipWith": `package functions
+
+// ZipWith returns a new slice where each element is the result of fn for the
+// elements at the same position in ss and ss2. If the slices are different
+// lengths the extra elements of the longer slice are ignored. The result may
+// contain zero elements (nil).
+//
+// See Zip().
+func (ss SliceType) ZipWith(ss2 SliceType, fn func(a, b ElementType) ElementType) (ss3 SliceType) {
+	for i := 0; i < len(ss) && i < len(ss2); i++ {
+		ss3 = append(ss3, fn(ss[i], ss2[i]))
+	}
+
+	return
+}
+`,
+	"equality/CoalesceOr": `package equality
+
+// CoalesceOr returns the first element that is not the zero value (see
+// Compact), or defaultValue if there is no such element.
+//
+// Elements are compared to the zero value with ElementEquals.
+func (ss SliceType) CoalesceOr(defaultValue ElementType) ElementType {
+	var zero ElementType
+
+	for _, s := range ss {
+		if !ElementEquals(s, zero) {
+			return s
+		}
+	}
+
+	return defaultValue
+}
+`,
+	"equality/Compact": `package equality
+
+// Compact returns a new slice with the zero values removed. That is, 0 for
+// numbers, "" for strings, nil for pointers and the empty value for structs.
+//
+// Elements are compared to the zero value with ElementEquals.
+//
+// The returned slice may contain zero elements (nil).
+func (ss SliceType) Compact() (ss2 SliceType) {
+	var zero ElementType
+
+	for _, s := range ss {
+		if !ElementEquals(s, zero) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+`,
+	"equality/Contains": `package equality
+
+// Contains returns true if the element exists in the slice.
+//
+// Elements are compared with ElementEquals.
+func (ss SliceType) Contains(lookingFor ElementType) bool {
+	for _, s := range ss {
+		if ElementEquals(s, lookingFor) {
+			return true
+		}
+	}
+
+	return false
+}
+`,
+	"equality/Diff": `package equality
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of the elements is retained. Duplicate elements are compared by
+// count, so if ss contains a value twice and against contains it once, one of
+// them will be in removed.
+//
+// Both added and removed may contain zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n*m).
+func (ss SliceType) Diff(against SliceType) (added, removed SliceType) {
+	// Each element in ss can only be matched once.
+	matched := make([]bool, len(ss))
+
+values:
+	for _, value := range against {
+		for i, s := range ss {
+			if !matched[i] && ElementEquals(s, value) {
+				matched[i] = true
+				continue values
+			}
+		}
+
+		added = append(added, value)
+	}
+
+	for i, s := range ss {
+		if !matched[i] {
+			removed = append(removed, s)
+		}
+	}
+
+	return
+}
+`,
+	"equality/Equals": `package equality
+
+// Equals returns true if both slices contain the same elements in the same
+// order. A nil slice and an empty slice are considered equal.
+//
+// Elements are compared with ElementEquals.
+func (ss SliceType) Equals(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	for i, s := range ss {
+		if !ElementEquals(s, ss2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+`,
+	"equality/EqualsUnordered": `package equality
+
+// EqualsUnordered returns true if both slices contain the same elements,
+// regardless of their order. Duplicate elements are compared by count, so
+// {1, 1, 2} is not equal to {1, 2, 2}. A nil slice and an empty slice are
+// considered equal.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) EqualsUnordered(ss2 SliceType) bool {
+	if len(ss) != len(ss2) {
+		return false
+	}
+
+	// Each element in ss2 can only be matched once.
+	matched := make([]bool, len(ss2))
+
+values:
+	for _, s := range ss {
+		for i, s2 := range ss2 {
+			if !matched[i] && ElementEquals(s, s2) {
+				matched[i] = true
+				continue values
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+`,
+	"equality/IndexOf": `package equality
+
+// IndexOf returns the index of the first occurrence of lookingFor, or -1 if the
+// value does not exist in the slice.
+//
+// Elements are compared with ElementEquals.
+//
+// See LastIndexOf() and Contains().
+func (ss SliceType) IndexOf(lookingFor ElementType) int {
+	for i, s := range ss {
+		if ElementEquals(s, lookingFor) {
+			return i
+		}
+	}
+
+	return -1
+}
+`,
+	"equality/Intersect": `package equality
+
+// Intersect returns a new slice containing the elements that exist in both
+// slices. Each element will only appear once, in the order that it first
+// appears in ss. The returned slice may contain zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n*m).
+func (ss SliceType) Intersect(ss2 SliceType) (intersect SliceType) {
+values:
+	for _, value := range ss {
+		for _, existing := range intersect {
+			if ElementEquals(existing, value) {
+				continue values
+			}
+		}
+
+		for _, value2 := range ss2 {
+			if ElementEquals(value, value2) {
+				intersect = append(intersect, value)
+				continue values
+			}
+		}
+	}
+
+	return
+}
+`,
+	"equality/LastIndexOf": `package equality
+
+// LastIndexOf returns the index of the last occurrence of lookingFor, or -1 if
+// the value does not exist in the slice.
+//
+// Elements are compared with ElementEquals.
+//
+// See IndexOf() and Contains().
+func (ss SliceType) LastIndexOf(lookingFor ElementType) int {
+	for i := len(ss) - 1; i >= 0; i-- {
+		if ElementEquals(ss[i], lookingFor) {
+			return i
+		}
+	}
+
+	return -1
+}
+`,
+	"equality/Mode": `package equality
+
+// Mode returns a new slice containing the most frequently occurring values.
+//
+// A slice is returned because there may be more than one value that occurs the
+// most number of times. The values are returned in the order they first appear
+// in the input slice. If the slice is empty then nil is returned.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) Mode() (mode SliceType) {
+	// counts[i] is the number of times that ss[i] appears in ss, or zero if it
+	// is not the first occurrence.
+	counts := make([]int, len(ss))
+	highest := 0
+
+values:
+	for i, s := range ss {
+		for j := 0; j < i; j++ {
+			if ElementEquals(ss[j], s) {
+				continue values
+			}
+		}
+
+		for _, s2 := range ss[i:] {
+			if ElementEquals(s, s2) {
+				counts[i]++
+			}
+		}
+
+		if counts[i] > highest {
+			highest = counts[i]
+		}
+	}
+
+	for i, s := range ss {
+		if counts[i] == highest && highest > 0 {
+			mode = append(mode, s)
+		}
+	}
+
+	return
+}
+`,
+	"equality/Union": `package equality
+
+// Union returns a new slice containing the elements that exist in either
+// slice. Each element will only appear once. Elements from ss appear first,
+// followed by the elements only found in ss2. The returned slice may contain
+// zero elements (nil).
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+func (ss SliceType) Union(ss2 SliceType) (union SliceType) {
+	for _, slice := range []SliceType{ss, ss2} {
+	values:
+		for _, value := range slice {
+			for _, existing := range union {
+				if ElementEquals(existing, value) {
+					continue values
+				}
+			}
+
+			union = append(union, value)
+		}
+	}
+
+	return
+}
+`,
+	"equality/Unique": `package equality
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in the same order that they first appear in the
+// input slice.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// Elements are compared with ElementEquals, so this is O(n^2).
+//
+// See AreUnique().
+func (ss SliceType) Unique() SliceType {
 	// Avoid the allocation. If there is one element or less it is already
 	// unique.
 	if len(ss) < 2 {
 		return ss
 	}
 
-	values := map[ElementType]struct{}{}
+	uniqueValues := SliceType{}
 
+values:
 	for _, value := range ss {
-		values[value] = struct{}{}
-	}
+		for _, uniqueValue := range uniqueValues {
+			if ElementEquals(uniqueValue, value) {
+				continue values
+			}
+		}
 
-	var uniqueValues SliceType
-	for value := range values {
 		uniqueValues = append(uniqueValues, value)
 	}
 
 	return uniqueValues
 }
 `,
-	"Unselect": `package functions
+	"floats/Abs": `package floats
 
-// Unselect works the same as Select, with a negated condition. That is, it will
-// return a new slice only containing the elements that returned false from the
-// condition. The returned slice may contain zero elements (nil).
-func (ss SliceType) Unselect(condition func(ElementType) bool) (ss2 SliceType) {
-	for _, s := range ss {
-		if !condition(s) {
-			ss2 = append(ss2, s)
-		}
+import (
+	"math"
+)
+
+// Abs returns a new slice with the absolute value of each element. The input
+// slice is not modified.
+func (ss SliceType) Abs() SliceType {
+	if ss == nil {
+		return nil
 	}
 
-	return
+	result := make(SliceType, len(ss))
+	for i, s := range ss {
+		result[i] = ElementType(math.Abs(float64(s)))
+	}
+
+	return result
 }
 `,
-	"Values": `package functions
+	"floats/AreSorted": `package floats
 
-// Values returns the values in the map.
+import (
+	"sort"
+)
+
+// AreSorted will return true if the slice is already sorted. It is a wrapper
+// for sort.SliceTypeAreSorted. NaN values are expected to be first, which is
+// the order produced by Sort.
+func (ss SliceType) AreSorted() bool {
+	return sort.SliceIsSorted(ss, func(i, j int) bool {
+		return ss[i] < ss[j] || (ss[i] != ss[i] && ss[j] == ss[j])
+	})
+}
+`,
+	"floats/ArgSort": `package floats
+
+import (
+	"sort"
+)
+
+// ArgSort returns the indexes that would sort the slice in ascending order.
+// This is useful for applying the same ordering to another slice:
 //
-// Due to Go's randomization of iterating maps the order is not deterministic.
-func (m MapType) Values() []ElementType {
-	// Avoid allocation
-	l := len(m)
-	if l == 0 {
+//   for _, i := range xs.ArgSort() {
+//     sortedYs = append(sortedYs, ys[i])
+//   }
+//
+// Equal elements keep their original order, and NaN values are placed first
+// like Sort. The input slice is not modified and nil is returned if there are
+// no elements.
+func (ss SliceType) ArgSort() []int {
+	if len(ss) == 0 {
 		return nil
 	}
 
-	i := 0
-	keys := make([]ElementType, len(m))
-	for _, value := range m {
-		keys[i] = value
-		i++
+	indexes := make([]int, len(ss))
+	for i := range indexes {
+		indexes[i] = i
 	}
 
-	return keys
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := ss[indexes[i]], ss[indexes[j]]
+
+		return a < b || (a != a && b == b)
+	})
+
+	return indexes
+}
+`,
+	"floats/Rank": `package floats
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Rank returns the rank of each element, where the smallest element has a rank
+// of 1. Equal elements are ranked according to method, see pie.RankMethod.
+//
+// NaN values are sorted first, so they have the lowest ranks, and are ranked
+// as equal to each other.
+//
+// The ranks are in the same order as the elements. The input slice is not
+// modified and nil is returned if there are no elements.
+func (ss SliceType) Rank(method pie.RankMethod) pie.Float64s {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	indexes := ss.ArgSort()
+	ranks := make(pie.Float64s, len(ss))
+	dense := 0
+
+	for start := 0; start < len(indexes); {
+		end := start + 1
+		for end < len(indexes) {
+			// NaN is the only value that is not equal to itself.
+			a, b := ss[indexes[end]], ss[indexes[start]]
+			if a != b && (a == a || b == b) {
+				break
+			}
+
+			end++
+		}
+
+		dense++
+
+		var rank float64
+		switch method {
+		case pie.RankMin:
+			rank = float64(start + 1)
+
+		case pie.RankMax:
+			rank = float64(end)
+
+		case pie.RankDense:
+			rank = float64(dense)
+
+		default:
+			rank = float64(start+1+end) / 2
+		}
+
+		for _, i := range indexes[start:end] {
+			ranks[i] = rank
+		}
+
+		start = end
+	}
+
+	return ranks
+}
+`,
+	"floats/Sort": `package floats
+
+import (
+	"sort"
+)
+
+// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// NaN values are placed before all other values, in the same way as
+// sort.Float64s, so that the order is deterministic.
+//
+// See Reverse() and AreSorted().
+func (ss SliceType) Sort() SliceType {
+	// Avoid the allocation. If there is one element or less it is already
+	// sorted.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	sorted := make([]ElementType, len(ss))
+	copy(sorted, ss)
+	sort.Slice(sorted, func(i, j int) bool {
+		// NaN is the only value that is not equal to itself.
+		return sorted[i] < sorted[j] || (sorted[i] != sorted[i] && sorted[j] == sorted[j])
+	})
+
+	return sorted
+}
+`,
+	"floats/SortInPlace": `package floats
+
+import (
+	"sort"
+)
+
+// SortInPlace works the same as Sort, except that the elements are sorted in
+// the existing slice instead of allocating a new one. The same slice is
+// returned so that it can be chained. Like Sort, NaN values are placed first.
+//
+// This modifies the input slice. Use Sort if you need to keep the original.
+func (ss SliceType) SortInPlace() SliceType {
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i] < ss[j] || (ss[i] != ss[i] && ss[j] == ss[j])
+	})
+
+	return ss
 }
 `,
 }
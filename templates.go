@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/elliotchance/pie/functions"
+)
+
+// customTemplate is a template loaded with the -templates flag.
+type customTemplate struct {
+	Name   string
+	For    int
+	Source string
+}
+
+// receiverKinds maps the placeholder receiver of a template to the kinds of
+// types it will be generated for.
+var receiverKinds = map[string]int{
+	"SliceType":        functions.ForAll,
+	"StringSliceType":  functions.ForStrings,
+	"BoolSliceType":    functions.ForBools,
+	"PointerSliceType": functions.ForPointers,
+	"MapType":          functions.ForMaps,
+}
+
+// directiveKinds are the names that can be used with the "//pie:for"
+// directive.
+var directiveKinds = map[string]int{
+	"all":      functions.ForAll,
+	"numbers":  functions.ForNumbers,
+	"floats":   functions.ForFloats,
+	"integers": functions.ForIntegers,
+	"strings":  functions.ForStrings,
+	"structs":  functions.ForStructs,
+	"maps":     functions.ForMaps,
+	"bools":    functions.ForBools,
+	"pointers": functions.ForPointers,
+}
+
+// loadTemplates reads each .go file in dir as a template. Templates are
+// written in the same way as the built-in templates in the functions package.
+//
+// The name of the template is the name of the first function or method
+// declared (with any "SliceType" prefix removed, for constructors). The kinds
+// of types that the template applies to is based on the receiver, such as
+// StringSliceType for strings only. This can be overridden with a directive:
+//
+//	//pie:for numbers,strings
+func loadTemplates(dir string) (templates []customTemplate, err error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		source, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		template, err := parseTemplate(path, string(source))
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	return
+}
+
+func parseTemplate(path, source string) (template customTemplate, err error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return
+	}
+
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		template.Name = strings.TrimPrefix(funcDecl.Name.Name, "SliceType")
+		template.For = functions.ForAll
+
+		if funcDecl.Recv != nil {
+			receiver := getIdentName(funcDecl.Recv.List[0].Type)
+			template.For = receiverKinds[strings.TrimLeft(receiver, "*")]
+		}
+
+		break
+	}
+
+	if template.Name == "" {
+		return template, fmt.Errorf("%s: template must declare a function", path)
+	}
+
+	// Remove the directives so that they do not appear in the generated code.
+	var lines []string
+	for _, line := range strings.Split(source, "\n") {
+		if strings.HasPrefix(line, "//pie:for ") {
+			template.For = 0
+			for _, kind := range splitFunctions(line[len("//pie:for "):]) {
+				if _, ok := directiveKinds[kind]; !ok {
+					return template, fmt.Errorf("%s: unknown kind: %s", path, kind)
+				}
+
+				template.For |= directiveKinds[kind]
+			}
+
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	template.Source = strings.Join(lines, "\n")
+
+	if template.For == 0 {
+		return template, fmt.Errorf("%s: cannot determine which types the template is for", path)
+	}
+
+	if isFunctionName(template.Name) {
+		return template, fmt.Errorf("%s: %s is already a built-in function", path, template.Name)
+	}
+
+	return
+}
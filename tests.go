@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/elliotchance/pie/functions"
+)
+
+// generatedTests are the properties checked by the tests that are generated
+// with the -tests flag. Each test is only included when all of the functions
+// it uses have been generated for the type.
+//
+// The code is an expression that must be true for any slice, ss. It may also
+// use equal(a, b) to compare slices.
+var generatedTests = []struct {
+	Name string
+	For  int
+	Uses []string
+	Code string
+}{
+	{"Len", functions.ForAll, []string{"Len"},
+		`ss.Len() == len(ss)`},
+	{"Reverse", functions.ForAll, []string{"Reverse"},
+		`equal(ss.Reverse().Reverse(), ss)`},
+	{"FirstAndLast", functions.ForAll, []string{"First", "Last"},
+		`func() bool {
+			if len(ss) == 0 {
+				return reflect.DeepEqual(ss.First(), ElementZeroValue) &&
+					reflect.DeepEqual(ss.Last(), ElementZeroValue)
+			}
+
+			return reflect.DeepEqual(ss.First(), ss[0]) && reflect.DeepEqual(ss.Last(), ss[len(ss)-1])
+		}()`},
+	{"Contains", functions.ForAll, []string{"All", "Contains"},
+		`ss.All(ss.Contains)`},
+	{"TopAndBottom", functions.ForAll, []string{"Top", "Bottom", "Reverse"},
+		`equal(ss.Top(len(ss)), ss) && equal(ss.Bottom(len(ss)), ss.Reverse())`},
+	{"SelectAndUnselect", functions.ForAll, []string{"Select", "Unselect"},
+		`equal(ss.Select(func(ElementType) bool { return true }), ss) &&
+			len(ss.Unselect(func(ElementType) bool { return true })) == 0`},
+	{"Shuffle", functions.ForAll, []string{"Shuffle"},
+		`len(ss.Shuffle(rand.NewSource(0))) == len(ss)`},
+	{"JSON", functions.ForAll, []string{"JSONString", "FromJSONString"},
+		`func() bool {
+			ss2, err := SliceTypeFromJSONString(ss.JSONString())
+			return err == nil && ss2.JSONString() == ss.JSONString()
+		}()`},
+	{"Unique", functions.ForNumbersAndStrings, []string{"Unique", "AreUnique"},
+		`ss.Unique().AreUnique()`},
+	{"Sort", functions.ForNumbersAndStrings, []string{"Sort", "AreSorted"},
+		`len(ss.Sort()) == len(ss) && ss.Sort().AreSorted()`},
+	{"MinAndMax", functions.ForNumbersAndStrings, []string{"All", "Min", "Max"},
+		`ss.All(func(s ElementType) bool { return ss.Min() <= s && s <= ss.Max() })`},
+}
+
+// usesAll returns true if all of the functions have been generated.
+func usesAll(fns []string, kind int, uses []string) bool {
+	for _, use := range uses {
+		if !includeFunction(fns, use) {
+			return false
+		}
+
+		for _, function := range functions.Functions {
+			if function.Name == use && function.For&kind == 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// findTypeFile returns the file that declares the type.
+func findTypeFile(pkgs map[string]*ast.Package, name string) *ast.File {
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+
+				for _, spec := range genDecl.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.String() == name {
+						return file
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// qualifiers finds package qualifiers, like the "time" in "time.Second".
+var qualifiers = regexp.MustCompile(`\b([a-zA-Z_]\w*)\.[A-Z]`)
+
+// generateTests creates the test file for the -tests flag. The tests are run
+// against a nil slice, an empty slice and the literal provided by the
+// "//pie:sample" directive, if there is one:
+//
+//	//go:generate pie -tests Cars.*
+//	//pie:sample {{"Bob", "blue"}, {"Sally", "green"}}
+//	type Cars []Car
+//
+// A fuzz target is also generated (when JSON functions are available) that
+// checks the same properties for any slice that can be decoded from JSON.
+func generateTests(pkgs map[string]*ast.Package, packageName, sliceType, elementType string, kind int, fns []string) string {
+	var checks []string
+	imports := map[string]struct{}{
+		`"testing"`: {},
+	}
+
+	for _, test := range generatedTests {
+		if test.For&kind == 0 || !usesAll(fns, kind, test.Uses) {
+			continue
+		}
+
+		code := strings.Replace(test.Code, "SliceType", sliceType, -1)
+		code = strings.Replace(code, "ElementZeroValue", getZeroValue(kind, elementType), -1)
+		code = strings.Replace(code, "ElementType", elementType, -1)
+		checks = append(checks, fmt.Sprintf("\t\t{%q, func() bool {\n\t\t\treturn %s\n\t\t}},\n", test.Name, code))
+
+		if strings.Contains(code, "rand.") {
+			imports[`"math/rand"`] = struct{}{}
+		}
+	}
+
+	// reflect is always needed by equal.
+	imports[`"reflect"`] = struct{}{}
+
+	samples := []string{"nil", "{}"}
+	if sample := strings.Join(findDirectives(pkgs, sliceType)["sample"], " "); sample != "" {
+		samples = append(samples, sample)
+
+		if file := findTypeFile(pkgs, sliceType); file != nil {
+			for _, match := range qualifiers.FindAllStringSubmatch(sample, -1) {
+				for _, imp := range getTypeImports(file, match[1]+".") {
+					imports[imp] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var sortedImports []string
+	for imp := range imports {
+		sortedImports = append(sortedImports, imp)
+	}
+
+	sort.Strings(sortedImports)
+
+	// Test functions must start with an uppercase letter after "Test".
+	name := strings.ToUpper(sliceType[:1]) + sliceType[1:]
+
+	t := "//go:build go1.18\n// +build go1.18\n\n"
+	t += fmt.Sprintf("package %s\n\n", packageName)
+	t += "import (\n\t" + strings.Join(sortedImports, "\n\t") + "\n)\n\n"
+
+	t += fmt.Sprintf(`func check%[4]sGenerated(t *testing.T, ss %[1]s) {
+	equal := func(a, b %[1]s) bool {
+		if len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !reflect.DeepEqual(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	original := make(%[1]s, len(ss))
+	copy(original, ss)
+
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+%[2]s	}
+
+	for _, test := range tests {
+		if !test.check() {
+			t.Errorf("%%s failed for %%v", test.name, ss)
+		}
+
+		if !equal(ss, original) {
+			t.Fatalf("%%s modified the slice", test.name)
+		}
+	}
+}
+
+func Test%[4]s_Generated(t *testing.T) {
+	for _, ss := range []%[1]s{%[3]s} {
+		check%[4]sGenerated(t, ss)
+	}
+}
+`, sliceType, strings.Join(checks, ""), strings.Join(samples, ", "), name)
+
+	if usesAll(fns, kind, []string{"JSONString", "UnmarshalJSON"}) {
+		t += fmt.Sprintf(`
+func Fuzz%[3]s_Generated(f *testing.F) {
+	for _, ss := range []%[1]s{%[2]s} {
+		f.Add([]byte(ss.JSONString()))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ss %[1]s
+		if ss.UnmarshalJSON(data) != nil {
+			return
+		}
+
+		check%[3]sGenerated(t, ss)
+	})
+}
+`, sliceType, strings.Join(samples, ", "), name)
+	}
+
+	// The checks are not indented consistently so the whole file is formatted.
+	formatted, err := format.Source([]byte(t))
+	check(err)
+
+	return string(formatted)
+}